@@ -0,0 +1,5 @@
+resource "okta_admin_role_custom" "test" {
+  label       = "testAcc_replace_with_uuid"
+  description = "Testing custom admin role"
+  permissions = ["okta.users.read", "okta.groups.read"]
+}
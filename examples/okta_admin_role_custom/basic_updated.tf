@@ -0,0 +1,10 @@
+resource "okta_admin_role_custom" "test" {
+  label       = "testAccUpdated_replace_with_uuid"
+  description = "Testing updated custom admin role"
+  permissions = ["okta.users.read", "okta.groups.manage", "okta.users.userprofile.manage"]
+
+  conditions {
+    permission = "okta.users.userprofile.manage"
+    include    = ["city", "zipCode"]
+  }
+}
@@ -0,0 +1,35 @@
+variable "resource_set_id" {
+  type        = string
+  description = "ID of the resource set the custom role is granted on"
+}
+
+variable "org_url" {
+  type        = string
+  description = "URL of the org, e.g. https://example.okta.com"
+}
+
+resource "okta_user" "test" {
+  first_name = "TestAcc"
+  last_name  = "Smith"
+  login      = "testAcc-replace_with_uuid@example.com"
+  email      = "testAcc-replace_with_uuid@example.com"
+}
+
+resource "okta_group" "test" {
+  name = "testAcc_replace_with_uuid"
+}
+
+resource "okta_admin_role_custom" "test" {
+  label       = "testAcc_replace_with_uuid"
+  description = "Testing custom admin role assignment"
+  permissions = ["okta.users.read"]
+}
+
+resource "okta_admin_role_custom_assignment" "test" {
+  resource_set_id = var.resource_set_id
+  custom_role_id  = okta_admin_role_custom.test.id
+  members = [
+    "${var.org_url}/api/v1/users/${okta_user.test.id}",
+    "${var.org_url}/api/v1/groups/${okta_group.test.id}",
+  ]
+}
@@ -18,6 +18,9 @@ resource "okta_app_basic_auth" "test" {
   auth_url = "https://example.com/auth.html"
   logo     = "../examples/okta_app_basic_auth/terraform_icon.png"
 
+  admin_note   = "managed by terraform"
+  enduser_note = "contact support for access"
+
   users {
     id       = okta_user.user.id
     username = okta_user.user.email
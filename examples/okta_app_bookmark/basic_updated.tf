@@ -14,6 +14,9 @@ resource "okta_app_bookmark" "test" {
   label = "testAcc_replace_with_uuid"
   url   = "https://test.com"
 
+  accessibility_self_service       = true
+  accessibility_error_redirect_url = "https://test.com/error"
+
   users {
     id       = okta_user.user.id
     username = okta_user.user.email
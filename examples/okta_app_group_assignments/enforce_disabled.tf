@@ -0,0 +1,39 @@
+resource "okta_app_oauth" "test" {
+  label          = "testAcc_replace_with_uuid"
+  type           = "web"
+  grant_types    = ["implicit", "authorization_code"]
+  redirect_uris  = ["http://d.com/"]
+  response_types = ["code", "token", "id_token"]
+  issuer_mode    = "ORG_URL"
+
+  lifecycle {
+    ignore_changes = ["users", "groups"]
+  }
+}
+
+resource "okta_group" "test1" {
+  name = "testAcc_replace_with_uuid"
+}
+
+resource "okta_group" "test2" {
+  name = "testAcc_replace_with_uuid_2"
+}
+
+resource "okta_app_group_assignment" "test1" {
+  app_id   = okta_app_oauth.test.id
+  group_id = okta_group.test1.id
+}
+
+resource "okta_app_group_assignments" "test" {
+  app_id  = okta_app_oauth.test.id
+  enforce = false
+
+  group {
+    id = okta_group.test1.id
+  }
+  group {
+    id = okta_group.test2.id
+  }
+
+  depends_on = [okta_app_group_assignment.test1]
+}
@@ -11,6 +11,8 @@ resource "okta_app_saml" "test" {
   signature_algorithm      = "RSA_SHA1"
   digest_algorithm         = "SHA1"
   honor_force_authn        = true
+  request_compressed       = true
+  default_relay_state      = "https://example.com/landing"
   authn_context_class_ref  = "urn:oasis:names:tc:SAML:2.0:ac:classes:PasswordProtectedTransport"
 
   attribute_statements {
@@ -0,0 +1,10 @@
+resource "okta_app_signon_policy" "test" {
+  name        = "testAcc_replace_with_uuid"
+  description = "Terraform Acceptance Test App SignOn Policy"
+}
+
+resource "okta_app_signon_policy_rule" "test" {
+  policy_id = okta_app_signon_policy.test.id
+  name      = "testAcc_replace_with_uuid"
+  access    = "ALLOW"
+}
@@ -0,0 +1,34 @@
+resource "okta_app_signon_policy" "test" {
+  name        = "testAcc_replace_with_uuid"
+  description = "Terraform Acceptance Test App SignOn Policy"
+}
+
+resource "okta_group" "test" {
+  name = "testAcc_replace_with_uuid"
+}
+
+resource "okta_user" "test" {
+  first_name = "TestAcc"
+  last_name  = "Smith"
+  login      = "testAcc-replace_with_uuid@example.com"
+  email      = "testAcc-replace_with_uuid@example.com"
+}
+
+resource "okta_app_signon_policy_rule" "test" {
+  policy_id                   = okta_app_signon_policy.test.id
+  name                        = "testAcc_replace_with_uuid"
+  access                      = "ALLOW"
+  factor_mode                 = "1FA"
+  re_authentication_frequency = "PT43800H"
+  device_is_registered        = true
+  device_is_managed           = false
+  groups_included             = [okta_group.test.id]
+  users_excluded              = [okta_user.test.id]
+  constraints = [
+    jsonencode({
+      knowledge = {
+        types = ["password"]
+      }
+    })
+  ]
+}
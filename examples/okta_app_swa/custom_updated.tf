@@ -1,8 +1,15 @@
 resource "okta_app_swa" "test" {
-  label          = "testAcc_replace_with_uuid"
-  status         = "INACTIVE"
-  button_field   = "btn-login-updated"
-  password_field = "txtbox-password-updated"
-  username_field = "txtbox-username-updated"
-  url            = "https://example.com/login-updated.html"
+  label              = "testAcc_replace_with_uuid"
+  status             = "INACTIVE"
+  button_field       = "btn-login-updated"
+  password_field     = "txtbox-password-updated"
+  username_field     = "txtbox-username-updated"
+  url                = "https://example.com/login-updated.html"
+  checkbox           = "chk-remember"
+  redirect_url       = "https://example.com/redirect.html"
+  credentials_scheme = "SHARED_USERNAME_AND_PASSWORD"
+  shared_username    = "shared-user"
+  shared_password    = "Sh@redPassw0rd"
+  skip_users         = true
+  skip_groups        = true
 }
@@ -0,0 +1,11 @@
+variable "app_id" {
+  type        = string
+  description = "ID of the app with provisioning enabled and the API integration configured"
+}
+
+resource "okta_app_user_provisioning" "test" {
+  app_id                 = var.app_id
+  create_users           = true
+  update_user_attributes = true
+  deactivate_users       = true
+}
@@ -0,0 +1,24 @@
+resource "okta_app_bookmark" "test_1" {
+  label = "testAcc_replace_with_uuid - Test 1"
+  url   = "https://test.com"
+}
+
+resource "okta_app_bookmark" "test_2" {
+  label  = "testAcc_replace_with_uuid - Test 2"
+  url    = "https://test.com"
+  status = "INACTIVE"
+}
+
+data "okta_apps" "test" {
+  label_prefix = "testAcc_replace_with_uuid"
+}
+
+data "okta_apps" "test_non_active" {
+  label_prefix       = "testAcc_replace_with_uuid"
+  include_non_active = true
+}
+
+data "okta_apps" "test_label" {
+  label              = okta_app_bookmark.test_2.label
+  include_non_active = true
+}
@@ -0,0 +1,10 @@
+resource "okta_app_bookmark" "test_1" {
+  label = "testAcc_replace_with_uuid - Test 1"
+  url   = "https://test.com"
+}
+
+resource "okta_app_bookmark" "test_2" {
+  label  = "testAcc_replace_with_uuid - Test 2"
+  url    = "https://test.com"
+  status = "INACTIVE"
+}
@@ -0,0 +1,7 @@
+resource "okta_auth_server" "test" {
+  audiences   = ["whatever.rise.zone", "whatever-else.rise.zone"]
+  description = "Activated authorization server"
+  name        = "testAcc_replace_with_uuid"
+  issuer_mode = "ORG_URL"
+  status      = "ACTIVE"
+}
@@ -4,6 +4,12 @@ resource "okta_auth_server_claim" "test" {
   claim_type     = "RESOURCE"
   value_type     = "EXPRESSION"
   value          = "cool"
+  scopes         = [okta_auth_server_scope.test.name]
+  auth_server_id = okta_auth_server.test.id
+}
+
+resource "okta_auth_server_scope" "test" {
+  name           = "test:claim"
   auth_server_id = okta_auth_server.test.id
 }
 
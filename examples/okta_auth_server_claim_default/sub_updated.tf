@@ -0,0 +1,11 @@
+resource "okta_auth_server_claim_default" "test" {
+  name           = "sub"
+  value          = "(appuser != null) ? appuser.email : app.clientId"
+  auth_server_id = okta_auth_server.test.id
+}
+
+resource "okta_auth_server" "test" {
+  name        = "testAcc_replace_with_uuid"
+  description = "test"
+  audiences   = ["whatever.rise.zone"]
+}
@@ -0,0 +1,18 @@
+resource "okta_auth_server" "test" {
+  name        = "testAcc_replace_with_uuid"
+  description = "test"
+  audiences   = ["whatever.rise.zone"]
+}
+
+resource "okta_auth_server_claim" "test" {
+  name           = "test"
+  claim_type     = "RESOURCE"
+  value          = "cool"
+  auth_server_id = okta_auth_server.test.id
+}
+
+data "okta_auth_server_claims" "test" {
+  auth_server_id = okta_auth_server.test.id
+
+  depends_on = [okta_auth_server_claim.test]
+}
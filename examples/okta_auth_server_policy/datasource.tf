@@ -15,7 +15,22 @@ resource "okta_auth_server" "test" {
     "whatever.rise.zone"]
 }
 
+data "okta_group" "all" {
+  name = "Everyone"
+}
+
+resource "okta_auth_server_policy_rule" "test" {
+  auth_server_id       = okta_auth_server.test.id
+  policy_id            = okta_auth_server_policy.test.id
+  status               = "ACTIVE"
+  name                 = "test"
+  priority             = 1
+  group_whitelist      = [data.okta_group.all.id]
+  grant_type_whitelist = ["implicit"]
+}
+
 data "okta_auth_server_policy" "test" {
   name           = "test"
   auth_server_id = okta_auth_server.test.id
+  depends_on     = [okta_auth_server_policy_rule.test]
 }
\ No newline at end of file
@@ -0,0 +1,31 @@
+data "okta_group" "all" {
+  name = "Everyone"
+}
+
+resource "okta_auth_server" "test" {
+  name        = "testAcc_replace_with_uuid"
+  description = "test"
+  audiences   = ["whatever.rise.zone"]
+}
+
+resource "okta_auth_server_policy" "test" {
+  name             = "test"
+  description      = "test"
+  priority         = 1
+  client_whitelist = ["ALL_CLIENTS"]
+  auth_server_id   = okta_auth_server.test.id
+}
+
+resource "okta_auth_server_policy_rule" "test" {
+  auth_server_id                 = okta_auth_server.test.id
+  policy_id                      = okta_auth_server_policy.test.id
+  status                         = "INACTIVE"
+  name                           = "test"
+  priority                       = 1
+  group_whitelist                = [data.okta_group.all.id]
+  grant_type_whitelist           = ["authorization_code", "client_credentials"]
+  scope_whitelist                = ["*"]
+  access_token_lifetime_minutes  = 120
+  refresh_token_lifetime_minutes = 1440
+  refresh_token_window_minutes   = 720
+}
@@ -0,0 +1,7 @@
+resource "okta_authenticator" "test" {
+  key = "okta_email"
+  settings = jsonencode({
+    "allowedFor" : "any",
+    "tokenLifetimeInMinutes" : 10
+  })
+}
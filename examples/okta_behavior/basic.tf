@@ -0,0 +1,25 @@
+resource "okta_behavior" "my_location" {
+  name                      = "testAcc_replace_with_uuid Location"
+  type                      = "ANOMALOUS_LOCATION"
+  number_of_authentications = 50
+  location_granularity_type = "LAT_LONG"
+  radius_from_location      = 20
+}
+
+resource "okta_behavior" "my_device" {
+  name                      = "testAcc_replace_with_uuid Device"
+  type                      = "ANOMALOUS_DEVICE"
+  number_of_authentications = 50
+}
+
+resource "okta_behavior" "my_ip" {
+  name                      = "testAcc_replace_with_uuid IP"
+  type                      = "ANOMALOUS_IP"
+  number_of_authentications = 50
+}
+
+resource "okta_behavior" "my_velocity" {
+  name     = "testAcc_replace_with_uuid Velocity"
+  type     = "VELOCITY"
+  velocity = 25
+}
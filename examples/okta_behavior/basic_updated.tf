@@ -0,0 +1,26 @@
+resource "okta_behavior" "my_location" {
+  name                      = "testAcc_replace_with_uuid Location Updated"
+  type                      = "ANOMALOUS_LOCATION"
+  number_of_authentications = 20
+  location_granularity_type = "COUNTRY"
+  status                    = "INACTIVE"
+}
+
+resource "okta_behavior" "my_device" {
+  name                      = "testAcc_replace_with_uuid Device"
+  type                      = "ANOMALOUS_DEVICE"
+  number_of_authentications = 30
+}
+
+resource "okta_behavior" "my_ip" {
+  name                      = "testAcc_replace_with_uuid IP"
+  type                      = "ANOMALOUS_IP"
+  number_of_authentications = 50
+  status                    = "INACTIVE"
+}
+
+resource "okta_behavior" "my_velocity" {
+  name     = "testAcc_replace_with_uuid Velocity"
+  type     = "VELOCITY"
+  velocity = 40
+}
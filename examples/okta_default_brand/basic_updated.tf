@@ -0,0 +1,4 @@
+resource "okta_default_brand" "test" {
+  custom_privacy_policy_url = "https://example.com/privacy"
+  remove_powered_by_okta    = true
+}
@@ -0,0 +1,9 @@
+resource "okta_email_sender" "test" {
+  from_name    = "testAcc_replace_with_uuid"
+  from_address = "no-reply@example.com"
+  subdomain    = "mail"
+}
+
+resource "okta_email_sender_verification" "test" {
+  sender_id = okta_email_sender.test.id
+}
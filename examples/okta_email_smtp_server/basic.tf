@@ -0,0 +1,8 @@
+resource "okta_email_smtp_server" "test" {
+  alias    = "testAcc_replace_with_uuid"
+  host     = "smtp.example.com"
+  port     = 587
+  username = "okta"
+  password = "Passw0rd!"
+  enabled  = false
+}
@@ -0,0 +1,9 @@
+resource "okta_email_smtp_server" "test" {
+  alias      = "testAccUpdated_replace_with_uuid"
+  host       = "relay.example.com"
+  port       = 465
+  encryption = "TLS"
+  username   = "okta-relay"
+  password   = "Passw0rd!Updated"
+  enabled    = false
+}
@@ -4,6 +4,11 @@ resource "okta_group" "test" {
   users       = [okta_user.test.id]
 }
 
+# has the name of the other group as a prefix, so that the exact name matching is verified
+resource "okta_group" "test_prefix" {
+  name = "testAcc_replace_with_uuid"
+}
+
 resource "okta_user" "test" {
   first_name = "TestAcc"
   last_name  = "Jones"
@@ -14,4 +19,10 @@ resource "okta_user" "test" {
 data "okta_group" "test" {
   include_users = true
   name          = okta_group.test.name
+  type          = "OKTA_GROUP"
+  depends_on    = [okta_group.test_prefix]
+}
+
+data "okta_group" "test_search" {
+  search = "profile.name eq \"${okta_group.test.name}\""
 }
@@ -0,0 +1,7 @@
+resource "okta_group" "test" {
+  name = "testAcc_replace_with_uuid"
+}
+
+resource "okta_group" "test_other" {
+  name = "testAcc_replace_with_uuid"
+}
@@ -0,0 +1,3 @@
+resource "okta_group" "test" {
+  name = "testAcc_replace_with_uuid"
+}
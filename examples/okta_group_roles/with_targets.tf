@@ -0,0 +1,35 @@
+resource "okta_group" "test" {
+  name        = "testAcc_replace_with_uuid"
+  description = "testing"
+}
+
+resource "okta_group" "test_target" {
+  name        = "testTargetAcc_replace_with_uuid"
+  description = "testing"
+}
+
+resource "okta_app_swa" "test" {
+  label          = "testAcc_replace_with_uuid"
+  button_field   = "btn-login"
+  password_field = "txtbox-password"
+  username_field = "txtbox-username"
+  url            = "https://example.com/login.html"
+}
+
+resource "okta_group_roles" "test" {
+  group_id = okta_group.test.id
+
+  role {
+    type = "READ_ONLY_ADMIN"
+  }
+
+  role {
+    type              = "HELP_DESK_ADMIN"
+    target_group_list = [okta_group.test_target.id]
+  }
+
+  role {
+    type            = "APP_ADMIN"
+    target_app_list = [format("%s.%s", okta_app_swa.test.name, okta_app_swa.test.id)]
+  }
+}
@@ -0,0 +1,40 @@
+resource "okta_group_schema_property" "test" {
+  index       = "testAcc_replace_with_uuid"
+  title       = "terraform acceptance test"
+  type        = "string"
+  description = "terraform acceptance test"
+  required    = false
+  min_length  = 1
+  max_length  = 50
+  permissions = "READ_ONLY"
+  master      = "PROFILE_MASTER"
+  enum        = ["S", "M", "L", "XL"]
+
+  one_of {
+    const = "S"
+    title = "Small"
+  }
+
+  one_of {
+    const = "M"
+    title = "Medium"
+  }
+
+  one_of {
+    const = "L"
+    title = "Large"
+  }
+
+  one_of {
+    const = "XL"
+    title = "Extra Large"
+  }
+}
+
+resource "okta_group" "test" {
+  name = "testAcc_replace_with_uuid"
+  custom_profile_attributes = jsonencode({
+    "testAcc_replace_with_uuid" = "M"
+  })
+  depends_on = [okta_group_schema_property.test]
+}
@@ -9,5 +9,10 @@ resource "okta_group" "test_2" {
 }
 
 data "okta_groups" "test" {
-  q = "testAcc_"
+  q    = "testAcc_replace_with_uuid"
+  type = "OKTA_GROUP"
+}
+
+data "okta_groups" "built_in" {
+  type = "BUILT_IN"
 }
@@ -1,3 +1,7 @@
+resource "okta_group" "test" {
+  name = "testAcc_replace_with_uuid"
+}
+
 resource "okta_idp_oidc" "test" {
   name                  = "testAcc_replace_with_uuid"
   authorization_url     = "https://idp.example.com/authorize2"
@@ -13,4 +17,9 @@ resource "okta_idp_oidc" "test" {
   client_secret         = "efg456"
   issuer_url            = "https://id.example.com"
   username_template     = "idpuser.email"
+  groups_action         = "SYNC"
+  groups_attribute      = "groups"
+  groups_filter         = [okta_group.test.id]
+  status                = "INACTIVE"
+  account_link_action   = "AUTO"
 }
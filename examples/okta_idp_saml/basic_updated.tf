@@ -29,4 +29,15 @@ resource "okta_idp_saml" "test" {
   response_signature_scope = "RESPONSE"
   request_signature_scope  = "REQUEST"
   max_clock_skew           = 60
+  status                   = "INACTIVE"
+  name_format              = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+  subject_match_type       = "EMAIL"
+  account_link_action      = "AUTO"
+  provisioning_action      = "AUTO"
+  groups_action            = "ASSIGN"
+  groups_assignment        = [okta_group.test.id]
+}
+
+resource "okta_group" "test" {
+  name = "testAcc_replace_with_uuid"
 }
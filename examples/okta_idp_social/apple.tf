@@ -0,0 +1,17 @@
+resource "okta_idp_social" "apple" {
+  type          = "APPLE"
+  protocol_type = "OIDC"
+  name          = "testAcc_apple_replace_with_uuid"
+
+  scopes = [
+    "openid",
+    "email",
+    "name",
+  ]
+
+  client_id         = "com.example.replace_with_uuid"
+  apple_kid         = "TEST123456"
+  apple_team_id     = "TEAM123456"
+  apple_private_key = "MIGHAgEAMBMGByqGSM49AgEGCCqGSM49AwEHBG0wawIBAQQgjpWlKcVi0fpBIH7M9UZIeLHMIR4S49WHePy7r1xW0GKhRANCAAQft/2UesSesc3y1jJJ6aIYotnvqLms5nvV5Kv/IDulWWxkFdNDB74C5B6fIYEl+MgfwGZ+d+xvwWNOjSH1gYsg"
+  username_template = "idpuser.email"
+}
@@ -0,0 +1,15 @@
+resource "okta_idp_social" "google" {
+  type          = "GOOGLE"
+  protocol_type = "OAUTH2"
+  name          = "testAcc_google_replace_with_uuid"
+
+  scopes = [
+    "profile",
+    "email",
+  ]
+
+  client_id         = "efgh456"
+  client_secret     = "efgh456"
+  omit_secret       = true
+  username_template = "idpuser.email"
+}
@@ -10,6 +10,12 @@ data "okta_idp_social" "test_microsoft" {
   name = "testAcc_microsoft_replace_with_uuid"
 }
 
+data "okta_idp_social" "test_type" {
+  type = "MICROSOFT"
+
+  depends_on = [okta_idp_social.microsoft]
+}
+
 resource "okta_idp_social" "facebook" {
   type          = "FACEBOOK"
   protocol_type = "OAUTH2"
@@ -9,6 +9,7 @@ resource "okta_network_zone" "dynamic_network_zone_example" {
   name              = "testAcc_replace_with_uuid Dynamic"
   type              = "DYNAMIC"
   dynamic_locations = ["US", "AF-BGL"]
+  asns              = ["23457"]
 }
 
 resource "okta_network_zone" "dynamic_proxy_example" {
@@ -16,4 +17,5 @@ resource "okta_network_zone" "dynamic_proxy_example" {
   type               = "DYNAMIC"
   usage              = "BLOCKLIST"
   dynamic_proxy_type = "TorAnonymizer"
+  status             = "INACTIVE"
 }
@@ -9,6 +9,7 @@ resource "okta_network_zone" "dynamic_network_zone_example" {
   name              = "testAcc_replace_with_uuid Dynamic Updated"
   type              = "DYNAMIC"
   dynamic_locations = ["US", "AF-BGL", "UA-26"]
+  asns              = ["23457", "23458"]
 }
 
 resource "okta_network_zone" "dynamic_proxy_example" {
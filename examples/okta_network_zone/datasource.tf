@@ -0,0 +1,18 @@
+resource "okta_network_zone" "test" {
+  name     = "testAcc_replace_with_uuid"
+  type     = "IP"
+  gateways = ["1.2.3.4/24", "2.3.4.5-2.3.4.15"]
+  proxies  = ["2.2.3.4/24", "3.3.4.5-3.3.4.15"]
+}
+
+data "okta_network_zone" "test" {
+  name = okta_network_zone.test.name
+}
+
+data "okta_network_zone" "test_id" {
+  id = okta_network_zone.test.id
+}
+
+data "okta_network_zone" "legacy" {
+  name = "LegacyIpZone"
+}
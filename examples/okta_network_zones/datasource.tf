@@ -0,0 +1,12 @@
+resource "okta_network_zone" "test" {
+  name              = "testAcc_replace_with_uuid"
+  type              = "DYNAMIC"
+  dynamic_locations = ["US", "AF-BGL"]
+}
+
+data "okta_network_zones" "test" {
+  type  = "DYNAMIC"
+  usage = "POLICY"
+
+  depends_on = [okta_network_zone.test]
+}
@@ -0,0 +1,8 @@
+data "okta_oin_apps" "test" {
+  q = "Salesforce"
+}
+
+data "okta_oin_apps" "test_category" {
+  q        = "Salesforce"
+  category = "CRM"
+}
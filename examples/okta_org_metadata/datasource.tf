@@ -0,0 +1,13 @@
+data "okta_org_metadata" "test" {}
+
+resource "okta_app_oauth" "test" {
+  label          = "testAcc_replace_with_uuid"
+  type           = "web"
+  grant_types    = ["authorization_code"]
+  redirect_uris  = ["${data.okta_org_metadata.test.org_url}/oauth2/v1/authorize/callback"]
+  response_types = ["code"]
+}
+
+output "issuer" {
+  value = data.okta_org_metadata.test.issuer
+}
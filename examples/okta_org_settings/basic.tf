@@ -0,0 +1,4 @@
+resource "okta_org_settings" "test" {
+  show_end_user_footer         = true
+  opt_out_communication_emails = false
+}
@@ -0,0 +1,7 @@
+resource "okta_policy_device_assurance_android" "test" {
+  name                    = "testAcc_replace_with_uuid_updated"
+  os_version              = "13"
+  disk_encryption_type    = ["FULL"]
+  screenlock_type         = ["BIOMETRIC", "PASSCODE"]
+  secure_hardware_present = true
+}
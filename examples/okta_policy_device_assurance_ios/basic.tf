@@ -0,0 +1,6 @@
+resource "okta_policy_device_assurance_ios" "test" {
+  name            = "testAcc_replace_with_uuid"
+  os_version      = "12.4.5"
+  jailbreak       = false
+  screenlock_type = ["BIOMETRIC"]
+}
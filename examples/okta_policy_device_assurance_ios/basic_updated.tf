@@ -0,0 +1,5 @@
+resource "okta_policy_device_assurance_ios" "test" {
+  name            = "testAcc_replace_with_uuid_updated"
+  os_version      = "15.0"
+  screenlock_type = ["BIOMETRIC", "PASSCODE"]
+}
@@ -0,0 +1,7 @@
+resource "okta_policy_device_assurance_macos" "test" {
+  name                    = "testAcc_replace_with_uuid_updated"
+  os_version              = "13.0"
+  disk_encryption_type    = ["ALL_INTERNAL_VOLUMES"]
+  screenlock_type         = ["BIOMETRIC", "PASSCODE"]
+  secure_hardware_present = true
+}
@@ -0,0 +1,4 @@
+resource "okta_policy_profile_enrollment" "test" {
+  name        = "testAcc_replace_with_uuid"
+  description = "Terraform Acceptance Test Profile Enrollment Policy"
+}
@@ -0,0 +1,5 @@
+resource "okta_policy_profile_enrollment" "test" {
+  name        = "testAcc_replace_with_uuid"
+  status      = "INACTIVE"
+  description = "Terraform Acceptance Test Profile Enrollment Policy Updated"
+}
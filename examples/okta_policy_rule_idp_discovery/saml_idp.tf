@@ -0,0 +1,68 @@
+data "okta_policy" "test" {
+  name = "Idp Discovery Policy"
+  type = "IDP_DISCOVERY"
+}
+
+resource "okta_app_saml" "test" {
+  label                    = "testAcc_replace_with_uuid"
+  sso_url                  = "http://google.com"
+  recipient                = "http://here.com"
+  destination              = "http://its-about-the-journey.com"
+  audience                 = "http://audience.com"
+  subject_name_id_template = "$${user.userName}"
+  subject_name_id_format   = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+  response_signed          = true
+  signature_algorithm      = "RSA_SHA256"
+  digest_algorithm         = "SHA256"
+  honor_force_authn        = false
+  authn_context_class_ref  = "urn:oasis:names:tc:SAML:2.0:ac:classes:PasswordProtectedTransport"
+}
+
+resource "okta_idp_saml_key" "test" {
+  x5c = [okta_app_saml.test.certificate]
+}
+
+resource "okta_idp_saml" "test" {
+  name                     = "testAcc_replace_with_uuid"
+  acs_type                 = "INSTANCE"
+  sso_url                  = "https://idp.example.com"
+  sso_destination          = "https://idp.example.com"
+  sso_binding              = "HTTP-POST"
+  username_template        = "idpuser.email"
+  kid                      = okta_idp_saml_key.test.id
+  issuer                   = "https://idp.example.com"
+  request_signature_scope  = "REQUEST"
+  response_signature_scope = "ANY"
+}
+
+resource "okta_network_zone" "test" {
+  name     = "testAcc_replace_with_uuid"
+  type     = "IP"
+  gateways = ["1.2.3.4/24", "2.3.4.5-2.3.4.15"]
+}
+
+resource "okta_policy_rule_idp_discovery" "test" {
+  policy_id            = data.okta_policy.test.id
+  priority             = 1
+  name                 = "testAcc_replace_with_uuid"
+  idp_id               = okta_idp_saml.test.id
+  idp_type             = "SAML2"
+  network_connection   = "ZONE"
+  network_includes     = [okta_network_zone.test.id]
+  user_identifier_type = "IDENTIFIER"
+
+  app_include {
+    id   = okta_app_saml.test.id
+    type = "APP"
+  }
+
+  platform_include {
+    type    = "DESKTOP"
+    os_type = "ANY"
+  }
+
+  user_identifier_patterns {
+    match_type = "SUFFIX"
+    value      = "partner.example.com"
+  }
+}
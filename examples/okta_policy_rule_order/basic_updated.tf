@@ -0,0 +1,37 @@
+data "okta_group" "all" {
+  name = "Everyone"
+}
+
+resource "okta_policy_signon" "test" {
+  name            = "testAcc_replace_with_uuid"
+  status          = "ACTIVE"
+  description     = "Terraform Acceptance Test SignOn Policy"
+  groups_included = [data.okta_group.all.id]
+}
+
+resource "okta_policy_rule_signon" "test_1" {
+  policy_id = okta_policy_signon.test.id
+  name      = "testAcc_replace_with_uuid_1"
+  status    = "ACTIVE"
+}
+
+resource "okta_policy_rule_signon" "test_2" {
+  policy_id = okta_policy_signon.test.id
+  name      = "testAcc_replace_with_uuid_2"
+  status    = "ACTIVE"
+}
+
+resource "okta_policy_rule_signon" "test_3" {
+  policy_id = okta_policy_signon.test.id
+  name      = "testAcc_replace_with_uuid_3"
+  status    = "ACTIVE"
+}
+
+resource "okta_policy_rule_order" "test" {
+  policy_id = okta_policy_signon.test.id
+  rule_ids = [
+    okta_policy_rule_signon.test_3.id,
+    okta_policy_rule_signon.test_1.id,
+    okta_policy_rule_signon.test_2.id,
+  ]
+}
@@ -0,0 +1,46 @@
+resource "okta_policy_profile_enrollment" "test" {
+  name = "testAcc_replace_with_uuid"
+}
+
+resource "okta_group" "test" {
+  name        = "testAcc_replace_with_uuid"
+  description = "Self-registered users"
+}
+
+resource "okta_inline_hook" "test" {
+  name    = "testAcc_replace_with_uuid"
+  status  = "ACTIVE"
+  type    = "com.okta.user.pre-registration"
+  version = "1.0.2"
+
+  channel = {
+    type    = "HTTP"
+    version = "1.0.0"
+    uri     = "https://example.com/test1"
+    method  = "POST"
+  }
+}
+
+resource "okta_policy_rule_profile_enrollment" "test" {
+  policy_id           = okta_policy_profile_enrollment.test.id
+  unknown_user_action = "REGISTER"
+  email_verification  = false
+  access              = "ALLOW"
+  target_group_id     = okta_group.test.id
+  inline_hook_id      = okta_inline_hook.test.id
+
+  profile_attributes {
+    name     = "email"
+    label    = "Email"
+    required = true
+  }
+  profile_attributes {
+    name     = "firstName"
+    label    = "First Name"
+    required = true
+  }
+  profile_attributes {
+    name  = "lastName"
+    label = "Last Name"
+  }
+}
@@ -0,0 +1,51 @@
+data "okta_group" "all" {
+  name = "Everyone"
+}
+
+resource "okta_policy_signon" "test" {
+  name            = "testAcc_replace_with_uuid"
+  status          = "ACTIVE"
+  description     = "Terraform Acceptance Test SignOn Policy"
+  groups_included = [data.okta_group.all.id]
+}
+
+data "okta_behavior" "new_city" {
+  name = "New City"
+}
+
+resource "okta_network_zone" "test" {
+  name     = "testAcc_replace_with_uuid"
+  type     = "IP"
+  gateways = ["1.2.3.4/24", "2.3.4.5-2.3.4.15"]
+  proxies  = ["2.2.3.4/24", "3.3.4.5-3.3.4.15"]
+}
+
+resource "okta_idp_social" "test" {
+  type              = "GOOGLE"
+  protocol_type     = "OAUTH2"
+  name              = "testAcc_google_replace_with_uuid"
+  scopes            = ["profile", "email", "openid"]
+  client_id         = "abcd123"
+  client_secret     = "abcd123"
+  username_template = "idpuser.email"
+}
+
+resource "okta_policy_rule_signon" "test" {
+  policy_id             = okta_policy_signon.test.id
+  name                  = "testAcc_replace_with_uuid"
+  status                = "ACTIVE"
+  priority              = 1
+  access                = "ALLOW"
+  network_connection    = "ZONE"
+  network_includes      = [okta_network_zone.test.id]
+  identity_provider     = "SPECIFIC_IDP"
+  identity_provider_ids = [okta_idp_social.test.id]
+  behaviors             = [data.okta_behavior.new_city.id]
+  risc_level            = "HIGH"
+  mfa_required          = true
+  mfa_prompt            = "DEVICE"
+  mfa_remember_device   = true
+  session_idle          = 60
+  session_lifetime      = 480
+  session_persistent    = true
+}
@@ -0,0 +1,17 @@
+data "okta_group" "all" {
+  name = "Everyone"
+}
+
+resource "okta_policy_signon" "test" {
+  name            = "testAcc_replace_with_uuid"
+  status          = "ACTIVE"
+  description     = "Terraform Acceptance Test SignOn Policy"
+  groups_included = [data.okta_group.all.id]
+}
+
+resource "okta_policy_rule_signon" "test" {
+  policy_id                         = okta_policy_signon.test.id
+  name                              = "testAcc_replace_with_uuid"
+  status                            = "ACTIVE"
+  require_phishing_resistant_factor = true
+}
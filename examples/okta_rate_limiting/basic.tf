@@ -0,0 +1,7 @@
+resource "okta_rate_limiting" "test" {
+  default_mode           = "ENFORCE_AND_LOG"
+  login                  = "PREVIEW"
+  authorize              = "ENFORCE_AND_LOG"
+  communications_enabled = true
+  warning_threshold      = 90
+}
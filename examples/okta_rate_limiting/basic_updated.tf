@@ -0,0 +1,7 @@
+resource "okta_rate_limiting" "test" {
+  default_mode           = "ENFORCE_AND_LOG"
+  login                  = "ENFORCE_AND_LOG"
+  authorize              = "PREVIEW"
+  communications_enabled = false
+  warning_threshold      = 60
+}
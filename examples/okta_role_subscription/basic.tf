@@ -0,0 +1,5 @@
+resource "okta_role_subscription" "test" {
+  role_type         = "SUPER_ADMIN"
+  notification_type = "AGENT_AUTO_UPDATE_NOTIFICATION"
+  status            = "unsubscribed"
+}
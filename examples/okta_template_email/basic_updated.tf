@@ -0,0 +1,16 @@
+resource "okta_template_email" "test" {
+  type             = "email.forgotPassword"
+  default_language = "fr"
+
+  translations {
+    language = "en"
+    subject  = "Reset your password"
+    template = "Hi $${user.firstName},<br/><br/>Use this link to reset your password: $${resetPasswordLink}"
+  }
+
+  translations {
+    language = "fr"
+    subject  = "Réinitialisez votre mot de passe"
+    template = "Bonjour $${user.firstName},<br/><br/>Utilisez ce lien pour réinitialiser votre mot de passe : $${resetPasswordLink}"
+  }
+}
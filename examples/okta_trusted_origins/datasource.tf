@@ -0,0 +1,13 @@
+resource "okta_trusted_origin" "test" {
+  name   = "testAcc_replace_with_uuid"
+  origin = "https://example-replace_with_uuid.com"
+  scopes = ["CORS", "REDIRECT"]
+}
+
+data "okta_trusted_origins" "all" {
+  depends_on = [okta_trusted_origin.test]
+}
+
+data "okta_trusted_origins" "test" {
+  filter = "origin eq \"${okta_trusted_origin.test.origin}\""
+}
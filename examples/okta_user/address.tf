@@ -0,0 +1,14 @@
+resource "okta_user" "test" {
+  first_name = "TestAcc"
+  last_name  = "Smith"
+  login      = "testAcc-replace_with_uuid@example.com"
+  email      = "testAcc-replace_with_uuid@example.com"
+
+  address {
+    street  = "1234 Testing St."
+    city    = "New York"
+    state   = "NY"
+    zip     = "11111"
+    country = "US"
+  }
+}
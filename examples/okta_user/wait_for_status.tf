@@ -0,0 +1,9 @@
+resource "okta_user" "test" {
+  first_name              = "TestAcc"
+  last_name               = "Smith"
+  login                   = "testAcc-replace_with_uuid@example.com"
+  email                   = "testAcc-replace_with_uuid@example.com"
+  password                = "Abcd1234"
+  wait_for_status         = "ACTIVE"
+  wait_for_status_timeout = 60
+}
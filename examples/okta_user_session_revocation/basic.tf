@@ -0,0 +1,14 @@
+resource "okta_user" "test" {
+  first_name = "TestAcc"
+  last_name  = "Smith"
+  login      = "testAcc-replace_with_uuid@example.com"
+  email      = "testAcc-replace_with_uuid@example.com"
+}
+
+resource "okta_user_session_revocation" "test" {
+  user_id      = okta_user.test.id
+  oauth_tokens = true
+  triggers = {
+    rotation = "1"
+  }
+}
@@ -7,3 +7,7 @@ resource "okta_user_type" "test" {
 data "okta_user_type" "test" {
   name = okta_user_type.test.name
 }
+
+data "okta_user_types" "test" {
+  depends_on = [okta_user_type.test]
+}
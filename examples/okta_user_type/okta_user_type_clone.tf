@@ -0,0 +1,15 @@
+resource "okta_user_schema" "test" {
+  index       = "testAcc_replace_with_uuid"
+  title       = "terraform acceptance test"
+  type        = "string"
+  description = "terraform acceptance test"
+}
+
+resource "okta_user_type" "test" {
+  name              = "testAcc_replace_with_uuid"
+  display_name      = "Terraform Acceptance Test User Type"
+  description       = "Terraform Acceptance Test User Type"
+  clone_schema_from = "default"
+
+  depends_on = [okta_user_schema.test]
+}
@@ -2,6 +2,9 @@
 package main
 
 import (
+	"context"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 	"github.com/okta/terraform-provider-okta/okta"
 )
@@ -10,4 +13,8 @@ func main() {
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: okta.Provider,
 	})
+	// Terraform waits a couple of seconds for the plugin to exit before killing it
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	okta.ShutdownTracing(ctx)
 }
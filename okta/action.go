@@ -0,0 +1,49 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// actionFunc runs the operation of an action resource and returns the ID of the resource
+type actionFunc func(ctx context.Context, d *schema.ResourceData, m interface{}) (string, error)
+
+// buildActionResource builds a one-shot resource, which runs the action when it is created. Like 'null_resource',
+// the action is run again whenever the resource is replaced, i.e. when any of the 'triggers' or of the arguments
+// change. Nothing is read back from Okta, and destroying the resource only removes it from the state.
+func buildActionResource(description string, target map[string]*schema.Schema, action actionFunc) *schema.Resource {
+	s := map[string]*schema.Schema{
+		"triggers": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			ForceNew:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Arbitrary map of values that, when changed, will run the action again.",
+		},
+	}
+	for k, v := range target {
+		if v.Optional || v.Required {
+			v.ForceNew = true
+		}
+		s[k] = v
+	}
+	return &schema.Resource{
+		Description: description,
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			id, err := action(ctx, d, m)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			d.SetId(id)
+			return nil
+		},
+		ReadContext: schema.NoopContext,
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			d.SetId("")
+			return nil
+		},
+		Schema: s,
+	}
+}
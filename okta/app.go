@@ -8,13 +8,21 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"math"
 	"net/http"
 	"os"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/okta-sdk-golang/v2/okta"
 	"github.com/okta/okta-sdk-golang/v2/okta/query"
+	"github.com/okta/terraform-provider-okta/sdk"
 )
 
 var appUserResource = &schema.Resource{
@@ -72,6 +80,18 @@ var baseAppSchema = map[string]*schema.Schema{
 		Description: "Groups associated with the application",
 		Deprecated:  "The direct configuration of groups in this app resource is deprecated, please ensure you use the resource `okta_app_group_assignments` for this functionality.",
 	},
+	"skip_users": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Ignore users sync. Use it when user assignments are managed elsewhere.",
+	},
+	"skip_groups": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Ignore groups sync. Use it when group assignments are managed elsewhere.",
+	},
 	"status": {
 		Type:             schema.TypeString,
 		Optional:         true,
@@ -100,6 +120,40 @@ var baseAppSchema = map[string]*schema.Schema{
 		Computed:    true,
 		Description: "URL of the application's logo",
 	},
+	"accessibility_self_service": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Enable self service",
+	},
+	"accessibility_error_redirect_url": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "Custom error page URL",
+		ValidateDiagFunc: stringIsURL(validURLSchemes...),
+	},
+	"accessibility_login_redirect_url": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "Custom login page URL",
+		ValidateDiagFunc: stringIsURL(validURLSchemes...),
+	},
+	"authentication_policy": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "ID of the app sign-on (access) policy assigned to the application.",
+	},
+	"admin_note": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Application notes for admins.",
+	},
+	"enduser_note": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Application notes for end users.",
+	},
 }
 
 var appVisibilitySchema = map[string]*schema.Schema{
@@ -124,17 +178,6 @@ var appVisibilitySchema = map[string]*schema.Schema{
 }
 
 var baseAppSwaSchema = map[string]*schema.Schema{
-	"accessibility_self_service": {
-		Type:        schema.TypeBool,
-		Optional:    true,
-		Default:     false,
-		Description: "Enable self service",
-	},
-	"accessibility_error_redirect_url": {
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "Custom error page URL",
-	},
 	"auto_submit_toolbar": {
 		Type:        schema.TypeBool,
 		Optional:    true,
@@ -179,16 +222,51 @@ var appSamlDiffSuppressFunc = func(k, old, new string, d *schema.ResourceData) b
 }
 
 // Wish there was some better polymorphism that could make these similarities easier to deal with
-func appRead(d *schema.ResourceData, name, status, signOn, label string, accy *okta.ApplicationAccessibility, vis *okta.ApplicationVisibility) {
+// appRead sets the common app attributes. It returns warnings if Okta has changed the catalog integration
+// of the app (e.g. upgraded the OIN app to another version with a different name or sign-on mode), since
+// such upgrades can change the behavior of the app without any change in the configuration.
+func appRead(d *schema.ResourceData, name, status, signOn, label string, accy *okta.ApplicationAccessibility, vis *okta.ApplicationVisibility) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, kv := range [][2]string{{"name", name}, {"sign_on_mode", signOn}} {
+		key, value := kv[0], kv[1]
+		old := d.Get(key).(string)
+		if old != "" && old != value {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Application catalog integration changed",
+				Detail: fmt.Sprintf("The '%s' of the application '%s' has been changed from '%s' to '%s' outside of Terraform, "+
+					"most likely Okta has upgraded the integration in the OIN catalog. Review the application settings.", key, d.Id(), old, value),
+			})
+		}
+	}
 	_ = d.Set("name", name)
 	_ = d.Set("status", status)
 	_ = d.Set("sign_on_mode", signOn)
 	_ = d.Set("label", label)
-	_ = d.Set("accessibility_self_service", *accy.SelfService)
-	_ = d.Set("accessibility_error_redirect_url", accy.ErrorRedirectUrl)
+	setAppAccessibility(d, accy)
 	_ = d.Set("auto_submit_toolbar", vis.AutoSubmitToolbar)
 	_ = d.Set("hide_ios", vis.Hide.IOS)
 	_ = d.Set("hide_web", vis.Hide.Web)
+	return diags
+}
+
+func setAppAccessibility(d *schema.ResourceData, accy *okta.ApplicationAccessibility) {
+	if accy == nil {
+		return
+	}
+	if accy.SelfService != nil {
+		_ = d.Set("accessibility_self_service", *accy.SelfService)
+	}
+	_ = d.Set("accessibility_error_redirect_url", accy.ErrorRedirectUrl)
+	_ = d.Set("accessibility_login_redirect_url", accy.LoginRedirectUrl)
+}
+
+func buildAppAccessibility(d *schema.ResourceData) *okta.ApplicationAccessibility {
+	return &okta.ApplicationAccessibility{
+		SelfService:      boolPtr(d.Get("accessibility_self_service").(bool)),
+		ErrorRedirectUrl: d.Get("accessibility_error_redirect_url").(string),
+		LoginRedirectUrl: d.Get("accessibility_login_redirect_url").(string),
+	}
 }
 
 func buildAppSchema(appSchema map[string]*schema.Schema) map[string]*schema.Schema {
@@ -217,6 +295,38 @@ func buildSchemeCreds(d *schema.ResourceData) *okta.SchemeApplicationCredentials
 	}
 }
 
+// appSwaCredentialsConflict returns why Okta rejects the credentials scheme along with the username template type,
+// or an empty string if they can be used together.
+func appSwaCredentialsConflict(scheme, templateType string) string {
+	if scheme == "EDIT_PASSWORD_ONLY" && templateType == "CUSTOM" {
+		return "the 'CUSTOM' username template can't be used when the users only set their passwords, " +
+			"set 'user_name_template_type' to 'BUILT_IN' or use the 'ADMIN_SETS_CREDENTIALS' credentials scheme"
+	}
+	return ""
+}
+
+// warnAppSwaCredentialsConflict warns during the plan about the credentials scheme and the username template type
+// that Okta rejects with a generic bad request error.
+func warnAppSwaCredentialsConflict(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("credentials_scheme") || !d.NewValueKnown("user_name_template_type") {
+		return nil
+	}
+	conflict := appSwaCredentialsConflict(d.Get("credentials_scheme").(string), d.Get("user_name_template_type").(string))
+	if conflict != "" {
+		logger(m).Warn("the credentials of the app are likely to be rejected by Okta", "reason", conflict)
+	}
+	return nil
+}
+
+// appSwaCredentialsError adds the reason of the conflicting credentials settings to the error of the app request
+func appSwaCredentialsError(d *schema.ResourceData, err error) error {
+	conflict := appSwaCredentialsConflict(d.Get("credentials_scheme").(string), d.Get("user_name_template_type").(string))
+	if conflict == "" {
+		return err
+	}
+	return fmt.Errorf("%v: %s", err, conflict)
+}
+
 func buildAppSwaSchema(appSchema map[string]*schema.Schema) map[string]*schema.Schema {
 	return buildSchema(baseAppSchema, baseAppSwaSchema, appSchema)
 }
@@ -235,8 +345,12 @@ func buildVisibility(d *schema.ResourceData) *okta.ApplicationVisibility {
 	}
 }
 
-func fetchApp(ctx context.Context, d *schema.ResourceData, m interface{}, app okta.App) error {
-	return fetchAppByID(ctx, d.Id(), m, app)
+// fetchApp gets the app, the returned document holds the app fields missing from the app model, see sdk.RawApp
+func fetchApp(ctx context.Context, d *schema.ResourceData, m interface{}, app okta.App) (sdk.RawApp, error) {
+	rawApp, resp, err := getSupplementFromMetadata(m).GetApp(ctx, d.Id(), app)
+	// We don't want to consider a 404 an error in some cases and thus the delineation.
+	// Check if app's ID is set to ensure that app exists
+	return rawApp, suppressErrorOn404(resp, err)
 }
 
 func fetchAppByID(ctx context.Context, id string, m interface{}, app okta.App) error {
@@ -252,6 +366,26 @@ func updateAppByID(ctx context.Context, id string, m interface{}, app okta.App)
 	return suppressErrorOn404(resp, err)
 }
 
+// appStepKeys are the app attributes applied by the requests following the app create or update, see resourceSteps
+var appStepKeys = []string{"status", "groups", "users", "skip_groups", "skip_users", "logo", "authentication_policy"}
+
+// createApp creates the app with its notes and the given app fields missing from the app model in a single request
+func createApp(ctx context.Context, d *schema.ResourceData, m interface{}, app okta.App, fields ...sdk.AppFields) error {
+	activate := d.Get("status").(string) == statusActive
+	return stepsFrom(ctx).runMain(func() error {
+		_, _, err := getSupplementFromMetadata(m).CreateApp(ctx, app, activate, append(fields, appNotes(d))...)
+		return err
+	}, "status")
+}
+
+// updateApp updates the app with its notes and the given app fields missing from the app model in a single request
+func updateApp(ctx context.Context, d *schema.ResourceData, m interface{}, app okta.App, fields ...sdk.AppFields) error {
+	return stepsFrom(ctx).runMain(func() error {
+		_, _, err := getSupplementFromMetadata(m).UpdateApp(ctx, d.Id(), app, append(fields, appNotes(d))...)
+		return err
+	})
+}
+
 func handleAppGroups(ctx context.Context, id string, d *schema.ResourceData, client *okta.Client) []func() error {
 	existingGroups, _ := listApplicationGroupAssignments(ctx, client, id)
 	var (
@@ -346,22 +480,88 @@ func handleAppGroupsAndUsers(ctx context.Context, id string, d *schema.ResourceD
 	resultChan := make(chan []*result, 1)
 	client := getOktaClientFromMetadata(m)
 
-	groupHandlers := handleAppGroups(ctx, id, d, client)
-	userHandlers := handleAppUsers(ctx, id, d, client)
+	var handlers []func() error
+	if !d.Get("skip_groups").(bool) {
+		handlers = append(handlers, handleAppGroups(ctx, id, d, client)...)
+	}
+	if !d.Get("skip_users").(bool) {
+		handlers = append(handlers, handleAppUsers(ctx, id, d, client)...)
+	}
+	if len(handlers) == 0 {
+		return nil
+	}
+	// do not start the remaining requests once the operation's deadline is exceeded
+	for i := range handlers {
+		handler := handlers[i]
+		handlers[i] = func() error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			return handler()
+		}
+	}
 	con := getParallelismFromMetadata(m)
-	promiseAll(con, &wg, resultChan, append(groupHandlers, userHandlers...)...)
+	promiseAll(con, &wg, resultChan, handlers...)
 	wg.Wait()
 
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("failed to associate user or groups with application within the operation timeout, "+
+			"consider increasing the create or update timeout of the resource: %v", err)
+	}
 	return getPromiseError(<-resultChan, "failed to associate user or groups with application")
 }
 
+// handleAppAssignments assigns the groups and users to the app as a step of its create or update
+func handleAppAssignments(ctx context.Context, id string, d *schema.ResourceData, m interface{}) error {
+	return stepsFrom(ctx).run([]string{"groups", "users", "skip_groups", "skip_users"}, func() error {
+		return handleAppGroupsAndUsers(ctx, id, d, m)
+	})
+}
+
 func handleAppLogo(ctx context.Context, d *schema.ResourceData, m interface{}, appID string, links interface{}) error {
-	l, ok := d.GetOk("logo")
-	if !ok {
-		return nil
+	return stepsFrom(ctx).run([]string{"logo"}, func() error {
+		l, ok := d.GetOk("logo")
+		if !ok {
+			return nil
+		}
+		_, err := getSupplementFromMetadata(m).UploadAppLogo(ctx, appID, l.(string))
+		return err
+	})
+}
+
+// appNotes sets the admin and end user notes of the app, which the app models lack
+func appNotes(d *schema.ResourceData) sdk.AppFields {
+	return sdk.WithAppNotes(&sdk.AppNotes{
+		Admin:   d.Get("admin_note").(string),
+		Enduser: d.Get("enduser_note").(string),
+	})
+}
+
+func setAppNotes(d *schema.ResourceData, rawApp sdk.RawApp) {
+	notes := rawApp.Notes()
+	_ = d.Set("admin_note", notes.Admin)
+	_ = d.Set("enduser_note", notes.Enduser)
+}
+
+// handleAppAuthenticationPolicy assigns the app sign-on (access) policy. Okta assigns the default policy
+// to every app in Identity Engine orgs, so it is only changed when configured explicitly.
+func handleAppAuthenticationPolicy(ctx context.Context, d *schema.ResourceData, m interface{}, appID string) error {
+	return stepsFrom(ctx).run([]string{"authentication_policy"}, func() error {
+		policyID, ok := d.GetOk("authentication_policy")
+		if !ok || !d.HasChange("authentication_policy") {
+			return nil
+		}
+		_, err := getSupplementFromMetadata(m).SetAppAuthenticationPolicy(ctx, appID, policyID.(string))
+		return err
+	})
+}
+
+func setAppAuthenticationPolicy(d *schema.ResourceData, links interface{}) {
+	href := linksValue(links, "accessPolicy", "href")
+	if href == "" {
+		return
 	}
-	_, err := getSupplementFromMetadata(m).UploadAppLogo(ctx, appID, l.(string))
-	return err
+	_ = d.Set("authentication_policy", path.Base(href))
 }
 
 func handleAppUsers(ctx context.Context, id string, d *schema.ResourceData, client *okta.Client) []func() error {
@@ -449,65 +649,135 @@ func listApplicationUsers(ctx context.Context, client *okta.Client, id string) (
 }
 
 func setAppStatus(ctx context.Context, d *schema.ResourceData, client *okta.Client, status string) error {
-	desiredStatus := d.Get("status").(string)
-	if status == desiredStatus {
-		return nil
-	}
-	if desiredStatus == statusInactive {
-		return responseErr(client.Application.DeactivateApplication(ctx, d.Id()))
-	}
-	return responseErr(client.Application.ActivateApplication(ctx, d.Id()))
+	return stepsFrom(ctx).run([]string{"status"}, func() error {
+		desiredStatus := d.Get("status").(string)
+		if status == desiredStatus {
+			return nil
+		}
+		if desiredStatus == statusInactive {
+			return responseErr(client.Application.DeactivateApplication(ctx, d.Id()))
+		}
+		return responseErr(client.Application.ActivateApplication(ctx, d.Id()))
+	})
 }
 
 func syncGroupsAndUsers(ctx context.Context, id string, d *schema.ResourceData, m interface{}) error {
 	ctx = context.WithValue(ctx, retryOnStatusCodes, []int{http.StatusNotFound})
 	client := getOktaClientFromMetadata(m)
-	// Temporary high limit to avoid issues short term. Need to support pagination here
-	userList, _, err := client.Application.ListApplicationUsers(ctx, id, &query.Params{Limit: defaultPaginationLimit})
-	if err != nil {
-		return fmt.Errorf("failed to list application users: %v", err)
-	}
-	// Temporary high limit to avoid issues short term. Need to support pagination here
-	groupList, _, err := client.Application.ListApplicationGroupAssignments(ctx, id, &query.Params{Limit: defaultPaginationLimit})
-	if err != nil {
-		return fmt.Errorf("failed to list application group assignments: %v", err)
-	}
-	flatGroupList := make([]interface{}, len(groupList))
-
-	for i, g := range groupList {
-		flatGroupList[i] = g.Id
-	}
-
-	var flattenedUserList []interface{}
-
-	for _, user := range userList {
-		if user.Scope == userScope {
-			var un, up string
-			if user.Credentials != nil {
-				un = user.Credentials.UserName
-				if user.Credentials.Password != nil {
-					up = user.Credentials.Password.Value
+	flatMap := map[string]interface{}{}
+	if !d.Get("skip_users").(bool) {
+		// Temporary high limit to avoid issues short term. Need to support pagination here
+		userList, _, err := client.Application.ListApplicationUsers(ctx, id, &query.Params{Limit: defaultPaginationLimit})
+		if err != nil {
+			return fmt.Errorf("failed to list application users: %v", err)
+		}
+		var flattenedUserList []interface{}
+		for _, user := range userList {
+			if user.Scope == userScope {
+				var un, up string
+				if user.Credentials != nil {
+					un = user.Credentials.UserName
+					if user.Credentials.Password != nil {
+						up = user.Credentials.Password.Value
+					}
 				}
+				flattenedUserList = append(flattenedUserList, map[string]interface{}{
+					"id":       user.Id,
+					"username": un,
+					"scope":    user.Scope,
+					"password": up,
+				})
 			}
-			flattenedUserList = append(flattenedUserList, map[string]interface{}{
-				"id":       user.Id,
-				"username": un,
-				"scope":    user.Scope,
-				"password": up,
-			})
+		}
+		if len(flattenedUserList) > 0 {
+			flatMap["users"] = schema.NewSet(schema.HashResource(appUserResource), flattenedUserList)
 		}
 	}
-	flatMap := map[string]interface{}{}
+	if !d.Get("skip_groups").(bool) {
+		// Temporary high limit to avoid issues short term. Need to support pagination here
+		groupList, _, err := client.Application.ListApplicationGroupAssignments(ctx, id, &query.Params{Limit: defaultPaginationLimit})
+		if err != nil {
+			return fmt.Errorf("failed to list application group assignments: %v", err)
+		}
+		flatGroupList := make([]interface{}, len(groupList))
+		for i, g := range groupList {
+			flatGroupList[i] = g.Id
+		}
+		if len(flatGroupList) > 0 {
+			flatMap["groups"] = schema.NewSet(schema.HashString, flatGroupList)
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("failed to sync application users and groups within the operation timeout, "+
+			"consider increasing the read timeout of the resource: %v", err)
+	}
+	return setNonPrimitives(d, flatMap)
+}
 
-	if len(flattenedUserList) > 0 {
-		flatMap["users"] = schema.NewSet(schema.HashResource(appUserResource), flattenedUserList)
+// appTimeouts are the default operation timeouts of the app resources. Syncing apps with many user and group
+// assignments may take longer than the default timeouts, in which case they can be increased per resource.
+func appTimeouts() *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(time.Hour),
+		Read:   schema.DefaultTimeout(time.Hour),
+		Update: schema.DefaultTimeout(time.Hour),
 	}
+}
 
-	if len(flatGroupList) > 0 {
-		flatMap["groups"] = schema.NewSet(schema.HashString, flatGroupList)
+// suppressAppSettingsJSONDiff ignores the app settings when they are not configured, along with the formatting
+// of the JSON
+func suppressAppSettingsJSONDiff(_, old, new string, _ *schema.ResourceData) bool {
+	if new == "" {
+		return true
 	}
+	var oldSettings, newSettings map[string]interface{}
+	if json.Unmarshal([]byte(old), &oldSettings) != nil || json.Unmarshal([]byte(new), &newSettings) != nil {
+		return false
+	}
+	return reflect.DeepEqual(oldSettings, newSettings)
+}
 
-	return setNonPrimitives(d, flatMap)
+// validateAppSettingsJSONDiff checks the types of the configured 'app_settings_json' values against the settings
+// of the existing app, so that a mistyped setting is reported during the plan rather than failing the update.
+// New apps and settings the app doesn't have yet are not validated, neither is the app if it can't be fetched.
+func validateAppSettingsJSONDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || !d.HasChange("app_settings_json") || !d.NewValueKnown("app_settings_json") {
+		return nil
+	}
+	var settings map[string]interface{}
+	if err := json.Unmarshal([]byte(d.Get("app_settings_json").(string)), &settings); err != nil {
+		// invalid JSON is reported by the attribute validation
+		return nil
+	}
+	app := okta.NewSamlApplication()
+	_, _, err := getSupplementFromMetadata(m).GetApp(ctx, d.Id(), app)
+	if err != nil {
+		logger(m).Warn("failed to get app, skipping app settings validation", "app_id", d.Id(), "error", err)
+		return nil
+	}
+	if app.Settings == nil || app.Settings.App == nil {
+		return nil
+	}
+	current := *app.Settings.App
+	keys := make([]string, 0, len(settings))
+	for key := range settings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var errs []string
+	for _, key := range keys {
+		value, currentValue := settings[key], current[key]
+		if value == nil || currentValue == nil {
+			continue
+		}
+		if expected := jsonTypeName(currentValue); !isValueOfSchemaType(expected, value) {
+			errs = append(errs, fmt.Sprintf("'%s' must be of type '%s', got %s", key, expected, jsonTypeName(value)))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("app_settings_json does not match the settings of the app: %s", strings.Join(errs, "; "))
+	}
+	return nil
 }
 
 // setAppSettings available preconfigured SAML and OAuth applications vary wildly on potential app settings, thus
@@ -541,6 +811,7 @@ func setSamlSettings(d *schema.ResourceData, signOn *okta.SamlApplicationSetting
 	_ = d.Set("signature_algorithm", signOn.SignatureAlgorithm)
 	_ = d.Set("digest_algorithm", signOn.DigestAlgorithm)
 	_ = d.Set("honor_force_authn", signOn.HonorForceAuthn)
+	_ = d.Set("request_compressed", signOn.RequestCompressed)
 	_ = d.Set("authn_context_class_ref", signOn.AuthnContextClassRef)
 	if signOn.AllowMultipleAcsEndpoints != nil {
 		if *signOn.AllowMultipleAcsEndpoints {
@@ -628,3 +899,145 @@ func computeFileHash(filename string) string {
 	}
 	return hex.EncodeToString(h.Sum(nil))
 }
+
+// getAppUserSchemaCached fetches app user schema once per provider run, since the same app schema is
+// needed to validate profiles of every user and group assigned to the app.
+func getAppUserSchemaCached(ctx context.Context, m interface{}, appID string) (*sdk.UserSchema, error) {
+	config := m.(*Config)
+	config.appUserSchemasLock.Lock()
+	defer config.appUserSchemasLock.Unlock()
+	if us, ok := config.appUserSchemas[appID]; ok {
+		return us, nil
+	}
+	us, resp, err := getSupplementFromMetadata(m).GetAppUserSchema(ctx, appID)
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return nil, err
+	}
+	if is404(resp) {
+		us = nil
+	}
+	if config.appUserSchemas == nil {
+		config.appUserSchemas = make(map[string]*sdk.UserSchema)
+	}
+	config.appUserSchemas[appID] = us
+	return us, nil
+}
+
+// validateAppProfileDiff validates 'profile' of the resources assigning users or groups to an app
+func validateAppProfileDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if (!d.HasChange("app_id") && !d.HasChange("profile")) || !d.NewValueKnown("app_id") || !d.NewValueKnown("profile") {
+		return nil
+	}
+	return validateAppProfile(ctx, m, d.Get("app_id").(string), d.Get("profile").(string))
+}
+
+// validateAppProfile checks the types of the values in the assignment profile JSON against the app user schema.
+// Attributes missing from the schema are skipped, since they might be added by 'okta_app_user_schema_property'
+// resources during the same apply. Validation is also skipped if the schema can not be fetched.
+func validateAppProfile(ctx context.Context, m interface{}, appID, rawProfile string) error {
+	if appID == "" || rawProfile == "" {
+		return nil
+	}
+	var profile map[string]interface{}
+	if err := json.Unmarshal([]byte(rawProfile), &profile); err != nil {
+		// value is either unknown or invalid, the latter is reported by the attribute validation
+		return nil
+	}
+	us, err := getAppUserSchemaCached(ctx, m, appID)
+	if err != nil {
+		logger(m).Warn("failed to get app user schema, skipping profile validation", "app_id", appID, "error", err)
+		return nil
+	}
+	if us == nil || us.Definitions == nil {
+		return nil
+	}
+	keys := make([]string, 0, len(profile))
+	for key := range profile {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var errs []string
+	for _, key := range keys {
+		attr := appUserSchemaAttribute(us, key)
+		if attr == nil {
+			continue
+		}
+		if err := validateAppProfileValue(attr, profile[key]); err != nil {
+			errs = append(errs, fmt.Sprintf("'%s' %v", key, err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("profile does not match the user schema of the app '%s': %s", appID, strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func appUserSchemaAttribute(us *sdk.UserSchema, key string) *sdk.UserSubSchema {
+	for _, def := range []*sdk.UserSubSchemaProperties{us.Definitions.Base, us.Definitions.Custom} {
+		if def == nil {
+			continue
+		}
+		if attr, ok := def.Properties[key]; ok {
+			return attr
+		}
+	}
+	return nil
+}
+
+func validateAppProfileValue(attr *sdk.UserSubSchema, value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	if !isValueOfSchemaType(attr.Type, value) {
+		return fmt.Errorf("must be of type '%s', got %s", attr.Type, jsonTypeName(value))
+	}
+	if attr.Type != "array" || attr.Items == nil {
+		return nil
+	}
+	for i, item := range value.([]interface{}) {
+		if item != nil && !isValueOfSchemaType(attr.Items.Type, item) {
+			return fmt.Errorf("item %d must be of type '%s', got %s", i, attr.Items.Type, jsonTypeName(item))
+		}
+	}
+	return nil
+}
+
+func isValueOfSchemaType(schemaType string, value interface{}) bool {
+	switch schemaType {
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "integer":
+		v, ok := value.(float64)
+		return ok && v == math.Trunc(v)
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	}
+	return true
+}
+
+func jsonTypeName(value interface{}) string {
+	switch value.(type) {
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("%T", value)
+}
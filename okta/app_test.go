@@ -3,7 +3,13 @@ package okta
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
 )
 
 func deleteTestApps(client *testClient) error {
@@ -34,3 +40,129 @@ func deleteTestApps(client *testClient) error {
 	}
 	return nil
 }
+
+func TestValidateAppProfileValue(t *testing.T) {
+	tests := []struct {
+		attr     *sdk.UserSubSchema
+		value    interface{}
+		expected bool
+	}{
+		{&sdk.UserSubSchema{Type: "string"}, "foo", true},
+		{&sdk.UserSubSchema{Type: "string"}, float64(1), false},
+		{&sdk.UserSubSchema{Type: "boolean"}, true, true},
+		{&sdk.UserSubSchema{Type: "boolean"}, "true", false},
+		{&sdk.UserSubSchema{Type: "integer"}, float64(10), true},
+		{&sdk.UserSubSchema{Type: "integer"}, 1.5, false},
+		{&sdk.UserSubSchema{Type: "number"}, 1.5, true},
+		{&sdk.UserSubSchema{Type: "array", Items: &sdk.UserSchemaItem{Type: "string"}}, []interface{}{"a", "b"}, true},
+		{&sdk.UserSubSchema{Type: "array", Items: &sdk.UserSchemaItem{Type: "string"}}, []interface{}{"a", true}, false},
+		{&sdk.UserSubSchema{Type: "array"}, "a", false},
+		{&sdk.UserSubSchema{Type: "string"}, nil, true},
+	}
+	for _, test := range tests {
+		err := validateAppProfileValue(test.attr, test.value)
+		if (err == nil) != test.expected {
+			t.Errorf("validateAppProfileValue test failed, type %s, value %v, expected valid %t, error %v", test.attr.Type, test.value, test.expected, err)
+		}
+	}
+}
+
+func TestAppSwaCredentialsConflict(t *testing.T) {
+	tests := []struct {
+		scheme       string
+		templateType string
+		conflict     bool
+	}{
+		{"EDIT_PASSWORD_ONLY", "CUSTOM", true},
+		{"EDIT_USERNAME_AND_PASSWORD", "CUSTOM", false},
+		{"SHARED_USERNAME_AND_PASSWORD", "CUSTOM", false},
+		{"ADMIN_SETS_CREDENTIALS", "CUSTOM", false},
+		{"EDIT_PASSWORD_ONLY", "BUILT_IN", false},
+		{"", "CUSTOM", false},
+	}
+	for _, test := range tests {
+		t.Run(test.scheme+"_"+test.templateType, func(t *testing.T) {
+			if got := appSwaCredentialsConflict(test.scheme, test.templateType) != ""; got != test.conflict {
+				t.Errorf("expected conflict %v, got %v", test.conflict, got)
+			}
+		})
+	}
+}
+
+func TestSuppressAppSettingsJSONDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		old      string
+		new      string
+		suppress bool
+	}{
+		{"not configured", `{"url":"https://example.com"}`, "", true},
+		{"formatting", `{"url":"https://example.com","port":8080}`, `{ "port": 8080, "url": "https://example.com" }`, true},
+		{"removed", `{"url":"https://example.com","buttonField":"btn"}`, `{"url":"https://example.com"}`, false},
+		{"changed", `{"url":"https://example.com"}`, `{"url":"https://example.org"}`, false},
+		{"added", `{"url":"https://example.com"}`, `{"url":"https://example.com","port":8080}`, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := suppressAppSettingsJSONDiff("app_settings_json", test.old, test.new, nil); got != test.suppress {
+				t.Errorf("expected suppress %v, got %v", test.suppress, got)
+			}
+		})
+	}
+}
+
+// Every app resource reports the changes of the catalog integration made outside of Terraform as warnings
+func TestAppReadCatalogChangeWarning(t *testing.T) {
+	visibility := `"visibility":{"autoSubmitToolbar":false,"hide":{"iOS":false,"web":false}}`
+	tests := []struct {
+		name     string
+		resource func() *schema.Resource
+		app      string
+	}{
+		{
+			name:     "bookmark",
+			resource: resourceAppBookmark,
+			app: `{"id":"0oa1","name":"bookmark","label":"test","status":"ACTIVE","signOnMode":"BOOKMARK",` + visibility +
+				`,"settings":{"app":{"url":"https://example.com"}}}`,
+		},
+		{
+			name:     "basic_auth",
+			resource: resourceAppBasicAuth,
+			app: `{"id":"0oa1","name":"template_basic_auth","label":"test","status":"ACTIVE","signOnMode":"BASIC_AUTH",` + visibility +
+				`,"settings":{"app":{"url":"https://example.com","authURL":"https://example.com/login"}}}`,
+		},
+		{
+			name:     "oauth",
+			resource: resourceAppOAuth,
+			app: `{"id":"0oa1","name":"oidc_client","label":"test","status":"ACTIVE","signOnMode":"OPENID_CONNECT",` + visibility +
+				`,"credentials":{"oauthClient":{"client_id":"abc","token_endpoint_auth_method":"client_secret_basic"}}` +
+				`,"settings":{"oauthClient":{"application_type":"web","response_types":["code"],"grant_types":["authorization_code"]}}}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := newTestOktaClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case r.URL.Path == "/api/v1/apps/0oa1":
+					_, _ = w.Write([]byte(test.app))
+				case strings.HasPrefix(r.URL.Path, "/api/v1/internal/"):
+					_, _ = w.Write([]byte(`{}`))
+				default:
+					_, _ = w.Write([]byte(`[]`))
+				}
+			}))
+			r := test.resource()
+			d := r.TestResourceData()
+			d.SetId("0oa1")
+			_ = d.Set("name", "previous_name")
+			diags := r.ReadContext(context.Background(), d, m)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if len(diags) != 1 || diags[0].Severity != diag.Warning || !strings.Contains(diags[0].Detail, "'previous_name'") {
+				t.Errorf("expected a warning about the changed name, got %v", diags)
+			}
+		})
+	}
+}
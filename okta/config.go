@@ -1,9 +1,14 @@
 package okta
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/go-cleanhttp"
@@ -19,38 +24,247 @@ func (adt *AddHeaderTransport) RoundTrip(req *http.Request) (*http.Response, err
 	return adt.T.RoundTrip(req)
 }
 
+// RoundTrip adds the cause of the network restriction to the summary of authentication errors caused by the network
+// restrictions of the API token or the service app, since the cause is otherwise only reported in the error causes.
+// The cause may name the IP address Okta saw the request coming from, which is usually the public address of a CI
+// runner and not a local one.
+func (aet *AuthErrorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := aet.T.RoundTrip(req)
+	if err != nil || (resp.StatusCode != http.StatusUnauthorized && resp.StatusCode != http.StatusForbidden) {
+		return resp, err
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	var oErr map[string]interface{}
+	if err := json.Unmarshal(body, &oErr); err == nil {
+		if causes := networkRestrictionCauses(oErr); len(causes) > 0 {
+			oErr["errorSummary"] = fmt.Sprintf("%v (the request was rejected by the network restrictions of the API "+
+				"token or the service app: %s, make sure the IP address the request comes from is allowed)",
+				oErr["errorSummary"], strings.Join(causes, "; "))
+			body, _ = json.Marshal(oErr)
+		}
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	resp.Header.Del("Content-Length")
+	return resp, nil
+}
+
+// networkRestrictionErrorCodes are the codes of the Okta API errors returned for the requests rejected because of
+// the network they came from, Okta puts the details of the restriction into the error causes.
+var networkRestrictionErrorCodes = map[string]bool{
+	"E0000006": true, // access denied
+	"E0000011": true, // invalid token
+}
+
+// networkRestrictionCauses returns the causes of the error reporting that the request came from outside the network
+// zones allowed for the API token or the service app. No causes are returned for other errors.
+func networkRestrictionCauses(oErr map[string]interface{}) []string {
+	if code, _ := oErr["errorCode"].(string); !networkRestrictionErrorCodes[code] {
+		return nil
+	}
+	rawCauses, _ := oErr["errorCauses"].([]interface{})
+	var causes []string
+	for _, rawCause := range rawCauses {
+		c, _ := rawCause.(map[string]interface{})
+		summary, _ := c["errorSummary"].(string)
+		lower := strings.ToLower(summary)
+		if strings.Contains(lower, "network zone") || strings.Contains(lower, "allowed zone") {
+			causes = append(causes, summary)
+		}
+	}
+	return causes
+}
+
+// RoundTrip deduplicates identical GET requests made by data sources. Concurrent requests wait for the one in
+// flight, and the completed successful responses are reused until any request that changes the org is made.
+func (ct *CoalescingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		ct.lock.Lock()
+		ct.responses = nil
+		ct.lock.Unlock()
+		return ct.T.RoundTrip(req)
+	}
+	if coalesce, _ := req.Context().Value(coalesceRequests).(bool); !coalesce {
+		return ct.T.RoundTrip(req)
+	}
+	key := req.Header.Get("Accept") + " " + req.URL.String()
+	ct.lock.Lock()
+	if resp, ok := ct.responses[key]; ok {
+		ct.lock.Unlock()
+		return resp.toResponse(req, true), nil
+	}
+	if call, ok := ct.calls[key]; ok {
+		ct.lock.Unlock()
+		<-call.done
+		if call.err != nil {
+			return nil, call.err
+		}
+		return call.resp.toResponse(req, true), nil
+	}
+	call := &coalescedCall{done: make(chan struct{})}
+	if ct.calls == nil {
+		ct.calls = make(map[string]*coalescedCall)
+	}
+	ct.calls[key] = call
+	ct.lock.Unlock()
+
+	call.resp, call.err = ct.roundTrip(req)
+	ct.lock.Lock()
+	delete(ct.calls, key)
+	if call.err == nil && call.resp.statusCode == http.StatusOK {
+		if ct.responses == nil {
+			ct.responses = make(map[string]*coalescedResponse)
+		}
+		ct.responses[key] = call.resp
+	}
+	ct.lock.Unlock()
+	close(call.done)
+	if call.err != nil {
+		return nil, call.err
+	}
+	return call.resp.toResponse(req, false), nil
+}
+
+func (ct *CoalescingTransport) roundTrip(req *http.Request) (*coalescedResponse, error) {
+	resp, err := ct.T.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	return &coalescedResponse{
+		status:     resp.Status,
+		statusCode: resp.StatusCode,
+		proto:      resp.Proto,
+		protoMajor: resp.ProtoMajor,
+		protoMinor: resp.ProtoMinor,
+		header:     resp.Header,
+		body:       body,
+	}, nil
+}
+
+// toResponse makes a response for the request. The rate limit headers are dropped from the responses replayed to the
+// requests that were not sent, since they describe the limits at the time the sent request was made.
+func (cr *coalescedResponse) toResponse(req *http.Request, replayed bool) *http.Response {
+	header := cr.header.Clone()
+	if replayed {
+		for k := range header {
+			if strings.HasPrefix(k, "X-Rate-Limit-") {
+				header.Del(k)
+			}
+		}
+	}
+	return &http.Response{
+		Status:        cr.status,
+		StatusCode:    cr.statusCode,
+		Proto:         cr.proto,
+		ProtoMajor:    cr.protoMajor,
+		ProtoMinor:    cr.protoMinor,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(cr.body)),
+		ContentLength: int64(len(cr.body)),
+		Request:       req,
+	}
+}
+
 type (
 	// AddHeaderTransport used to tack on default headers to outgoing requests
 	AddHeaderTransport struct {
 		T http.RoundTripper
 	}
 
+	// AuthErrorTransport used to explain authentication errors caused by network restrictions
+	AuthErrorTransport struct {
+		T http.RoundTripper
+	}
+
+	// CoalescingTransport used to deduplicate identical GET requests made by data sources within one run
+	CoalescingTransport struct {
+		T         http.RoundTripper
+		lock      sync.Mutex
+		calls     map[string]*coalescedCall
+		responses map[string]*coalescedResponse
+	}
+
+	coalescedCall struct {
+		done chan struct{}
+		resp *coalescedResponse
+		err  error
+	}
+
+	coalescedResponse struct {
+		status     string
+		statusCode int
+		proto      string
+		protoMajor int
+		protoMinor int
+		header     http.Header
+		body       []byte
+	}
+
 	// Config contains our provider schema values and Okta clients
 	Config struct {
-		orgName          string
-		domain           string
-		apiToken         string
-		clientID         string
-		privateKey       string
-		scopes           []string
-		retryCount       int
-		parallelism      int
-		backoff          bool
-		minWait          int
-		maxWait          int
-		logLevel         int
-		requestTimeout   int
-		oktaClient       *okta.Client
-		supplementClient *sdk.ApiSupplement
-		logger           hclog.Logger
+		orgName              string
+		domain               string
+		apiToken             string
+		clientID             string
+		privateKey           string
+		scopes               []string
+		retryCount           int
+		parallelism          int
+		backoff              bool
+		minWait              int
+		maxWait              int
+		logLevel             int
+		requestTimeout       int
+		tracingEndpoint      string
+		validateUserPassword bool
+		oktaClient           *okta.Client
+		supplementClient     *sdk.ApiSupplement
+		logger               hclog.Logger
+
+		// appUserSchemas caches app user schemas fetched during the plan to validate profiles
+		appUserSchemas     map[string]*sdk.UserSchema
+		appUserSchemasLock sync.Mutex
+
+		// passwordPolicies caches the active password policies in priority order, the ID of the Everyone group and
+		// the complexity requirements of the policies to validate passwords
+		passwordPolicies       []*okta.Policy
+		passwordComplexities   map[string]*sdk.PasswordPolicyPasswordSettingsComplexity
+		everyoneGroupID        string
+		passwordPoliciesLoaded bool
+		passwordPoliciesLock   sync.Mutex
 	}
 )
 
+// orgURL returns the base URL of the org, e.g. https://example.okta.com
+func (c *Config) orgURL() string {
+	return fmt.Sprintf("https://%v.%v", c.orgName, c.domain)
+}
+
 func (c *Config) loadAndValidate() error {
 	c.logger = hclog.New(&hclog.LoggerOptions{
 		Level:      hclog.Level(c.logLevel),
 		TimeFormat: "2006/01/02 03:04:05",
 	})
+	// every attempt of the requests is traced, so that the retries on rate limits show up as separate spans
+	traced := func(t http.RoundTripper) http.RoundTripper { return t }
+	if c.tracingEndpoint != "" {
+		tracerProvider, err := newTracerProvider(context.Background(), c.tracingEndpoint)
+		if err != nil {
+			return err
+		}
+		traced = func(t http.RoundTripper) http.RoundTripper {
+			return &TracingTransport{T: t, tracer: tracerProvider.Tracer("github.com/okta/terraform-provider-okta")}
+		}
+	}
 	var httpClient *http.Client
 	if c.backoff {
 		retryableClient := retryablehttp.NewClient()
@@ -58,16 +272,17 @@ func (c *Config) loadAndValidate() error {
 		retryableClient.RetryWaitMax = time.Second * time.Duration(c.maxWait)
 		retryableClient.RetryMax = c.retryCount
 		retryableClient.Logger = c.logger
-		retryableClient.HTTPClient.Transport = logging.NewTransport("Okta", retryableClient.HTTPClient.Transport)
+		retryableClient.HTTPClient.Transport = &AuthErrorTransport{T: logging.NewTransport("Okta", traced(retryableClient.HTTPClient.Transport))}
 		retryableClient.ErrorHandler = errHandler
 		retryableClient.CheckRetry = checkRetry
 		httpClient = retryableClient.StandardClient()
 	} else {
 		httpClient = cleanhttp.DefaultClient()
-		httpClient.Transport = logging.NewTransport("Okta", httpClient.Transport)
+		httpClient.Transport = &AuthErrorTransport{T: logging.NewTransport("Okta", traced(httpClient.Transport))}
 	}
+	httpClient.Transport = &CoalescingTransport{T: httpClient.Transport}
 	setters := []okta.ConfigSetter{
-		okta.WithOrgUrl(fmt.Sprintf("https://%v.%v", c.orgName, c.domain)),
+		okta.WithOrgUrl(c.orgURL()),
 		okta.WithToken(c.apiToken),
 		okta.WithClientId(c.clientID),
 		okta.WithPrivateKey(c.privateKey),
@@ -115,7 +330,15 @@ func errHandler(resp *http.Response, err error, numTries int) (*http.Response, e
 
 type contextKey string
 
-const retryOnStatusCodes contextKey = "retryOnStatusCodes"
+const (
+	retryOnStatusCodes contextKey = "retryOnStatusCodes"
+
+	// coalesceRequests is injected into the context of data source reads to deduplicate their GET requests
+	coalesceRequests contextKey = "coalesceRequests"
+
+	// resumableSteps is injected into the context of creates and updates to record their completed steps
+	resumableSteps contextKey = "resumableSteps"
+)
 
 // Used to make http client retry on provided list of response status codes
 //
@@ -0,0 +1,165 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAuthErrorTransport(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ok":
+			_, _ = w.Write([]byte(`{"errorSummary": "not an error"}`))
+		case "/unauthorized":
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"errorCode": "E0000011", "errorSummary": "Invalid token provided"}`))
+		case "/forbidden":
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errorCode": "E0000006", "errorSummary": "You do not have permission to perform the requested action", "errorCauses": []}`))
+		case "/restricted":
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errorCode": "E0000006", "errorSummary": "You do not have permission to perform the requested action", "errorCauses": [{"errorSummary": "Request from 203.0.113.7 is outside of the allowed zone."}]}`))
+		case "/restricted_token":
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"errorCode": "E0000011", "errorSummary": "Invalid token provided", "errorCauses": [{"errorSummary": "Token is restricted to a network zone"}]}`))
+		case "/forbidden_with_causes":
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errorCode": "E0000006", "errorSummary": "You do not have permission to perform the requested action", "errorCauses": [{"errorSummary": "Missing the okta.users.manage scope"}]}`))
+		}
+	}))
+	defer ts.Close()
+	client := &http.Client{Transport: &AuthErrorTransport{T: http.DefaultTransport}}
+
+	tests := []struct {
+		path     string
+		expected string
+		hint     bool
+	}{
+		{"/unauthorized", "Invalid token provided", false},
+		{"/forbidden", "You do not have permission to perform the requested action", false},
+		{"/forbidden_with_causes", "You do not have permission to perform the requested action", false},
+		{"/restricted", "You do not have permission to perform the requested action (the request was rejected by the network restrictions of the API token or the service app: Request from 203.0.113.7 is outside of the allowed zone., make sure the IP address the request comes from is allowed)", true},
+		{"/restricted_token", "Invalid token provided (the request was rejected by the network restrictions of the API token or the service app: Token is restricted to a network zone, make sure the IP address the request comes from is allowed)", true},
+		{"/ok", "not an error", false},
+	}
+	for _, test := range tests {
+		resp, err := client.Get(ts.URL + test.path)
+		if err != nil {
+			t.Fatalf("request to %s failed: %v", test.path, err)
+		}
+		var body map[string]interface{}
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		_ = resp.Body.Close()
+		if err != nil {
+			t.Fatalf("failed to decode response from %s: %v", test.path, err)
+		}
+		summary, _ := body["errorSummary"].(string)
+		if !strings.HasPrefix(summary, test.expected) || (!test.hint && summary != test.expected) {
+			t.Errorf("unexpected error summary for %s, expected prefix %q, actual %q", test.path, test.expected, summary)
+		}
+	}
+}
+
+func TestCoalescingTransport(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		time.Sleep(50 * time.Millisecond)
+		_, _ = w.Write([]byte(r.Method + " " + r.URL.Path))
+	}))
+	defer ts.Close()
+	client := &http.Client{Transport: &CoalescingTransport{T: http.DefaultTransport}}
+	coalesced := context.WithValue(context.Background(), coalesceRequests, true)
+
+	get := func(ctx context.Context, path string) string {
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL+path, nil)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Errorf("request to %s failed: %v", path, err)
+			return ""
+		}
+		defer resp.Body.Close()
+		body, _ := ioutil.ReadAll(resp.Body)
+		return string(body)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if body := get(coalesced, "/groups"); body != "GET /groups" {
+				t.Errorf("unexpected response body: %q", body)
+			}
+		}()
+	}
+	wg.Wait()
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Fatalf("expected concurrent identical requests to be coalesced into 1 request, got %d", n)
+	}
+	get(coalesced, "/groups")
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Fatalf("expected response to be reused, got %d requests", n)
+	}
+	get(context.Background(), "/groups")
+	if n := atomic.LoadInt32(&requests); n != 2 {
+		t.Fatalf("expected requests without coalescing to be sent, got %d requests", n)
+	}
+	req, _ := http.NewRequest(http.MethodPost, ts.URL+"/groups", nil)
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	_ = resp.Body.Close()
+	get(coalesced, "/groups")
+	if n := atomic.LoadInt32(&requests); n != 4 {
+		t.Fatalf("expected cached responses to be dropped after a change, got %d requests", n)
+	}
+}
+
+func TestCoalescingTransportRateLimitHeaders(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Rate-Limit-Remaining", "99")
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer ts.Close()
+	client := &http.Client{Transport: &CoalescingTransport{T: http.DefaultTransport}}
+	coalesced := context.WithValue(context.Background(), coalesceRequests, true)
+
+	for i, expected := range []string{"99", ""} {
+		req, _ := http.NewRequestWithContext(coalesced, http.MethodGet, ts.URL+"/groups", nil)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		_ = resp.Body.Close()
+		if actual := resp.Header.Get("X-Rate-Limit-Remaining"); actual != expected {
+			t.Errorf("unexpected rate limit header of response %d, expected %q, actual %q", i, expected, actual)
+		}
+	}
+}
+
+func TestCoalesceDataSourceRequests(t *testing.T) {
+	dataSources := coalesceDataSourceRequests(map[string]*schema.Resource{
+		apiHealth: {ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			if coalesce, _ := ctx.Value(coalesceRequests).(bool); coalesce {
+				return diag.Errorf("API health requests must not be coalesced")
+			}
+			return nil
+		}},
+	})
+	if diags := dataSources[apiHealth].ReadContext(context.Background(), nil, nil); diags.HasError() {
+		t.Error(diags[0].Summary)
+	}
+}
@@ -0,0 +1,68 @@
+package okta
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAPIHealth() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAPIHealthRead,
+		Schema: map[string]*schema.Schema{
+			"timeout": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          10,
+				ValidateDiagFunc: intBetween(1, 300),
+				Description:      "Number of seconds to wait for the Okta API to respond before considering it unhealthy.",
+			},
+			"healthy": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the Okta API responded successfully in time.",
+			},
+			"status_code": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "HTTP status code of the health check response, 0 if no response was received.",
+			},
+			"latency_ms": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Time in milliseconds it took the Okta API to respond.",
+			},
+			"error": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Error returned by the health check, empty if the Okta API is healthy.",
+			},
+		},
+	}
+}
+
+// dataSourceAPIHealthRead never fails on an unhealthy API, so the result can be used to skip applies during Okta incidents
+func dataSourceAPIHealthRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(d.Get("timeout").(int))*time.Second)
+	defer cancel()
+	start := time.Now()
+	resp, err := getSupplementFromMetadata(m).Ping(ctx)
+	latency := time.Since(start)
+	d.SetId(m.(*Config).orgName)
+	var statusCode int
+	if resp != nil {
+		statusCode = resp.StatusCode
+	}
+	var errMsg string
+	if err != nil {
+		logger(m).Warn("Okta API health check failed", "error", err)
+		errMsg = err.Error()
+	}
+	_ = d.Set("healthy", err == nil)
+	_ = d.Set("status_code", statusCode)
+	_ = d.Set("latency_ms", int(latency.Milliseconds()))
+	_ = d.Set("error", errMsg)
+	return nil
+}
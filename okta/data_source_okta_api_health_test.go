@@ -0,0 +1,24 @@
+package okta
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaDataSourceAPIHealth_read(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "okta_api_health" "test" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.okta_api_health.test", "healthy", "true"),
+					resource.TestCheckResourceAttr("data.okta_api_health.test", "status_code", "200"),
+					resource.TestCheckResourceAttrSet("data.okta_api_health.test", "latency_ms"),
+				),
+			},
+		},
+	})
+}
@@ -42,6 +42,11 @@ func dataSourceApp() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"sign_on_mode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Sign on mode of application.",
+			},
 			"links": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -101,6 +106,7 @@ func dataSourceAppRead(ctx context.Context, d *schema.ResourceData, m interface{
 	_ = d.Set("label", app.Label)
 	_ = d.Set("name", app.Name)
 	_ = d.Set("status", app.Status)
+	_ = d.Set("sign_on_mode", app.SignOnMode)
 	p, _ := json.Marshal(app.Links)
 	_ = d.Set("links", string(p))
 	return nil
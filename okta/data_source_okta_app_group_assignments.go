@@ -2,6 +2,7 @@ package okta
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -25,6 +26,28 @@ func dataSourceAppGroupAssignments() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "List of groups IDs assigned to the app",
 			},
+			"assignments": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of group assignments of the app",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"profile": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "JSON document containing the app profile of the group assignment",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -33,7 +56,7 @@ func dataSourceAppGroupAssignmentsRead(ctx context.Context, d *schema.ResourceDa
 	client := getOktaClientFromMetadata(m)
 	id := d.Get("id").(string)
 
-	groupAssignments, resp, err := client.Application.ListApplicationGroupAssignments(ctx, id, &query.Params{})
+	groupAssignments, resp, err := client.Application.ListApplicationGroupAssignments(ctx, id, &query.Params{Limit: defaultPaginationLimit})
 	if err != nil {
 		return diag.Errorf("unable to query for groups from app (%s): %s", id, err)
 	}
@@ -52,10 +75,18 @@ func dataSourceAppGroupAssignmentsRead(ctx context.Context, d *schema.ResourceDa
 	}
 
 	var groups []string
-	for _, assignment := range groupAssignments {
+	assignments := make([]map[string]interface{}, len(groupAssignments))
+	for i, assignment := range groupAssignments {
 		groups = append(groups, assignment.Id)
+		profile, _ := json.Marshal(assignment.Profile)
+		assignments[i] = map[string]interface{}{
+			"id":       assignment.Id,
+			"priority": assignment.Priority,
+			"profile":  string(profile),
+		}
 	}
 	_ = d.Set("groups", convertStringSetToInterface(groups))
+	_ = d.Set("assignments", assignments)
 	d.SetId(id)
 	return nil
 }
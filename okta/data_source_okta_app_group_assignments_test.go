@@ -20,6 +20,8 @@ func TestAccOktaDataSourceAppGroupAssignments_read(t *testing.T) {
 				Config: config,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.okta_app_group_assignments.test", "groups.#"),
+					resource.TestCheckResourceAttr("data.okta_app_group_assignments.test", "assignments.#", "3"),
+					resource.TestCheckResourceAttrSet("data.okta_app_group_assignments.test", "assignments.0.profile"),
 				),
 			},
 		},
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -164,26 +165,61 @@ func dataSourceAppOauthRead(ctx context.Context, d *schema.ResourceData, m inter
 			return diag.Errorf("failed get app by ID: %v", err)
 		}
 		app = respApp.(*okta.OpenIdConnectApplication)
+		if app.SignOnMode != "OPENID_CONNECT" {
+			return diag.Errorf("application with ID '%s' is not an OAuth application", filters.ID)
+		}
 	} else {
 		re := getOktaClientFromMetadata(m).GetRequestExecutor()
-		qp := &query.Params{Limit: 1, Filter: filters.Status, Q: filters.getQ()}
+		qp := &query.Params{Limit: defaultPaginationLimit, Filter: filters.Status, Q: filters.getQ()}
 		req, err := re.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/apps%s", qp.String()), nil)
 		if err != nil {
 			return diag.Errorf("failed to list OAuth apps: %v", err)
 		}
 		var appList []*okta.OpenIdConnectApplication
-		_, err = re.Do(ctx, req, &appList)
+		resp, err := re.Do(ctx, req, &appList)
 		if err != nil {
 			return diag.Errorf("failed to list OAuth apps: %v", err)
 		}
-		if len(appList) < 1 {
+		// 'q' also matches names and label prefixes of apps of other types, so only the OAuth apps
+		// with the exact label (if it's provided) are kept
+		var matches []*okta.OpenIdConnectApplication
+		for {
+			for i := range appList {
+				if appList[i].SignOnMode != "OPENID_CONNECT" {
+					continue
+				}
+				if filters.Label != "" && appList[i].Label != filters.Label {
+					continue
+				}
+				matches = append(matches, appList[i])
+			}
+			if !resp.HasNextPage() {
+				break
+			}
+			appList = nil
+			resp, err = resp.Next(ctx, &appList)
+			if err != nil {
+				return diag.Errorf("failed to list OAuth apps: %v", err)
+			}
+		}
+		if len(matches) == 0 {
+			if filters.Label != "" {
+				return diag.Errorf("no OAuth application found with the provided label: %s", filters.Label)
+			}
 			return diag.Errorf("no OAuth application found with provided filter: %s", filters)
 		}
-		if filters.Label != "" && appList[0].Label != filters.Label {
-			return diag.Errorf("no OAuth application found with the provided label: %s", filters.Label)
+		if len(matches) > 1 && filters.Label != "" {
+			ids := make([]string, len(matches))
+			for i := range matches {
+				ids[i] = matches[i].Id
+			}
+			return diag.Errorf("there are %d OAuth applications with the label '%s' (%s), use the ID of the application instead",
+				len(ids), filters.Label, strings.Join(ids, ", "))
+		}
+		if len(matches) > 1 {
+			logger(m).Info("found multiple OAuth applications with the criteria supplied, using the first one, sorted by creation date")
 		}
-		logger(m).Info("found multiple OAuth applications with the criteria supplied, using the first one, sorted by creation date")
-		app = appList[0]
+		app = matches[0]
 	}
 	users, groups, err := listAppUsersAndGroupsIDs(ctx, getOktaClientFromMetadata(m), app.Id)
 	if err != nil {
@@ -1,7 +1,10 @@
 package okta
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -52,3 +55,49 @@ resource "okta_app_oauth" "test" {
   consent_method             = "TRUSTED"
 }`, d)
 }
+
+func TestDataSourceAppOauthReadByLabel(t *testing.T) {
+	app := func(id, label, signOnMode string) string {
+		return fmt.Sprintf(`{"id":"%s","label":"%s","signOnMode":"%s","visibility":{"hide":{}},"settings":{"oauthClient":{}},"credentials":{"oauthClient":{}}}`,
+			id, label, signOnMode)
+	}
+	// the apps are returned in two pages, the app labeled 'Sales' is on the second one
+	m := newTestOktaClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path != "/api/v1/apps":
+			_, _ = w.Write([]byte(`[]`))
+		case r.URL.Query().Get("after") == "":
+			w.Header().Set("Link", fmt.Sprintf(`<http://%s/api/v1/apps?after=0oa3>; rel="next"`, r.Host))
+			_, _ = w.Write([]byte(`[` + app("0oa1", "HR", "OPENID_CONNECT") + `,` + app("0oa2", "HR", "OPENID_CONNECT") + `,` +
+				app("0oa3", "Sales", "SAML_2_0") + `]`))
+		default:
+			_, _ = w.Write([]byte(`[` + app("0oa4", "Sales", "OPENID_CONNECT") + `]`))
+		}
+	}))
+	tests := []struct {
+		label      string
+		expectedID string
+		err        string
+	}{
+		{"Sales", "0oa4", ""},
+		{"HR", "", "0oa1, 0oa2"},
+		{"Finance", "", "no OAuth application found"},
+	}
+	for _, test := range tests {
+		d := dataSourceAppOauth().TestResourceData()
+		_ = d.Set("label", test.label)
+		diags := dataSourceAppOauthRead(context.Background(), d, m)
+		if test.err != "" {
+			if !diags.HasError() || !strings.Contains(diags[0].Summary, test.err) {
+				t.Errorf("%s: expected an error containing '%s', got %v", test.label, test.err, diags)
+			}
+			continue
+		}
+		if diags.HasError() {
+			t.Errorf("%s: unexpected error: %v", test.label, diags)
+		} else if d.Id() != test.expectedID {
+			t.Errorf("%s: expected app %s, got %s", test.label, test.expectedID, d.Id())
+		}
+	}
+}
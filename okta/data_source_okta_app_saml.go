@@ -50,6 +50,11 @@ func dataSourceAppSaml() *schema.Resource {
 				Description: "Certificate ID",
 				Computed:    true,
 			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Description: "cert from SAML XML metadata payload",
+				Computed:    true,
+			},
 			"auto_submit_toolbar": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -309,9 +314,12 @@ func dataSourceAppSamlRead(ctx context.Context, d *schema.ResourceData, m interf
 			return diag.Errorf("failed get app by ID: %v", err)
 		}
 		app = respApp.(*okta.SamlApplication)
+		if app.SignOnMode != "SAML_2_0" {
+			return diag.Errorf("application with ID '%s' is not a SAML application", filters.ID)
+		}
 	} else {
 		re := getOktaClientFromMetadata(m).GetRequestExecutor()
-		qp := &query.Params{Limit: 1, Filter: filters.Status, Q: filters.getQ()}
+		qp := &query.Params{Limit: defaultPaginationLimit, Filter: filters.Status, Q: filters.getQ()}
 		req, err := re.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/apps%s", qp.String()), nil)
 		if err != nil {
 			return diag.Errorf("failed to list SAML apps: %v", err)
@@ -321,14 +329,25 @@ func dataSourceAppSamlRead(ctx context.Context, d *schema.ResourceData, m interf
 		if err != nil {
 			return diag.Errorf("failed to list SAML apps: %v", err)
 		}
-		if len(appList) < 1 {
-			return diag.Errorf("no SAML application found with provided filter: %s", filters)
+		// 'q' also matches names and label prefixes of apps of other types, so the first SAML app
+		// with the exact label (if it's provided) is taken
+		for i := range appList {
+			if appList[i].SignOnMode != "SAML_2_0" {
+				continue
+			}
+			if filters.Label != "" && appList[i].Label != filters.Label {
+				continue
+			}
+			app = appList[i]
+			break
 		}
-		if filters.Label != "" && appList[0].Label != filters.Label {
-			return diag.Errorf("no SAML application found with the provided label: %s", filters.Label)
+		if app == nil {
+			if filters.Label != "" {
+				return diag.Errorf("no SAML application found with the provided label: %s", filters.Label)
+			}
+			return diag.Errorf("no SAML application found with provided filter: %s", filters)
 		}
 		logger(m).Info("found multiple SAML applications with the criteria supplied, using the first one, sorted by creation date")
-		app = appList[0]
 	}
 	users, groups, err := listAppUsersAndGroupsIDs(ctx, getOktaClientFromMetadata(m), app.Id)
 	if err != nil {
@@ -341,6 +360,15 @@ func dataSourceAppSamlRead(ctx context.Context, d *schema.ResourceData, m interf
 	_ = d.Set("name", app.Name)
 	_ = d.Set("status", app.Status)
 	_ = d.Set("key_id", app.Credentials.Signing.Kid)
+	if app.Credentials.Signing.Kid != "" && app.Status != statusInactive {
+		_, metadataRoot, err := getSupplementFromMetadata(m).GetSAMLMetadata(ctx, app.Id, app.Credentials.Signing.Kid)
+		if err != nil {
+			return diag.Errorf("failed to get app's SAML metadata: %v", err)
+		}
+		if len(metadataRoot.IDPSSODescriptors) > 0 && len(metadataRoot.IDPSSODescriptors[0].KeyDescriptors) > 0 {
+			_ = d.Set("certificate", metadataRoot.IDPSSODescriptors[0].KeyDescriptors[0].KeyInfo.Certificate)
+		}
+	}
 	if app.Settings != nil {
 		if app.Settings.SignOn != nil {
 			err = setSamlSettings(d, app.Settings.SignOn)
@@ -27,6 +27,7 @@ func TestAccOktaDataSourceAppSaml_read(t *testing.T) {
 				Config: config,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.okta_app_saml.test", "key_id"),
+					resource.TestCheckResourceAttrSet("data.okta_app_saml.test", "certificate"),
 					resource.TestCheckResourceAttr("data.okta_app_saml.test", "label", buildResourceName(ri)),
 					resource.TestCheckResourceAttr("data.okta_app_saml.test_label", "label", buildResourceName(ri)),
 					resource.TestCheckResourceAttr("data.okta_app_saml.test", "status", statusActive),
@@ -33,6 +33,7 @@ func TestAccOktaDataSourceApp_read(t *testing.T) {
 					resource.TestCheckResourceAttr("data.okta_app.test", "status", statusActive),
 					resource.TestCheckResourceAttr("data.okta_app.test2", "status", statusActive),
 					resource.TestCheckResourceAttr("data.okta_app.test3", "status", statusActive),
+					resource.TestCheckResourceAttr("data.okta_app.test", "sign_on_mode", "OPENID_CONNECT"),
 				),
 			},
 		},
@@ -16,7 +16,7 @@ func dataSourceAppUserAssignments() *schema.Resource {
 			"id": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "ID of the Okta App being queried for groups",
+				Description: "ID of the Okta App being queried for users",
 				ForceNew:    true,
 			},
 			"users": {
@@ -25,6 +25,28 @@ func dataSourceAppUserAssignments() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "List of user IDs assigned to the app",
 			},
+			"assignments": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of user assignments of the app",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"scope": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Whether the user is assigned to the app directly (USER) or via a group (GROUP)",
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -33,7 +55,7 @@ func dataSourceAppUserAssignmentsRead(ctx context.Context, d *schema.ResourceDat
 	client := getOktaClientFromMetadata(m)
 	id := d.Get("id").(string)
 
-	userAssignments, resp, err := client.Application.ListApplicationUsers(ctx, id, &query.Params{})
+	userAssignments, resp, err := client.Application.ListApplicationUsers(ctx, id, &query.Params{Limit: defaultPaginationLimit})
 	if err != nil {
 		return diag.Errorf("unable to query for users from app (%s): %s", id, err)
 	}
@@ -52,10 +74,19 @@ func dataSourceAppUserAssignmentsRead(ctx context.Context, d *schema.ResourceDat
 	}
 
 	var users []string
-	for _, assignment := range userAssignments {
+	assignments := make([]map[string]interface{}, len(userAssignments))
+	for i, assignment := range userAssignments {
 		users = append(users, assignment.Id)
+		assignments[i] = map[string]interface{}{
+			"id":    assignment.Id,
+			"scope": assignment.Scope,
+		}
+		if assignment.Credentials != nil {
+			assignments[i]["username"] = assignment.Credentials.UserName
+		}
 	}
 	_ = d.Set("users", convertStringSetToInterface(users))
+	_ = d.Set("assignments", assignments)
 	d.SetId(id)
 	return nil
 }
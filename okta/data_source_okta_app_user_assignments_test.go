@@ -20,6 +20,7 @@ func TestAccOktaDataSourceAppUserAssignments_read(t *testing.T) {
 				Config: config,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.okta_app_user_assignments.test", "users.#"),
+					resource.TestCheckResourceAttrSet("data.okta_app_user_assignments.test", "assignments.0.scope"),
 				),
 			},
 		},
@@ -0,0 +1,95 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceApps() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAppsRead,
+		Schema: map[string]*schema.Schema{
+			"label": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Searches for applications with the exact label",
+				ConflictsWith: []string{"label_prefix"},
+			},
+			"label_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Searches for applications which label starts with the provided value",
+				ConflictsWith: []string{"label"},
+			},
+			"include_non_active": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Include inactive applications in the results",
+			},
+			"apps": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sign_on_mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAppsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	filters := &appFilters{
+		Label:       d.Get("label").(string),
+		LabelPrefix: d.Get("label_prefix").(string),
+	}
+	if !d.Get("include_non_active").(bool) {
+		filters.Status = fmt.Sprintf(`status eq "%s"`, statusActive)
+	}
+	apps, err := listApps(ctx, m, filters, defaultPaginationLimit)
+	if err != nil {
+		return diag.Errorf("failed to list apps: %v", err)
+	}
+	d.SetId(fmt.Sprintf("%d", crc32.ChecksumIEEE([]byte(fmt.Sprintf("%s, status: %s", filters, filters.Status)))))
+	arr := make([]map[string]interface{}, 0, len(apps))
+	for i := range apps {
+		// 'q' matches the beginning of the label, so the exact label is filtered here
+		if filters.Label != "" && apps[i].Label != filters.Label {
+			continue
+		}
+		arr = append(arr, map[string]interface{}{
+			"id":           apps[i].Id,
+			"label":        apps[i].Label,
+			"name":         apps[i].Name,
+			"status":       apps[i].Status,
+			"sign_on_mode": apps[i].SignOnMode,
+		})
+	}
+	_ = d.Set("apps", arr)
+	return nil
+}
@@ -0,0 +1,38 @@
+package okta
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaDataSourceApps_read(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager("okta_apps")
+	apps := mgr.GetFixtures("okta_apps.tf", ri, t)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: apps,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("okta_app_bookmark.test_1", "id"),
+					resource.TestCheckResourceAttrSet("okta_app_bookmark.test_2", "id"),
+				),
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.okta_apps.test", "apps.#", "1"),
+					resource.TestCheckResourceAttr("data.okta_apps.test_non_active", "apps.#", "2"),
+					resource.TestCheckResourceAttr("data.okta_apps.test_label", "apps.#", "1"),
+					resource.TestCheckResourceAttrPair("data.okta_apps.test_label", "apps.0.id", "okta_app_bookmark.test_2", "id"),
+					resource.TestCheckResourceAttr("data.okta_apps.test_label", "apps.0.status", statusInactive),
+				),
+			},
+		},
+	})
+}
@@ -2,9 +2,11 @@ package okta
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
 	"github.com/okta/okta-sdk-golang/v2/okta/query"
 )
 
@@ -59,22 +61,24 @@ func dataSourceAuthServer() *schema.Resource {
 
 func dataSourceAuthServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	name := d.Get("name").(string)
-	servers, _, err := getOktaClientFromMetadata(m).AuthorizationServer.ListAuthorizationServers(ctx, &query.Params{Q: name, Limit: 1})
+	authServer, err := findAuthServerByName(ctx, m, name)
 	if err != nil {
-		return diag.Errorf("failed to find auth server '%s': %v", name, err)
+		return diag.FromErr(err)
 	}
-	if len(servers) < 1 || servers[0].Name != name {
-		diag.Errorf("authorization server with name '%s' does not exist", name)
-	}
-	authServer := servers[0]
 	d.SetId(authServer.Id)
 	_ = d.Set("name", authServer.Name)
 	_ = d.Set("description", authServer.Description)
 	_ = d.Set("audiences", convertStringSetToInterface(authServer.Audiences))
-	_ = d.Set("credentials_rotation_mode", authServer.Credentials.Signing.RotationMode)
-	_ = d.Set("kid", authServer.Credentials.Signing.Kid)
-	_ = d.Set("credentials_next_rotation", authServer.Credentials.Signing.NextRotation.String())
-	_ = d.Set("credentials_last_rotated", authServer.Credentials.Signing.LastRotated.String())
+	if authServer.Credentials != nil && authServer.Credentials.Signing != nil {
+		_ = d.Set("credentials_rotation_mode", authServer.Credentials.Signing.RotationMode)
+		_ = d.Set("kid", authServer.Credentials.Signing.Kid)
+		if authServer.Credentials.Signing.NextRotation != nil {
+			_ = d.Set("credentials_next_rotation", authServer.Credentials.Signing.NextRotation.String())
+		}
+		if authServer.Credentials.Signing.LastRotated != nil {
+			_ = d.Set("credentials_last_rotated", authServer.Credentials.Signing.LastRotated.String())
+		}
+	}
 	_ = d.Set("status", authServer.Status)
 	_ = d.Set("issuer", authServer.Issuer)
 	// Do not sync these unless the issuer mode is specified since it is an EA feature
@@ -83,3 +87,26 @@ func dataSourceAuthServerRead(ctx context.Context, d *schema.ResourceData, m int
 	}
 	return nil
 }
+
+// findAuthServerByName returns the auth server with the exact name, since the 'q' filter performs a 'startsWith' match.
+func findAuthServerByName(ctx context.Context, m interface{}, name string) (*okta.AuthorizationServer, error) {
+	servers, resp, err := getOktaClientFromMetadata(m).AuthorizationServer.ListAuthorizationServers(ctx, &query.Params{Q: name, Limit: defaultPaginationLimit})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find auth server '%s': %v", name, err)
+	}
+	for {
+		for _, server := range servers {
+			if server.Name == name {
+				return server, nil
+			}
+		}
+		if !resp.HasNextPage() {
+			break
+		}
+		resp, err = resp.Next(ctx, &servers)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find auth server '%s': %v", name, err)
+		}
+	}
+	return nil, fmt.Errorf("authorization server with name '%s' does not exist", name)
+}
@@ -0,0 +1,34 @@
+package okta
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaDataSourceAuthServerClaims(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager("okta_auth_server_claims")
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(authServer, authServerExists),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.okta_auth_server_claims.test", "claims.#"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.okta_auth_server_claims.test", "claims.*", map[string]string{
+						"name":       "test",
+						"value":      "cool",
+						"value_type": "EXPRESSION",
+						"claim_type": "RESOURCE",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
 )
 
 func dataSourceAuthServerPolicy() *schema.Resource {
@@ -30,12 +31,56 @@ func dataSourceAuthServerPolicy() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"priority": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"rules": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Rules of the policy",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"grant_type_whitelist": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"scope_whitelist": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
 func dataSourceAuthServerPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	policies, _, err := getOktaClientFromMetadata(m).AuthorizationServer.ListAuthorizationServerPolicies(ctx, d.Get("auth_server_id").(string))
+	authServerID := d.Get("auth_server_id").(string)
+	policies, _, err := getOktaClientFromMetadata(m).AuthorizationServer.ListAuthorizationServerPolicies(ctx, authServerID)
 	if err != nil {
 		return diag.Errorf("failed to list auth server policies: %v", err)
 	}
@@ -45,8 +90,37 @@ func dataSourceAuthServerPolicyRead(ctx context.Context, d *schema.ResourceData,
 			d.SetId(policy.Id)
 			_ = d.Set("description", policy.Description)
 			_ = d.Set("assigned_clients", convertStringSetToInterface(policy.Conditions.Clients.Include))
+			_ = d.Set("status", policy.Status)
+			_ = d.Set("priority", policy.Priority)
+			rules, _, err := getSupplementFromMetadata(m).ListAuthorizationServerPolicyRules(ctx, authServerID, policy.Id)
+			if err != nil {
+				return diag.Errorf("failed to list auth server policy rules: %v", err)
+			}
+			_ = d.Set("rules", flattenAuthServerPolicyRules(rules))
 			return nil
 		}
 	}
 	return diag.Errorf("auth server policy with name '%s' does not exist", name)
 }
+
+func flattenAuthServerPolicyRules(rules []*sdk.AuthorizationServerPolicyRule) []interface{} {
+	result := make([]interface{}, len(rules))
+	for i, rule := range rules {
+		r := map[string]interface{}{
+			"id":       rule.Id,
+			"name":     rule.Name,
+			"status":   rule.Status,
+			"priority": rule.Priority,
+		}
+		if rule.Conditions != nil {
+			if rule.Conditions.GrantTypes != nil {
+				r["grant_type_whitelist"] = convertStringSetToInterface(rule.Conditions.GrantTypes.Include)
+			}
+			if rule.Conditions.Scopes != nil {
+				r["scope_whitelist"] = convertStringSetToInterface(rule.Conditions.Scopes.Include)
+			}
+		}
+		result[i] = r
+	}
+	return result
+}
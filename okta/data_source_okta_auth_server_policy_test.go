@@ -25,6 +25,9 @@ func TestAccOktaDataSourceAuthServerPolicy_read(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.okta_auth_server_policy.test", "id"),
 					resource.TestCheckResourceAttrSet("data.okta_auth_server_policy.test", "description"),
+					resource.TestCheckResourceAttr("data.okta_auth_server_policy.test", "status", statusActive),
+					resource.TestCheckResourceAttr("data.okta_auth_server_policy.test", "rules.#", "1"),
+					resource.TestCheckResourceAttr("data.okta_auth_server_policy.test", "rules.0.name", "test"),
 				),
 			},
 		},
@@ -48,5 +51,19 @@ resource "okta_auth_server" "test" {
   description = "test"
   audiences   = [
     "whatever.rise.zone"]
+}
+
+data "okta_group" "all" {
+  name = "Everyone"
+}
+
+resource "okta_auth_server_policy_rule" "test" {
+  auth_server_id       = okta_auth_server.test.id
+  policy_id            = okta_auth_server_policy.test.id
+  status               = "ACTIVE"
+  name                 = "test"
+  priority             = 1
+  group_whitelist      = [data.okta_group.all.id]
+  grant_type_whitelist = ["implicit"]
 }`, i)
 }
@@ -27,6 +27,9 @@ func TestAccOktaDataSourceAuthServer_read(t *testing.T) {
 					resource.TestCheckResourceAttr("data.okta_auth_server.test", "name", buildResourceName(ri)),
 					resource.TestCheckResourceAttr("data.okta_auth_server.test", "status", statusActive),
 					resource.TestCheckResourceAttrSet("data.okta_auth_server.test", "issuer"),
+					resource.TestCheckResourceAttrSet("data.okta_auth_server.test", "kid"),
+					resource.TestCheckResourceAttr("data.okta_auth_server.test", "audiences.#", "1"),
+					resource.TestCheckResourceAttrPair("data.okta_auth_server.test", "id", "okta_auth_server.test", "id"),
 				),
 			},
 		},
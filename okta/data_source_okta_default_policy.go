@@ -25,6 +25,11 @@ func dataSourceDefaultPolicies() *schema.Resource {
 				Description: fmt.Sprintf("Policy type: %s, %s, %s, or %s", sdk.SignOnPolicyType, sdk.PasswordPolicyType, sdk.MfaPolicyType, sdk.IdpDiscoveryType),
 				Required:    true,
 			},
+			"default_rule_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the default rule of the policy",
+			},
 		},
 	}
 }
@@ -41,6 +46,30 @@ func dataSourceDefaultPolicyRead(ctx context.Context, d *schema.ResourceData, m
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	rule, err := findDefaultPolicyRule(ctx, m, policy.Id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	d.SetId(policy.Id)
+	_ = d.Set("default_rule_id", rule.Id)
 	return nil
 }
+
+// findDefaultPolicyRule returns the system rule of the default policy, which can not be created or deleted
+func findDefaultPolicyRule(ctx context.Context, m interface{}, policyID string) (*sdk.PolicyRule, error) {
+	rules, _, err := getSupplementFromMetadata(m).ListPolicyRules(ctx, policyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list policy rules: %v", err)
+	}
+	for i := range rules {
+		if rules[i].System != nil && *rules[i].System {
+			return &rules[i], nil
+		}
+	}
+	for i := range rules {
+		if rules[i].Name == "Default Rule" {
+			return &rules[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no default rule retrieved for policy '%s'", policyID)
+}
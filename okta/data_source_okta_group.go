@@ -3,6 +3,7 @@ package okta
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,11 +18,20 @@ func dataSourceGroup() *schema.Resource {
 			"id": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"name", "type"},
+				ConflictsWith: []string{"name", "type", "search"},
 			},
 			"name": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Name of the group, it has to match the name of the group exactly.",
+				ConflictsWith: []string{"search"},
+			},
+			"search": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Searches for a group with a supported filtering expression for all attributes except for '_embedded', '_links', and 'objectClass', e.g. profile attributes",
+				ConflictsWith: []string{"type"},
 			},
 			"type": {
 				Type:             schema.TypeString,
@@ -37,7 +47,7 @@ func dataSourceGroup() *schema.Resource {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     false,
-				Description: "Fetch group users, having default off cuts down on API calls.",
+				Description: "Fetch group users, having default off cuts down on API calls. All the pages of the group users are fetched.",
 			},
 			"users": {
 				Type:        schema.TypeSet,
@@ -62,26 +72,43 @@ func findGroup(ctx context.Context, name string, d *schema.ResourceData, m inter
 			return diag.Errorf("failed get group by ID: %v", err)
 		}
 		group = respGroup
+	} else if search, ok := d.GetOk("search"); ok {
+		searchParams := &query.Params{Search: search.(string), Limit: defaultPaginationLimit}
+		logger(m).Info("looking for data source group", "query", searchParams.String())
+		groups, err := listGroups(ctx, getOktaClientFromMetadata(m), searchParams)
+		if err != nil {
+			return diag.Errorf("failed to query for groups: %v", err)
+		}
+		switch len(groups) {
+		case 0:
+			return diag.Errorf("group matching search expression '%s' does not exist", search.(string))
+		case 1:
+			group = groups[0]
+		default:
+			ids := make([]string, len(groups))
+			for i := range groups {
+				ids[i] = groups[i].Id
+			}
+			return diag.Errorf("there are %d groups matching search expression '%s' (%s), narrow it down to a single group",
+				len(ids), search.(string), strings.Join(ids, ", "))
+		}
 	} else {
-		searchParams := &query.Params{Q: name, Limit: 1}
+		searchParams := &query.Params{Q: name, Limit: defaultPaginationLimit}
 		t, okType := d.GetOk("type")
 		if okType {
 			searchParams.Filter = fmt.Sprintf("type eq \"%s\"", t.(string))
 		}
 		logger(m).Info("looking for data source group", "query", searchParams.String())
-		groups, _, err := getOktaClientFromMetadata(m).Group.ListGroups(ctx, searchParams)
+		var err error
+		group, err = getGroupByExactName(ctx, m, name, searchParams)
 		switch {
 		case err != nil:
 			return diag.Errorf("failed to query for groups: %v", err)
-		case len(groups) < 1:
-			if okType {
-				return diag.Errorf("group with name '%s' and type '%s' does not exist", name, d.Get("type").(string))
-			}
+		case group == nil && okType:
+			return diag.Errorf("group with name '%s' and type '%s' does not exist", name, d.Get("type").(string))
+		case group == nil:
 			return diag.Errorf("group with name '%s' does not exist", name)
-		case groups[0].Profile.Name != name:
-			logger(m).Warn("group with exact name match was not found: using partial match which contains name as a substring", "name", groups[0].Profile.Name)
 		}
-		group = groups[0]
 	}
 	d.SetId(group.Id)
 	_ = d.Set("description", group.Profile.Description)
@@ -99,3 +126,40 @@ func findGroup(ctx context.Context, name string, d *schema.ResourceData, m inter
 	_ = d.Set("users", convertStringSetToInterface(userIDList))
 	return nil
 }
+
+// getGroupByExactName returns the group with the exact name, or nil if there is no such group. It fails if there
+// are several groups with the name, e.g. app groups named after an Okta group, since either of them could be meant.
+func getGroupByExactName(ctx context.Context, m interface{}, name string, qp *query.Params) (*okta.Group, error) {
+	groups, err := listGroupsByExactName(ctx, m, name, qp)
+	if err != nil {
+		return nil, err
+	}
+	switch len(groups) {
+	case 0:
+		return nil, nil
+	case 1:
+		return groups[0], nil
+	default:
+		ids := make([]string, len(groups))
+		for i := range groups {
+			ids[i] = groups[i].Id
+		}
+		return nil, fmt.Errorf("there are %d groups named '%s' (%s), use the group ID or the type of the group instead", len(ids), name, strings.Join(ids, ", "))
+	}
+}
+
+// listGroupsByExactName goes through all the groups which names start with the given name, since the 'q' parameter
+// is a "starts with" match, and returns the ones with the exact name.
+func listGroupsByExactName(ctx context.Context, m interface{}, name string, qp *query.Params) ([]*okta.Group, error) {
+	groups, err := listGroups(ctx, getOktaClientFromMetadata(m), qp)
+	if err != nil {
+		return nil, err
+	}
+	var named []*okta.Group
+	for _, group := range groups {
+		if group.Profile.Name == name {
+			named = append(named, group)
+		}
+	}
+	return named, nil
+}
@@ -0,0 +1,110 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+func dataSourceGroupRule() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGroupRuleRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"name"},
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"id"},
+			},
+			"group_assignments": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The list of group ids to assign the users to.",
+			},
+			"expression_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expression_value": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The expression value.",
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGroupRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Get("id").(string)
+	name := d.Get("name").(string)
+	if id == "" && name == "" {
+		return diag.Errorf("config must provide either 'id' or 'name' to retrieve the group rule")
+	}
+	var (
+		err  error
+		rule *okta.GroupRule
+	)
+	if id != "" {
+		rule, _, err = getOktaClientFromMetadata(m).Group.GetGroupRule(ctx, id, nil)
+	} else {
+		rule, err = getGroupRuleByName(ctx, m, name)
+	}
+	if err != nil {
+		return diag.Errorf("failed to get group rule: %v", err)
+	}
+	d.SetId(rule.Id)
+	_ = d.Set("name", rule.Name)
+	_ = d.Set("status", rule.Status)
+	if rule.Conditions != nil && rule.Conditions.Expression != nil {
+		_ = d.Set("expression_type", rule.Conditions.Expression.Type)
+		_ = d.Set("expression_value", rule.Conditions.Expression.Value)
+	}
+	var groupIDs []string
+	if rule.Actions != nil && rule.Actions.AssignUserToGroups != nil {
+		groupIDs = rule.Actions.AssignUserToGroups.GroupIds
+	}
+	err = setNonPrimitives(d, map[string]interface{}{
+		"group_assignments": convertStringSetToInterface(groupIDs),
+	})
+	if err != nil {
+		return diag.Errorf("failed to set group rule properties: %v", err)
+	}
+	return nil
+}
+
+// getGroupRuleByName searches the group rules by name, since the search is a "starts with" match
+// the results have to be filtered to find the rule with the exact name.
+func getGroupRuleByName(ctx context.Context, m interface{}, name string) (*okta.GroupRule, error) {
+	rules, resp, err := getOktaClientFromMetadata(m).Group.ListGroupRules(ctx, &query.Params{Search: name, Limit: defaultPaginationLimit})
+	if err != nil {
+		return nil, err
+	}
+	for {
+		for _, rule := range rules {
+			if rule.Name == name {
+				return rule, nil
+			}
+		}
+		if !resp.HasNextPage() {
+			break
+		}
+		resp, err = resp.Next(ctx, &rules)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return nil, fmt.Errorf("group rule with name '%s' does not exist", name)
+}
@@ -0,0 +1,44 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaDataSourceGroupRule_read(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := fmt.Sprintf("data.%s.test", groupRule)
+	mgr := newFixtureManager(groupRule)
+	createGroupRule := mgr.GetFixtures("basic.tf", ri, t)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(groupRule, doesGroupRuleExist),
+		Steps: []resource.TestStep{
+			{
+				Config: createGroupRule,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("okta_group_rule.test", "id"),
+				),
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
+					resource.TestCheckResourceAttr(resourceName, "expression_type", "urn:okta:expression:1.0"),
+					resource.TestCheckResourceAttr(resourceName, "group_assignments.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)),
+					resource.TestCheckResourceAttrPair(resourceName, "id", fmt.Sprintf("data.%s.test_by_name", groupRule), "id"),
+				),
+			},
+		},
+	})
+}
@@ -1,11 +1,15 @@
 package okta
 
 import (
+	"context"
+	"net/http"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
 )
 
 func TestAccOktaDataSourceGroup_read(t *testing.T) {
@@ -28,9 +32,13 @@ func TestAccOktaDataSourceGroup_read(t *testing.T) {
 				Config: config,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.okta_group.test", "id"),
-					resource.TestCheckResourceAttrSet("data.okta_group.test", "type"),
+					resource.TestCheckResourceAttr("data.okta_group.test", "type", "OKTA_GROUP"),
+					resource.TestCheckResourceAttrPair("data.okta_group.test", "id", "okta_group.test", "id"),
+					resource.TestCheckResourceAttr("data.okta_group.test", "users.#", "1"),
 					resource.TestCheckResourceAttrSet("okta_group.test", "id"),
 					resource.TestCheckResourceAttr("okta_group.test", "users.#", "1"),
+					resource.TestCheckResourceAttrPair("data.okta_group.test_search", "id", "okta_group.test", "id"),
+					resource.TestCheckResourceAttr("data.okta_group.test_search", "name", "testAcc"),
 				),
 			},
 			{
@@ -40,3 +48,27 @@ func TestAccOktaDataSourceGroup_read(t *testing.T) {
 		},
 	})
 }
+
+func TestGetGroupByExactName(t *testing.T) {
+	m := newTestOktaClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"id":"00g1","type":"OKTA_GROUP","profile":{"name":"Admins"}},` +
+			`{"id":"00g2","type":"APP_GROUP","profile":{"name":"Admins"}},` +
+			`{"id":"00g3","type":"OKTA_GROUP","profile":{"name":"Admins Europe"}}]`))
+	}))
+	group, err := getGroupByExactName(context.Background(), m, "Admins Europe", &query.Params{Q: "Admins Europe"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if group == nil || group.Id != "00g3" {
+		t.Errorf("expected group 00g3, got %v", group)
+	}
+	group, err = getGroupByExactName(context.Background(), m, "Sales", &query.Params{Q: "Sales"})
+	if err != nil || group != nil {
+		t.Errorf("expected no group and no error, got %v and %v", group, err)
+	}
+	_, err = getGroupByExactName(context.Background(), m, "Admins", &query.Params{Q: "Admins"})
+	if err == nil || !strings.Contains(err.Error(), "00g1, 00g2") {
+		t.Errorf("expected an error listing the groups named 'Admins', got %v", err)
+	}
+}
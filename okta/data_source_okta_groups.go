@@ -30,6 +30,12 @@ func dataSourceGroups() *schema.Resource {
 				Description:      "Type of the group. When specified in the terraform resource, will act as a filter when searching for the groups",
 				ValidateDiagFunc: elemInSlice([]string{"OKTA_GROUP", "APP_GROUP", "BUILT_IN"}),
 			},
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the groups, in the same order as 'groups'.",
+			},
 			"groups": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -78,7 +84,9 @@ func dataSourceGroupsRead(ctx context.Context, d *schema.ResourceData, m interfa
 	}
 	d.SetId(fmt.Sprintf("%d", crc32.ChecksumIEEE([]byte(qp.String()))))
 	arr := make([]map[string]interface{}, len(groups))
+	ids := make([]string, len(groups))
 	for i := range groups {
+		ids[i] = groups[i].Id
 		arr[i] = map[string]interface{}{
 			"id":          groups[i].Id,
 			"name":        groups[i].Profile.Name,
@@ -86,6 +94,7 @@ func dataSourceGroupsRead(ctx context.Context, d *schema.ResourceData, m interfa
 			"description": groups[i].Profile.Description,
 		}
 	}
+	_ = d.Set("ids", ids)
 	_ = d.Set("groups", arr)
 	return nil
 }
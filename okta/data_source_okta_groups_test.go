@@ -28,6 +28,10 @@ func TestAccOktaDataSourceGroups_read(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.okta_groups.test", "id"),
 					resource.TestCheckResourceAttr("data.okta_groups.test", "groups.#", "2"),
+					resource.TestCheckResourceAttr("data.okta_groups.test", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.okta_groups.test", "groups.0.type", "OKTA_GROUP"),
+					resource.TestCheckResourceAttr("data.okta_groups.test", "groups.0.description", "testing, testing"),
+					resource.TestCheckTypeSetElemAttr("data.okta_groups.built_in", "groups.*.name", "Everyone"),
 				),
 			},
 		},
@@ -90,6 +90,28 @@ func dataSourceIdpOidc() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"provisioning_action": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"groups_action": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"groups_attribute": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"groups_assignment": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"groups_filter": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
 		},
 	}
 }
@@ -128,5 +150,10 @@ func dataSourceIdpOidcRead(ctx context.Context, d *schema.ResourceData, m interf
 	if oidc.IssuerMode != "" {
 		_ = d.Set("issuer_mode", oidc.IssuerMode)
 	}
+	_ = d.Set("provisioning_action", oidc.Policy.Provisioning.Action)
+	err = syncGroupActions(d, oidc.Policy.Provisioning.Groups)
+	if err != nil {
+		return diag.Errorf("failed to set OIDC identity provider properties: %v", err)
+	}
 	return nil
 }
@@ -73,6 +73,28 @@ func dataSourceIdpSaml() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"provisioning_action": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"groups_action": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"groups_attribute": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"groups_assignment": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"groups_filter": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
 		},
 	}
 }
@@ -110,6 +132,11 @@ func dataSourceIdpSamlRead(ctx context.Context, d *schema.ResourceData, m interf
 	if idp.IssuerMode != "" {
 		_ = d.Set("issuer_mode", idp.IssuerMode)
 	}
+	_ = d.Set("provisioning_action", idp.Policy.Provisioning.Action)
+	err = syncGroupActions(d, idp.Policy.Provisioning.Groups)
+	if err != nil {
+		return diag.Errorf("failed to set SAML identity provider properties: %v", err)
+	}
 	err = setNonPrimitives(d, map[string]interface{}{
 		"subject_format": convertStringSetToInterface(idp.Policy.Subject.Format),
 	})
@@ -11,6 +11,8 @@ import (
 	"github.com/okta/okta-sdk-golang/v2/okta/query"
 )
 
+var socialIdpTypes = []string{"APPLE", "FACEBOOK", "LINKEDIN", "MICROSOFT", "GOOGLE"}
+
 func dataSourceIdpSocial() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceIdpSocialRead,
@@ -103,8 +105,11 @@ func dataSourceIdpSocial() *schema.Resource {
 				Computed: true,
 			},
 			"type": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "Type of the social IdP, used to look up the IdP when neither 'id' nor 'name' is set",
+				ValidateDiagFunc: elemInSlice(socialIdpTypes),
 			},
 			"scopes": {
 				Type:     schema.TypeSet,
@@ -139,26 +144,36 @@ func dataSourceIdpSocial() *schema.Resource {
 func dataSourceIdpSocialRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Get("id").(string)
 	name := d.Get("name").(string)
-	if id == "" && name == "" {
-		return diag.Errorf("config must provide either 'id' or 'name' to retrieve the social IdP")
+	idpType := d.Get("type").(string)
+	if id == "" && name == "" && idpType == "" {
+		return diag.Errorf("config must provide 'id', 'name' or 'type' to retrieve the social IdP")
 	}
 	var (
 		err error
 		idp *okta.IdentityProvider
 	)
-	if id != "" {
+	switch {
+	case id != "":
 		idp, _, err = getOktaClientFromMetadata(m).IdentityProvider.GetIdentityProvider(ctx, id)
 		if err != nil {
 			return diag.Errorf("failed to get social identity provider with id '%s': %v", id, err)
 		}
-		if !contains([]string{"APPLE", "FACEBOOK", "LINKEDIN", "MICROSOFT", "GOOGLE"}, idp.Type) {
+		if !contains(socialIdpTypes, idp.Type) {
 			return diag.Errorf("social identity provider with id '%s' does not exist", id)
 		}
-	} else {
+	case name != "":
 		idp, err = getSocialIdPByName(ctx, m, name)
 		if err != nil {
 			return diag.FromErr(err)
 		}
+	default:
+		idp, err = getSocialIdPByType(ctx, m, idpType)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if idpType != "" && idp.Type != idpType {
+		return diag.Errorf("social identity provider '%s' is of type '%s', not '%s'", idp.Name, idp.Type, idpType)
 	}
 	d.SetId(idp.Id)
 	_ = d.Set("name", idp.Name)
@@ -176,8 +191,10 @@ func dataSourceIdpSocialRead(ctx context.Context, d *schema.ResourceData, m inte
 	_ = d.Set("issuer_mode", idp.IssuerMode)
 	_ = d.Set("protocol_type", idp.Protocol.Type)
 	_ = d.Set("type", idp.Type)
-	syncEndpoint("authorization", idp.Protocol.Endpoints.Authorization, d)
-	syncEndpoint("token", idp.Protocol.Endpoints.Authorization, d)
+	if idp.Protocol.Endpoints != nil {
+		syncEndpoint("authorization", idp.Protocol.Endpoints.Authorization, d)
+		syncEndpoint("token", idp.Protocol.Endpoints.Token, d)
+	}
 
 	err = syncGroupActions(d, idp.Policy.Provisioning.Groups)
 	if err != nil {
@@ -188,7 +205,7 @@ func dataSourceIdpSocialRead(ctx context.Context, d *schema.ResourceData, m inte
 	}
 	if idp.Policy.AccountLink != nil {
 		_ = d.Set("account_link_action", idp.Policy.AccountLink.Action)
-		if idp.Policy.AccountLink.Filter != nil {
+		if idp.Policy.AccountLink.Filter != nil && idp.Policy.AccountLink.Filter.Groups != nil {
 			setMap["account_link_group_include"] = convertStringSetToInterface(idp.Policy.AccountLink.Filter.Groups.Include)
 		}
 	}
@@ -205,7 +222,7 @@ func getSocialIdPByName(ctx context.Context, m interface{}, name string) (*okta.
 	if err != nil {
 		return nil, fmt.Errorf("failed to get social identity provider with name '%s': %v", name, err)
 	}
-	if len(idps) < 1 || !contains([]string{"APPLE", "FACEBOOK", "LINKEDIN", "MICROSOFT", "GOOGLE"}, idps[0].Type) {
+	if len(idps) < 1 || !contains(socialIdpTypes, idps[0].Type) {
 		return nil, fmt.Errorf("social identity provider with name '%s' does not exist", name)
 	}
 	k := 0
@@ -230,3 +247,18 @@ func getSocialIdPByName(ctx context.Context, m interface{}, name string) (*okta.
 	}
 	return idps[0], nil
 }
+
+func getSocialIdPByType(ctx context.Context, m interface{}, idpType string) (*okta.IdentityProvider, error) {
+	idps, _, err := getOktaClientFromMetadata(m).IdentityProvider.
+		ListIdentityProviders(ctx, &query.Params{Type: idpType, Limit: defaultPaginationLimit})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get social identity provider with type '%s': %v", idpType, err)
+	}
+	if len(idps) == 0 {
+		return nil, fmt.Errorf("social identity provider with type '%s' does not exist", idpType)
+	}
+	if len(idps) > 1 {
+		return nil, fmt.Errorf("found %d social identity providers with type '%s', set 'name' or 'id' to look up a specific one", len(idps), idpType)
+	}
+	return idps[0], nil
+}
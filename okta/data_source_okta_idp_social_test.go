@@ -27,6 +27,9 @@ func TestAccOktaDataSourceIdpSocial_read(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.okta_idp_social.test_facebook", "id"),
 					resource.TestCheckResourceAttrSet("data.okta_idp_social.test_google", "name"),
 					resource.TestCheckResourceAttrSet("data.okta_idp_social.test_microsoft", "id"),
+					resource.TestCheckResourceAttr("data.okta_idp_social.test_microsoft", "type", "MICROSOFT"),
+					resource.TestCheckResourceAttrSet("data.okta_idp_social.test_type", "id"),
+					resource.TestCheckResourceAttr("data.okta_idp_social.test_type", "type", "MICROSOFT"),
 				),
 			},
 		},
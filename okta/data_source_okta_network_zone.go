@@ -0,0 +1,76 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func dataSourceNetworkZone() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetworkZoneRead,
+		Schema: buildSchema(map[string]*schema.Schema{
+			"id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "ID of the Network Zone",
+				ConflictsWith: []string{"name"},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the Network Zone, it has to match the name of the zone exactly, e.g. LegacyIpZone",
+			},
+		}, networkZoneDataSourceSchema),
+	}
+}
+
+func dataSourceNetworkZoneRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Get("id").(string)
+	name := d.Get("name").(string)
+	if id == "" && name == "" {
+		return diag.Errorf("either 'id' or 'name' should be set")
+	}
+	var zone *sdk.NetworkZone
+	if id != "" {
+		var err error
+		zone, _, err = getSupplementFromMetadata(m).GetNetworkZone(ctx, id)
+		if err != nil {
+			return diag.Errorf("failed to get network zone by ID: %v", err)
+		}
+	} else {
+		zones, _, err := getSupplementFromMetadata(m).ListNetworkZones(ctx)
+		if err != nil {
+			return diag.Errorf("failed to list network zones: %v", err)
+		}
+		for i := range zones {
+			if zones[i].Name == name {
+				zone = zones[i]
+				break
+			}
+		}
+		if zone == nil {
+			return diag.Errorf("network zone with name '%s' does not exist", name)
+		}
+	}
+	d.SetId(zone.ID)
+	_ = d.Set("name", zone.Name)
+	_ = d.Set("type", zone.Type)
+	_ = d.Set("usage", zone.Usage)
+	_ = d.Set("status", zone.Status)
+	_ = d.Set("system", zone.System)
+	_ = d.Set("dynamic_proxy_type", zone.ProxyType)
+	err := setNonPrimitives(d, map[string]interface{}{
+		"gateways":          flattenAddresses(zone.Gateways),
+		"proxies":           flattenAddresses(zone.Proxies),
+		"dynamic_locations": flattenDynamicLocations(zone.Locations),
+		"asns":              convertStringSetToInterface(zone.Asns),
+	})
+	if err != nil {
+		return diag.Errorf("failed to set network zone properties: %v", err)
+	}
+	return nil
+}
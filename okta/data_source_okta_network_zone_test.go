@@ -0,0 +1,84 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaDataSourceNetworkZone_read(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(networkZone)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+	resourceName := fmt.Sprintf("data.%s.test", networkZone)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", fmt.Sprintf("%s.test", networkZone), "id"),
+					resource.TestCheckResourceAttr(resourceName, "type", "IP"),
+					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
+					resource.TestCheckResourceAttr(resourceName, "gateways.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "proxies.#", "2"),
+					resource.TestCheckResourceAttr(fmt.Sprintf("data.%s.test_id", networkZone), "name", buildResourceName(ri)),
+					resource.TestCheckResourceAttrSet(fmt.Sprintf("data.%s.legacy", networkZone), "id"),
+					resource.TestCheckResourceAttr(fmt.Sprintf("data.%s.legacy", networkZone), "system", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOktaDataSourceNetworkZones_read(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(networkZones)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+	resourceName := fmt.Sprintf("data.%s.test", networkZones)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "zones.#"),
+					resource.TestCheckResourceAttr(resourceName, "zones.0.type", "DYNAMIC"),
+					resource.TestCheckResourceAttr(resourceName, "zones.0.usage", "POLICY"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceNetworkZoneReadByName(t *testing.T) {
+	// the zones are returned in two pages, the zone named 'Office' is on the second one
+	m := newTestOktaClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path != "/api/v1/zones":
+			w.WriteHeader(http.StatusNotFound)
+		case r.URL.Query().Get("after") == "":
+			w.Header().Set("Link", fmt.Sprintf(`<http://%s/api/v1/zones?after=nzo1>; rel="next"`, r.Host))
+			_, _ = w.Write([]byte(`[{"id":"nzo1","name":"LegacyIpZone","type":"IP","usage":"POLICY"}]`))
+		default:
+			_, _ = w.Write([]byte(`[{"id":"nzo2","name":"Office","type":"IP","usage":"POLICY"}]`))
+		}
+	}))
+	d := dataSourceNetworkZone().TestResourceData()
+	_ = d.Set("name", "Office")
+	if diags := dataSourceNetworkZoneRead(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("failed to read network zone: %v", diags)
+	}
+	if d.Id() != "nzo2" {
+		t.Errorf("expected network zone nzo2, got %s", d.Id())
+	}
+}
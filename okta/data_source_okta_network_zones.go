@@ -0,0 +1,68 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetworkZones() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetworkZonesRead,
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: elemInSlice([]string{"IP", "DYNAMIC"}),
+				Description:      "Only returns the zones of the type: IP or DYNAMIC",
+			},
+			"usage": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: elemInSlice([]string{"POLICY", "BLOCKLIST"}),
+				Description:      "Only returns the zones of the usage: POLICY or BLOCKLIST",
+			},
+			"zones": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: buildSchema(map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					}, networkZoneDataSourceSchema),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkZonesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	zoneType := d.Get("type").(string)
+	usage := d.Get("usage").(string)
+	zones, _, err := getSupplementFromMetadata(m).ListNetworkZones(ctx)
+	if err != nil {
+		return diag.Errorf("failed to list network zones: %v", err)
+	}
+	var arr []map[string]interface{}
+	for i := range zones {
+		if (zoneType != "" && zones[i].Type != zoneType) || (usage != "" && zones[i].Usage != usage) {
+			continue
+		}
+		arr = append(arr, flattenNetworkZone(zones[i]))
+	}
+	d.SetId(fmt.Sprintf("%d", crc32.ChecksumIEEE([]byte(fmt.Sprintf("type: %s, usage: %s", zoneType, usage)))))
+	err = setNonPrimitives(d, map[string]interface{}{"zones": arr})
+	if err != nil {
+		return diag.Errorf("failed to set network zones: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func dataSourceOinApps() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceOinAppsRead,
+		Schema: map[string]*schema.Schema{
+			"q": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Searches the catalog for the apps which names start with the provided value",
+			},
+			"category": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only returns the apps of the category, e.g. 'CRM', the case is ignored",
+			},
+			"apps": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the app in the catalog, which is used as 'preconfigured_app'",
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"category": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sign_on_modes": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOinAppsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	q := d.Get("q").(string)
+	category := d.Get("category").(string)
+	apps, err := listCatalogApps(ctx, m, q, category)
+	if err != nil {
+		return diag.Errorf("failed to search OIN catalog: %v", err)
+	}
+	d.SetId(fmt.Sprintf("%d", crc32.ChecksumIEEE([]byte(fmt.Sprintf("q: %s, category: %s", q, category)))))
+	arr := make([]map[string]interface{}, len(apps))
+	for i := range apps {
+		arr[i] = map[string]interface{}{
+			"name":          apps[i].Name,
+			"display_name":  apps[i].DisplayName,
+			"category":      apps[i].Category,
+			"description":   apps[i].Description,
+			"sign_on_modes": convertStringArrToInterface(apps[i].SignOnModes),
+		}
+	}
+	_ = d.Set("apps", arr)
+	return nil
+}
+
+// listCatalogApps goes through all the pages of the OIN catalog apps matching the query, the category is filtered here
+func listCatalogApps(ctx context.Context, m interface{}, q, category string) ([]*sdk.CatalogApp, error) {
+	var result []*sdk.CatalogApp
+	apps, resp, err := getSupplementFromMetadata(m).ListCatalogApps(ctx, &query.Params{Q: q, Limit: defaultPaginationLimit})
+	if err != nil {
+		return nil, err
+	}
+	for {
+		for _, app := range apps {
+			if category == "" || strings.EqualFold(app.Category, category) {
+				result = append(result, app)
+			}
+		}
+		if !resp.HasNextPage() {
+			return result, nil
+		}
+		resp, err = resp.Next(ctx, &apps)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
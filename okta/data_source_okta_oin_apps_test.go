@@ -0,0 +1,28 @@
+package okta
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaDataSourceOinApps_read(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(oinApps)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.okta_oin_apps.test", "apps.#"),
+					resource.TestCheckResourceAttrSet("data.okta_oin_apps.test", "apps.0.name"),
+					resource.TestCheckResourceAttr("data.okta_oin_apps.test_category", "apps.0.category", "CRM"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,61 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceOrgMetadata() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceOrgMetadataRead,
+		Schema: map[string]*schema.Schema{
+			"org_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the org, as configured in the provider.",
+			},
+			"base_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Domain of the org, as configured in the provider.",
+			},
+			"org_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URL of the org, e.g. 'https://example.okta.com'.",
+			},
+			"admin_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URL of the admin console of the org, e.g. 'https://example-admin.okta.com'.",
+			},
+			"issuer": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Issuer URL of the org authorization server.",
+			},
+			"default_auth_server_issuer": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Issuer URL of the 'default' custom authorization server.",
+			},
+		},
+	}
+}
+
+// dataSourceOrgMetadataRead does not make any requests, all the values are derived from the provider configuration
+func dataSourceOrgMetadataRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+	orgURL := config.orgURL()
+	d.SetId(orgURL)
+	_ = d.Set("org_name", config.orgName)
+	_ = d.Set("base_url", config.domain)
+	_ = d.Set("org_url", orgURL)
+	_ = d.Set("admin_url", fmt.Sprintf("https://%s-admin.%s", config.orgName, config.domain))
+	_ = d.Set("issuer", orgURL)
+	_ = d.Set("default_auth_server_issuer", orgURL+"/oauth2/default")
+	return nil
+}
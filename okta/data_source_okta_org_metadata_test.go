@@ -0,0 +1,27 @@
+package okta
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaDataSourceOrgMetadata_read(t *testing.T) {
+	resourceName := "data.okta_org_metadata.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "okta_org_metadata" "test" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "org_url", regexp.MustCompile(`^https://`)),
+					resource.TestCheckResourceAttrPair(resourceName, "issuer", resourceName, "org_url"),
+					resource.TestMatchResourceAttr(resourceName, "default_auth_server_issuer", regexp.MustCompile(`/oauth2/default$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "admin_url"),
+				),
+			},
+		},
+	})
+}
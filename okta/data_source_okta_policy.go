@@ -25,9 +25,27 @@ func dataSourcePolicy() *schema.Resource {
 					sdk.PasswordPolicyType,
 					sdk.MfaPolicyType,
 					sdk.IdpDiscoveryType,
+					sdk.AccessPolicyType,
+					sdk.ProfileEnrollmentPolicyType,
 				}),
-				Description: fmt.Sprintf("Policy type: %s, %s, %s, or %s", sdk.SignOnPolicyType, sdk.PasswordPolicyType, sdk.MfaPolicyType, sdk.IdpDiscoveryType),
-				Required:    true,
+				Description: fmt.Sprintf("Policy type: %s, %s, %s, %s, %s, or %s", sdk.SignOnPolicyType, sdk.PasswordPolicyType,
+					sdk.MfaPolicyType, sdk.IdpDiscoveryType, sdk.AccessPolicyType, sdk.ProfileEnrollmentPolicyType),
+				Required: true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of policy",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Description of policy",
+			},
+			"priority": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Priority of policy",
 			},
 		},
 	}
@@ -39,5 +57,8 @@ func dataSourcePolicyRead(ctx context.Context, d *schema.ResourceData, m interfa
 		return diag.FromErr(err)
 	}
 	d.SetId(policy.Id)
+	_ = d.Set("status", policy.Status)
+	_ = d.Set("description", policy.Description)
+	_ = d.Set("priority", policy.Priority)
 	return nil
 }
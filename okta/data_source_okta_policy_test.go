@@ -21,6 +21,8 @@ func TestAccOktaDataSourcePolicy_read(t *testing.T) {
 				Config: config,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.okta_policy.test", "id"),
+					resource.TestCheckResourceAttr("data.okta_policy.test", "status", statusActive),
+					resource.TestCheckResourceAttrSet("data.okta_policy.test", "priority"),
 				),
 			},
 		},
@@ -0,0 +1,53 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceRoleSubscription() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRoleSubscriptionRead,
+		Schema: map[string]*schema.Schema{
+			"role_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: elemInSlice(validAdminRoles),
+				Description:      "Type of the role",
+			},
+			"notification_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: elemInSlice(validRoleSubscriptionNotificationTypes),
+				Description:      "Type of the notification",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of subscription",
+			},
+			"channels": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Channels the notification is sent through",
+			},
+		},
+	}
+}
+
+func dataSourceRoleSubscriptionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	roleType := d.Get("role_type").(string)
+	notificationType := d.Get("notification_type").(string)
+	subscription, _, err := getSupplementFromMetadata(m).GetRoleSubscription(ctx, roleType, notificationType)
+	if err != nil {
+		return diag.Errorf("failed to get role subscription: %v", err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", roleType, notificationType))
+	_ = d.Set("status", subscription.Status)
+	_ = d.Set("channels", convertStringSetToInterface(subscription.Channels))
+	return nil
+}
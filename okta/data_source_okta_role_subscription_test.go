@@ -0,0 +1,29 @@
+package okta
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaDataSourceRoleSubscription_read(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(roleSubscription)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.okta_role_subscription.test", "role_type", "SUPER_ADMIN"),
+					resource.TestCheckResourceAttr("data.okta_role_subscription.test", "notification_type", "APP_IMPORT"),
+					resource.TestCheckResourceAttrSet("data.okta_role_subscription.test", "status"),
+				),
+			},
+		},
+	})
+}
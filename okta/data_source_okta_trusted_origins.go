@@ -0,0 +1,91 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+func dataSourceTrustedOrigins() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTrustedOriginsRead,
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter expression for trusted origins, e.g. `origin eq \"https://example.com\"`",
+			},
+			"trusted_origins": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"active": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"origin": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"scopes": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTrustedOriginsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	qp := &query.Params{Limit: defaultPaginationLimit}
+	filter, ok := d.GetOk("filter")
+	if ok {
+		qp.Filter = filter.(string)
+	}
+	trustedOrigins, resp, err := getOktaClientFromMetadata(m).TrustedOrigin.ListOrigins(ctx, qp)
+	if err != nil {
+		return diag.Errorf("failed to list trusted origins: %v", err)
+	}
+	for resp.HasNextPage() {
+		var nextTrustedOrigins []*okta.TrustedOrigin
+		resp, err = resp.Next(ctx, &nextTrustedOrigins)
+		if err != nil {
+			return diag.Errorf("failed to list trusted origins: %v", err)
+		}
+		trustedOrigins = append(trustedOrigins, nextTrustedOrigins...)
+	}
+	d.SetId(fmt.Sprintf("%d", crc32.ChecksumIEEE([]byte(qp.String()))))
+	arr := make([]map[string]interface{}, len(trustedOrigins))
+	for i := range trustedOrigins {
+		scopes := make([]interface{}, len(trustedOrigins[i].Scopes))
+		for j, scope := range trustedOrigins[i].Scopes {
+			scopes[j] = scope.Type
+		}
+		arr[i] = map[string]interface{}{
+			"id":     trustedOrigins[i].Id,
+			"active": trustedOrigins[i].Status == statusActive,
+			"name":   trustedOrigins[i].Name,
+			"origin": trustedOrigins[i].Origin,
+			"scopes": scopes,
+		}
+	}
+	err = d.Set("trusted_origins", arr)
+	return diag.FromErr(err)
+}
@@ -0,0 +1,34 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaDataSourceTrustedOrigins_read(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(trustedOrigins)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      testAccCheckTrustedOriginDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.okta_trusted_origins.all", "trusted_origins.#"),
+					resource.TestCheckResourceAttr("data.okta_trusted_origins.test", "trusted_origins.#", "1"),
+					resource.TestCheckResourceAttr("data.okta_trusted_origins.test", "trusted_origins.0.name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr("data.okta_trusted_origins.test", "trusted_origins.0.origin", fmt.Sprintf("https://example-%d.com", ri)),
+					resource.TestCheckResourceAttr("data.okta_trusted_origins.test", "trusted_origins.0.active", "true"),
+					resource.TestCheckResourceAttr("data.okta_trusted_origins.test", "trusted_origins.0.scopes.#", "2"),
+				),
+			},
+		},
+	})
+}
@@ -31,6 +31,13 @@ func TestAccOktaDataSourceUserType_read(t *testing.T) {
 				Config: config,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.okta_user_types.test", "user_types.*", map[string]string{
+						"name":    buildResourceName(ri),
+						"default": "false",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.okta_user_types.test", "user_types.*", map[string]string{
+						"default": "true",
+					}),
 				),
 			},
 		},
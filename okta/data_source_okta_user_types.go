@@ -0,0 +1,69 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceUserTypes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceUserTypesRead,
+		Schema: map[string]*schema.Schema{
+			"user_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUserTypesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	userTypes, _, err := getOktaClientFromMetadata(m).UserType.ListUserTypes(ctx)
+	if err != nil {
+		return diag.Errorf("failed to list user types: %v", err)
+	}
+	ids := make([]string, len(userTypes))
+	arr := make([]map[string]interface{}, len(userTypes))
+	for i, ut := range userTypes {
+		ids[i] = ut.Id
+		arr[i] = map[string]interface{}{
+			"id":           ut.Id,
+			"name":         ut.Name,
+			"display_name": ut.DisplayName,
+			"description":  ut.Description,
+			"default":      ut.Default != nil && *ut.Default,
+		}
+	}
+	d.SetId(fmt.Sprintf("%d", crc32.ChecksumIEEE([]byte(strings.Join(ids, ",")))))
+	err = d.Set("user_types", arr)
+	return diag.FromErr(err)
+}
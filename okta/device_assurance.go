@@ -0,0 +1,215 @@
+package okta
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+const (
+	deviceAssurancePlatformAndroid = "ANDROID"
+	deviceAssurancePlatformIOS     = "IOS"
+	deviceAssurancePlatformMacOS   = "MACOS"
+	deviceAssurancePlatformWindows = "WINDOWS"
+)
+
+// deviceAssuranceSchema is the basis of the device assurance policies of all the platforms
+var deviceAssuranceSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Name of the device assurance policy",
+	},
+	"os_version": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Minimum version of the operating system, e.g. '12.4.5'",
+	},
+	"screenlock_type": {
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "Screen lock types the device must use: BIOMETRIC and/or PASSCODE",
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: elemInSlice([]string{"BIOMETRIC", "PASSCODE"}),
+		},
+	},
+	"created_by": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "ID of the user who created the device assurance policy",
+	},
+	"created_date": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Date the device assurance policy was created",
+	},
+	"last_updated_by": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "ID of the user who last updated the device assurance policy",
+	},
+	"last_update": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Date the device assurance policy was last updated",
+	},
+}
+
+// deviceAssuranceDiskEncryptionSchema builds the disk encryption attribute, the types differ between the platforms
+func deviceAssuranceDiskEncryptionSchema(types ...string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"disk_encryption_type": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Disk encryption types the device must use",
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: elemInSlice(types),
+			},
+		},
+	}
+}
+
+// Okta tells the unset boolean attributes of the device assurance policies apart from the false ones, e.g. a policy
+// with the jailbreak attribute set to false only allows the devices that are not jailbroken, while a policy without it
+// allows all the devices. The plugin SDK can't tell an unset boolean from a false one, so these attributes are strings
+// that are either "true", "false" or empty when unset. Boolean values in the configs are converted to the strings.
+var deviceAssuranceJailbreakSchema = map[string]*schema.Schema{
+	"jailbreak": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: elemInSlice([]string{"true", "false"}),
+		Description:      "Whether the device is jailbroken (rooted): 'true' or 'false', any device is allowed when unset",
+	},
+}
+
+var deviceAssuranceSecureHardwareSchema = map[string]*schema.Schema{
+	"secure_hardware_present": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: elemInSlice([]string{"true", "false"}),
+		Description:      "Whether the device must have the secure hardware, e.g. TPM or Secure Enclave: 'true' or 'false', any device is allowed when unset",
+	},
+}
+
+// deviceAssuranceAttributes lists the platform specific attributes of the device assurance policies
+var deviceAssuranceAttributes = map[string][]string{
+	deviceAssurancePlatformAndroid: {"disk_encryption_type", "jailbreak", "secure_hardware_present"},
+	deviceAssurancePlatformIOS:     {"jailbreak"},
+	deviceAssurancePlatformMacOS:   {"disk_encryption_type", "secure_hardware_present"},
+	deviceAssurancePlatformWindows: {"disk_encryption_type", "secure_hardware_present"},
+}
+
+func createDeviceAssurance(ctx context.Context, d *schema.ResourceData, m interface{}, platform string) error {
+	deviceAssurance, _, err := getSupplementFromMetadata(m).CreateDeviceAssurance(ctx, buildDeviceAssurance(d, platform))
+	if err != nil {
+		return err
+	}
+	d.SetId(deviceAssurance.ID)
+	return nil
+}
+
+func readDeviceAssurance(ctx context.Context, d *schema.ResourceData, m interface{}, platform string) error {
+	deviceAssurance, resp, err := getSupplementFromMetadata(m).GetDeviceAssurance(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return err
+	}
+	if deviceAssurance == nil {
+		d.SetId("")
+		return nil
+	}
+	return syncDeviceAssurance(d, platform, deviceAssurance)
+}
+
+func updateDeviceAssurance(ctx context.Context, d *schema.ResourceData, m interface{}, platform string) error {
+	_, _, err := getSupplementFromMetadata(m).UpdateDeviceAssurance(ctx, d.Id(), buildDeviceAssurance(d, platform))
+	return err
+}
+
+func deleteDeviceAssurance(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	resp, err := getSupplementFromMetadata(m).DeleteDeviceAssurance(ctx, d.Id())
+	return suppressErrorOn404(resp, err)
+}
+
+func buildDeviceAssurance(d *schema.ResourceData, platform string) sdk.DeviceAssurance {
+	deviceAssurance := sdk.DeviceAssurance{
+		Name:     d.Get("name").(string),
+		Platform: platform,
+	}
+	if version, ok := d.GetOk("os_version"); ok {
+		deviceAssurance.OsVersion = &sdk.DeviceAssuranceOs{Minimum: version.(string)}
+	}
+	if types := convertInterfaceToStringSetNullable(d.Get("screenlock_type")); len(types) > 0 {
+		deviceAssurance.ScreenLockType = &sdk.DeviceAssuranceTypes{Include: types}
+	}
+	for _, attr := range deviceAssuranceAttributes[platform] {
+		switch attr {
+		case "disk_encryption_type":
+			if types := convertInterfaceToStringSetNullable(d.Get(attr)); len(types) > 0 {
+				deviceAssurance.DiskEncryptionType = &sdk.DeviceAssuranceTypes{Include: types}
+			}
+		case "jailbreak":
+			deviceAssurance.Jailbreak = getDeviceAssuranceBool(d, attr)
+		case "secure_hardware_present":
+			deviceAssurance.SecureHardwarePresent = getDeviceAssuranceBool(d, attr)
+		}
+	}
+	return deviceAssurance
+}
+
+func syncDeviceAssurance(d *schema.ResourceData, platform string, deviceAssurance *sdk.DeviceAssurance) error {
+	_ = d.Set("name", deviceAssurance.Name)
+	_ = d.Set("created_by", deviceAssurance.CreatedBy)
+	_ = d.Set("last_updated_by", deviceAssurance.LastUpdatedBy)
+	if deviceAssurance.CreatedDate != nil {
+		_ = d.Set("created_date", deviceAssurance.CreatedDate.Format(time.RFC3339))
+	}
+	if deviceAssurance.LastUpdate != nil {
+		_ = d.Set("last_update", deviceAssurance.LastUpdate.Format(time.RFC3339))
+	}
+	if deviceAssurance.OsVersion != nil {
+		_ = d.Set("os_version", deviceAssurance.OsVersion.Minimum)
+	} else {
+		_ = d.Set("os_version", "")
+	}
+	attrs := map[string]interface{}{
+		"screenlock_type": deviceAssuranceTypesToInterface(deviceAssurance.ScreenLockType),
+	}
+	for _, attr := range deviceAssuranceAttributes[platform] {
+		switch attr {
+		case "disk_encryption_type":
+			attrs[attr] = deviceAssuranceTypesToInterface(deviceAssurance.DiskEncryptionType)
+		case "jailbreak":
+			_ = d.Set(attr, deviceAssuranceBoolToString(deviceAssurance.Jailbreak))
+		case "secure_hardware_present":
+			_ = d.Set(attr, deviceAssuranceBoolToString(deviceAssurance.SecureHardwarePresent))
+		}
+	}
+	return setNonPrimitives(d, attrs)
+}
+
+func deviceAssuranceTypesToInterface(types *sdk.DeviceAssuranceTypes) *schema.Set {
+	if types == nil {
+		return schema.NewSet(schema.HashString, nil)
+	}
+	return convertStringSetToInterface(types.Include)
+}
+
+func getDeviceAssuranceBool(d *schema.ResourceData, key string) *bool {
+	v := d.Get(key).(string)
+	if v == "" {
+		return nil
+	}
+	return boolPtr(v == "true")
+}
+
+func deviceAssuranceBoolToString(b *bool) string {
+	if b == nil {
+		return ""
+	}
+	return strconv.FormatBool(*b)
+}
@@ -3,6 +3,7 @@ package okta
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/okta/okta-sdk-golang/v2/okta"
 	"github.com/okta/okta-sdk-golang/v2/okta/query"
@@ -52,29 +53,55 @@ func listGroups(ctx context.Context, client *okta.Client, qp *query.Params) ([]*
 }
 
 // Group Primary Key Operations (Use when # groups < # users in operations)
-func addGroupMembers(ctx context.Context, client *okta.Client, groupId string, users []string) error {
-	for _, user := range users {
-		resp, err := client.Group.AddUserToGroup(ctx, groupId, user)
-		exists, err := doesResourceExist(resp, err)
-		if err != nil {
-			return fmt.Errorf("failed to add user (%s) to group (%s): %v", user, groupId, err)
-		}
-		if !exists {
-			return fmt.Errorf("targeted object does not exist: %s", err)
+// The users are added and removed concurrently, limited by the provider's parallelism. Requests hitting the rate
+// limits are retried by the client with a backoff.
+func addGroupMembers(ctx context.Context, client *okta.Client, groupId string, users []string, parallelism int) error {
+	handlers := make([]func() error, len(users))
+	for i := range users {
+		user := users[i]
+		handlers[i] = func() error {
+			resp, err := client.Group.AddUserToGroup(ctx, groupId, user)
+			exists, err := doesResourceExist(resp, err)
+			if err != nil {
+				return fmt.Errorf("failed to add user (%s) to group (%s): %v", user, groupId, err)
+			}
+			if !exists {
+				return fmt.Errorf("targeted object does not exist: %s", user)
+			}
+			return nil
 		}
 	}
-	return nil
+	return runGroupMembershipHandlers(parallelism, handlers, "failed to add users to group")
 }
 
-func removeGroupMembers(ctx context.Context, client *okta.Client, groupId string, users []string) error {
-	for _, user := range users {
-		resp, err := client.Group.RemoveUserFromGroup(ctx, groupId, user)
-		err = suppressErrorOn404(resp, err)
-		if err != nil {
-			return fmt.Errorf("failed to remove user (%s) from group (%s): %v", user, groupId, err)
+func removeGroupMembers(ctx context.Context, client *okta.Client, groupId string, users []string, parallelism int) error {
+	handlers := make([]func() error, len(users))
+	for i := range users {
+		user := users[i]
+		handlers[i] = func() error {
+			resp, err := client.Group.RemoveUserFromGroup(ctx, groupId, user)
+			err = suppressErrorOn404(resp, err)
+			if err != nil {
+				return fmt.Errorf("failed to remove user (%s) from group (%s): %v", user, groupId, err)
+			}
+			return nil
 		}
 	}
-	return nil
+	return runGroupMembershipHandlers(parallelism, handlers, "failed to remove users from group")
+}
+
+func runGroupMembershipHandlers(parallelism int, handlers []func() error, message string) error {
+	if len(handlers) == 0 {
+		return nil
+	}
+	if parallelism < 1 {
+		parallelism = 1
+	}
+	var wg sync.WaitGroup
+	resultChan := make(chan []*result, 1)
+	promiseAll(parallelism, &wg, resultChan, handlers...)
+	wg.Wait()
+	return getPromiseError(<-resultChan, message)
 }
 
 // User Primary Key Operations (use when # users < # groups in operations)
@@ -2,6 +2,7 @@ package okta
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -46,20 +47,24 @@ var (
 			Optional:         true,
 			Default:          "NONE",
 			ValidateDiagFunc: elemInSlice([]string{"NONE", "SYNC", "APPEND", "ASSIGN"}),
+			Description:      "Provisioning action for IdP user's group memberships",
 		},
 		"groups_attribute": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "IdP user profile attribute name for an array value that contains group memberships",
 		},
 		"groups_assignment": {
-			Elem:     &schema.Schema{Type: schema.TypeString},
-			Optional: true,
-			Type:     schema.TypeSet,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Type:        schema.TypeSet,
+			Description: "List of Okta Group IDs to add an IdP user as a member with the 'ASSIGN' groups_action",
 		},
 		"groups_filter": {
-			Elem:     &schema.Schema{Type: schema.TypeString},
-			Optional: true,
-			Type:     schema.TypeSet,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Type:        schema.TypeSet,
+			Description: "Allow list of Okta Group IDs that are allowed for the 'APPEND' or 'SYNC' groups_action",
 		},
 		"username_template": {
 			Type:     schema.TypeString,
@@ -168,15 +173,27 @@ func getIdentityProviderByID(ctx context.Context, m interface{}, id, providerTyp
 }
 
 func getIdpByNameAndType(ctx context.Context, m interface{}, name, providerType string) (*okta.IdentityProvider, error) {
-	queryParams := &query.Params{Limit: 1, Q: name, Type: providerType}
-	idps, _, err := getOktaClientFromMetadata(m).IdentityProvider.ListIdentityProviders(ctx, queryParams)
+	queryParams := &query.Params{Limit: defaultPaginationLimit, Q: name, Type: providerType}
+	idps, resp, err := getOktaClientFromMetadata(m).IdentityProvider.ListIdentityProviders(ctx, queryParams)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find identity provider '%s': %v", name, err)
 	}
-	if len(idps) < 1 || idps[0].Name != name {
-		return nil, fmt.Errorf("identity provider with name '%s' and type '%s' does not exist: %v", name, providerType, err)
+	for {
+		// 'q' performs a 'startsWith' match, so there might be IdPs with similar names
+		for _, idp := range idps {
+			if idp.Name == name {
+				return idp, nil
+			}
+		}
+		if !resp.HasNextPage() {
+			break
+		}
+		resp, err = resp.Next(ctx, &idps)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find identity provider '%s': %v", name, err)
+		}
 	}
-	return idps[0], nil
+	return nil, fmt.Errorf("identity provider with name '%s' and type '%s' does not exist", name, providerType)
 }
 
 func resourceIdpDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -231,6 +248,37 @@ func syncGroupActions(d *schema.ResourceData, groups *okta.ProvisioningGroups) e
 	})
 }
 
+// validateIdpGroupsProvisioning ensures that JIT group provisioning settings match the groups_action:
+// 'ASSIGN' uses a static list of groups, 'APPEND' and 'SYNC' use the groups asserted by the IdP
+// in groups_attribute, optionally restricted by the groups_filter allow list.
+func validateIdpGroupsProvisioning(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	action := d.Get("groups_action").(string)
+	assignments := d.Get("groups_assignment").(*schema.Set).Len()
+	filter := d.Get("groups_filter").(*schema.Set).Len()
+	attribute := d.Get("groups_attribute").(string)
+	switch action {
+	case "ASSIGN":
+		if assignments == 0 && d.NewValueKnown("groups_assignment") {
+			return errors.New("'groups_assignment' is required when 'groups_action' is 'ASSIGN'")
+		}
+		if attribute != "" || filter > 0 {
+			return errors.New("'groups_attribute' and 'groups_filter' can not be set when 'groups_action' is 'ASSIGN'")
+		}
+	case "APPEND", "SYNC":
+		if attribute == "" && d.NewValueKnown("groups_attribute") {
+			return fmt.Errorf("'groups_attribute' is required when 'groups_action' is '%s'", action)
+		}
+		if assignments > 0 {
+			return fmt.Errorf("'groups_assignment' can not be set when 'groups_action' is '%s'", action)
+		}
+	default:
+		if attribute != "" || assignments > 0 || filter > 0 {
+			return fmt.Errorf("'groups_attribute', 'groups_assignment' and 'groups_filter' can not be set when 'groups_action' is '%s'", action)
+		}
+	}
+	return nil
+}
+
 func syncAlgo(d *schema.ResourceData, alg *okta.ProtocolAlgorithms) {
 	if alg != nil {
 		if alg.Request != nil && alg.Request.Signature != nil {
@@ -0,0 +1,75 @@
+package okta
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+// networkZoneDataSourceSchema is the contents of the network zone returned by the data sources
+var networkZoneDataSourceSchema = map[string]*schema.Schema{
+	"type": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Type of the Network Zone: IP or DYNAMIC",
+	},
+	"usage": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Zone's purpose: POLICY or BLOCKLIST",
+	},
+	"status": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Network Zone status: ACTIVE or INACTIVE",
+	},
+	"system": {
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Whether the Network Zone is built into the org, e.g. LegacyIpZone",
+	},
+	"gateways": {
+		Type:        schema.TypeSet,
+		Computed:    true,
+		Description: "Gateways of the IP zone in CIDR/range form",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+	"proxies": {
+		Type:        schema.TypeSet,
+		Computed:    true,
+		Description: "Trusted proxies of the IP zone in CIDR/range form",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+	"dynamic_locations": {
+		Type:        schema.TypeSet,
+		Computed:    true,
+		Description: "Locations of the dynamic zone. Format code: countryCode OR countryCode-regionCode",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+	"dynamic_proxy_type": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Type of proxy of the dynamic zone",
+	},
+	"asns": {
+		Type:        schema.TypeSet,
+		Computed:    true,
+		Description: "Autonomous System Numbers of the dynamic zone",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+}
+
+func flattenNetworkZone(zone *sdk.NetworkZone) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                 zone.ID,
+		"name":               zone.Name,
+		"type":               zone.Type,
+		"usage":              zone.Usage,
+		"status":             zone.Status,
+		"system":             zone.System,
+		"gateways":           flattenAddresses(zone.Gateways),
+		"proxies":            flattenAddresses(zone.Proxies),
+		"dynamic_locations":  flattenDynamicLocations(zone.Locations),
+		"dynamic_proxy_type": zone.ProxyType,
+		"asns":               convertStringSetToInterface(zone.Asns),
+	}
+}
@@ -3,6 +3,7 @@ package okta
 import (
 	"context"
 	"fmt"
+	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/okta-sdk-golang/v2/okta"
@@ -40,7 +41,15 @@ var (
 		"groups_included": {
 			Type:        schema.TypeSet,
 			Optional:    true,
-			Description: "List of Group IDs to Include",
+			Description: "List of Group IDs or names to Include",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"groups_included_ids": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Description: "IDs of the included groups configured by name, resolved when the policy is applied",
 			Elem: &schema.Schema{
 				Type: schema.TypeString,
 			},
@@ -155,6 +164,9 @@ func createPolicy(ctx context.Context, d *schema.ResourceData, m interface{}, te
 	if err := ensureNotDefaultPolicy(d); err != nil {
 		return err
 	}
+	if err := resolveIncludedGroupNames(ctx, d, m, template.Conditions); err != nil {
+		return err
+	}
 	policy, _, err := getSupplementFromMetadata(m).CreatePolicy(ctx, template)
 	if err != nil {
 		return err
@@ -224,6 +236,9 @@ func updatePolicy(ctx context.Context, d *schema.ResourceData, m interface{}, te
 	if err := ensureNotDefaultPolicy(d); err != nil {
 		return err
 	}
+	if err := resolveIncludedGroupNames(ctx, d, m, template.Conditions); err != nil {
+		return err
+	}
 	policy, _, err := getSupplementFromMetadata(m).UpdatePolicy(ctx, d.Id(), template)
 	if err != nil {
 		return err
@@ -233,6 +248,10 @@ func updatePolicy(ctx context.Context, d *schema.ResourceData, m interface{}, te
 	if err != nil {
 		return err
 	}
+	// the lifecycle endpoints are only called when the status is changed
+	if !d.HasChange("status") {
+		return nil
+	}
 	return policyActivate(ctx, d, m)
 }
 
@@ -251,12 +270,61 @@ func deletePolicy(ctx context.Context, d *schema.ResourceData, m interface{}) er
 	return nil
 }
 
-func syncPolicyFromUpstream(d *schema.ResourceData, policy *sdk.Policy) error {
+func syncPolicyFromUpstream(ctx context.Context, d *schema.ResourceData, m interface{}, policy *sdk.Policy) error {
 	_ = d.Set("name", policy.Name)
 	_ = d.Set("description", policy.Description)
 	_ = d.Set("status", policy.Status)
 	_ = d.Set("priority", policy.Priority)
+	var groupsIncluded []string
+	if policy.Conditions != nil && policy.Conditions.People != nil && policy.Conditions.People.Groups != nil {
+		groupsIncluded = policy.Conditions.People.Groups.Include
+	}
+	// the groups configured by name are kept as names as long as the IDs they were resolved to are included
+	groupNames := make(map[string]string)
+	for name, id := range d.Get("groups_included_ids").(map[string]interface{}) {
+		groupNames[id.(string)] = name
+	}
+	for i, id := range groupsIncluded {
+		if name, ok := groupNames[id]; ok {
+			groupsIncluded[i] = name
+		}
+	}
 	return setNonPrimitives(d, map[string]interface{}{
-		"groups_included": convertStringSetToInterface(policy.Conditions.People.Groups.Include),
+		"groups_included": convertStringSetToInterface(groupsIncluded),
 	})
 }
+
+// markIncludedGroupIDsComputed marks the resolved group IDs as known after apply when the included groups change,
+// since the groups configured by name are only looked up when the policy is applied.
+func markIncludedGroupIDsComputed(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.HasChange("groups_included") {
+		return nil
+	}
+	return d.SetNewComputed("groups_included_ids")
+}
+
+var groupIDRegexp = regexp.MustCompile(`^00g[0-9a-zA-Z]{17}$`)
+
+// resolveIncludedGroupNames replaces the names of the included groups with their IDs and records them in the state,
+// so they don't have to be looked up again when the policy is read.
+func resolveIncludedGroupNames(ctx context.Context, d *schema.ResourceData, m interface{}, conditions *okta.PolicyRuleConditions) error {
+	if conditions == nil {
+		return nil
+	}
+	groupIDs := make(map[string]interface{})
+	if conditions.People != nil && conditions.People.Groups != nil {
+		include := conditions.People.Groups.Include
+		for i, name := range include {
+			if groupIDRegexp.MatchString(name) {
+				continue
+			}
+			id, err := getGroupIDByName(ctx, m, name)
+			if err != nil {
+				return err
+			}
+			groupIDs[name] = id
+			include[i] = id
+		}
+	}
+	return d.Set("groups_included_ids", groupIDs)
+}
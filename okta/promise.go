@@ -18,7 +18,7 @@ func promiseAll(limit int, wg *sync.WaitGroup, resp chan []*result, funcs ...fun
 	resultList := make([]*result, len(funcs))
 
 	for jobIndex < len(funcs) {
-		for i := 0; i < limit; i++ {
+		for i := 0; i < limit && jobIndex < len(funcs); i++ {
 			wg.Add(1)
 			go func(index int, cb func() error) {
 				defer wg.Done()
@@ -15,66 +15,97 @@ import (
 
 // Resource names, defined in place, used throughout the provider and tests
 const (
-	adminRoleTargets       = "okta_admin_role_targets"
-	appAutoLogin           = "okta_app_auto_login"
-	appBookmark            = "okta_app_bookmark"
-	appBasicAuth           = "okta_app_basic_auth"
-	appGroupAssignment     = "okta_app_group_assignment"
-	appGroupAssignments    = "okta_app_group_assignments"
-	appUser                = "okta_app_user"
-	appOAuth               = "okta_app_oauth"
-	appOAuthAPIScope       = "okta_app_oauth_api_scope"
-	appOAuthRedirectURI    = "okta_app_oauth_redirect_uri"
-	appSaml                = "okta_app_saml"
-	appSecurePasswordStore = "okta_app_secure_password_store"
-	appSwa                 = "okta_app_swa"
-	appSharedCredentials   = "okta_app_shared_credentials"
-	appThreeField          = "okta_app_three_field"
-	appUserSchema          = "okta_app_user_schema"
-	appUserBaseSchema      = "okta_app_user_base_schema"
-	authServer             = "okta_auth_server"
-	authServerDefault      = "okta_auth_server_default"
-	authServerClaim        = "okta_auth_server_claim"
-	authServerClaimDefault = "okta_auth_server_claim_default"
-	authServerPolicy       = "okta_auth_server_policy"
-	authServerPolicyRule   = "okta_auth_server_policy_rule"
-	authServerScope        = "okta_auth_server_scope"
-	behavior               = "okta_behavior"
-	behaviors              = "okta_behaviors"
-	eventHook              = "okta_event_hook"
-	factor                 = "okta_factor"
-	factorTotp             = "okta_factor_totp"
-	groupRole              = "okta_group_role"
-	groupRoles             = "okta_group_roles"
-	groupRule              = "okta_group_rule"
-	idpOidc                = "okta_idp_oidc"
-	idpSaml                = "okta_idp_saml"
-	idpSamlKey             = "okta_idp_saml_key"
-	idpSocial              = "okta_idp_social"
-	inlineHook             = "okta_inline_hook"
-	networkZone            = "okta_network_zone"
-	oktaGroup              = "okta_group"
-	oktaGroups             = "okta_groups"
-	oktaGroupMembership    = "okta_group_membership"
-	oktaGroupMemberships   = "okta_group_memberships"
-	oktaProfileMapping     = "okta_profile_mapping"
-	oktaUser               = "okta_user"
-	policyMfa              = "okta_policy_mfa"
-	policyMfaDefault       = "okta_policy_mfa_default"
-	policyPassword         = "okta_policy_password"
-	policyPasswordDefault  = "okta_policy_password_default"
-	policyRuleIdpDiscovery = "okta_policy_rule_idp_discovery"
-	policyRuleMfa          = "okta_policy_rule_mfa"
-	policyRulePassword     = "okta_policy_rule_password"
-	policyRuleSignOn       = "okta_policy_rule_signon"
-	policySignOn           = "okta_policy_signon"
-	templateEmail          = "okta_template_email"
-	templateSms            = "okta_template_sms"
-	trustedOrigin          = "okta_trusted_origin"
-	userBaseSchema         = "okta_user_base_schema"
-	userSchema             = "okta_user_schema"
-	userType               = "okta_user_type"
-	userGroupMemberships   = "okta_user_group_memberships"
+	adminRoleCustom              = "okta_admin_role_custom"
+	adminRoleCustomAssignment    = "okta_admin_role_custom_assignment"
+	adminRoleTargets             = "okta_admin_role_targets"
+	apiHealth                    = "okta_api_health"
+	appAutoLogin                 = "okta_app_auto_login"
+	appBookmark                  = "okta_app_bookmark"
+	appBasicAuth                 = "okta_app_basic_auth"
+	appGroupAssignment           = "okta_app_group_assignment"
+	appGroupAssignments          = "okta_app_group_assignments"
+	appKeyRotation               = "okta_app_key_rotation"
+	appUser                      = "okta_app_user"
+	appOAuth                     = "okta_app_oauth"
+	appOAuthAPIScope             = "okta_app_oauth_api_scope"
+	appOAuthRoleAssignment       = "okta_app_oauth_role_assignment"
+	appOAuthRedirectURI          = "okta_app_oauth_redirect_uri"
+	appSaml                      = "okta_app_saml"
+	appSecurePasswordStore       = "okta_app_secure_password_store"
+	appSignOnPolicy              = "okta_app_signon_policy"
+	appSignOnPolicyRule          = "okta_app_signon_policy_rule"
+	appSwa                       = "okta_app_swa"
+	appSharedCredentials         = "okta_app_shared_credentials"
+	appThreeField                = "okta_app_three_field"
+	appUserSchema                = "okta_app_user_schema"
+	appUserProvisioning          = "okta_app_user_provisioning"
+	appUserBaseSchema            = "okta_app_user_base_schema"
+	authenticator                = "okta_authenticator"
+	authServer                   = "okta_auth_server"
+	authServerDefault            = "okta_auth_server_default"
+	authServerClaim              = "okta_auth_server_claim"
+	authServerClaimDefault       = "okta_auth_server_claim_default"
+	authServerPolicy             = "okta_auth_server_policy"
+	authServerPolicyRule         = "okta_auth_server_policy_rule"
+	authServerScope              = "okta_auth_server_scope"
+	behavior                     = "okta_behavior"
+	behaviors                    = "okta_behaviors"
+	defaultBrand                 = "okta_default_brand"
+	emailSender                  = "okta_email_sender"
+	emailSenderVerification      = "okta_email_sender_verification"
+	emailSMTPServer              = "okta_email_smtp_server"
+	eventHook                    = "okta_event_hook"
+	eventHookVerification        = "okta_event_hook_verification"
+	factor                       = "okta_factor"
+	factorTotp                   = "okta_factor_totp"
+	groupRole                    = "okta_group_role"
+	groupRoles                   = "okta_group_roles"
+	groupRule                    = "okta_group_rule"
+	groupSchemaProperty          = "okta_group_schema_property"
+	idpOidc                      = "okta_idp_oidc"
+	idpSaml                      = "okta_idp_saml"
+	idpSamlKey                   = "okta_idp_saml_key"
+	idpSocial                    = "okta_idp_social"
+	inlineHook                   = "okta_inline_hook"
+	networkZone                  = "okta_network_zone"
+	networkZones                 = "okta_network_zones"
+	oinApps                      = "okta_oin_apps"
+	oktaGroup                    = "okta_group"
+	oktaGroups                   = "okta_groups"
+	oktaGroupMembership          = "okta_group_membership"
+	oktaGroupMemberships         = "okta_group_memberships"
+	oktaProfileMapping           = "okta_profile_mapping"
+	oktaUser                     = "okta_user"
+	orgSettings                  = "okta_org_settings"
+	policyDeviceAssuranceAndroid = "okta_policy_device_assurance_android"
+	policyDeviceAssuranceIOS     = "okta_policy_device_assurance_ios"
+	policyDeviceAssuranceMacOS   = "okta_policy_device_assurance_macos"
+	policyDeviceAssuranceWindows = "okta_policy_device_assurance_windows"
+	policyMfa                    = "okta_policy_mfa"
+	policyMfaDefault             = "okta_policy_mfa_default"
+	policyPassword               = "okta_policy_password"
+	policyPasswordDefault        = "okta_policy_password_default"
+	policyProfileEnrollment      = "okta_policy_profile_enrollment"
+	policyRuleIdpDiscovery       = "okta_policy_rule_idp_discovery"
+	policyRuleMfa                = "okta_policy_rule_mfa"
+	policyRulePassword           = "okta_policy_rule_password"
+	policyRuleOrder              = "okta_policy_rule_order"
+	policyRuleProfileEnrollment  = "okta_policy_rule_profile_enrollment"
+	policyRuleSignOn             = "okta_policy_rule_signon"
+	policySignOn                 = "okta_policy_signon"
+	rateLimiting                 = "okta_rate_limiting"
+	roleSubscription             = "okta_role_subscription"
+	templateEmail                = "okta_template_email"
+	templateSms                  = "okta_template_sms"
+	trustedOrigin                = "okta_trusted_origin"
+	trustedOrigins               = "okta_trusted_origins"
+	userBaseSchema               = "okta_user_base_schema"
+	userFactorReset              = "okta_user_factor_reset"
+	userSchema                   = "okta_user_schema"
+	userSessionRevocation        = "okta_user_session_revocation"
+	userType                     = "okta_user_type"
+	userTypes                    = "okta_user_types"
+	userGroupMemberships         = "okta_user_group_memberships"
 )
 
 // Provider establishes a client connection to an okta site
@@ -170,65 +201,104 @@ func Provider() *schema.Provider {
 				ValidateDiagFunc: intBetween(0, 300),
 				Description:      "Timeout for single request (in seconds) which is made to Okta, the default is `0` (means no limit is set). The maximum value can be `300`.",
 			},
+			"tracing_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", ""),
+				Description: "OTLP/HTTP endpoint the traces of the requests made to Okta are exported to, e.g. `http://localhost:4318/v1/traces`. Tracing is disabled when not set.",
+			},
+			"validate_user_password": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Validate the passwords of the users against the complexity requirements of the password policy which applies to their groups during the plan",
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			adminRoleTargets:       resourceAdminRoleTargets(),
-			appAutoLogin:           resourceAppAutoLogin(),
-			appBookmark:            resourceAppBookmark(),
-			appBasicAuth:           resourceAppBasicAuth(),
-			appGroupAssignment:     resourceAppGroupAssignment(),
-			appGroupAssignments:    resourceAppGroupAssignments(),
-			appUser:                resourceAppUser(),
-			appOAuth:               resourceAppOAuth(),
-			appOAuthAPIScope:       resourceAppOAuthAPIScope(),
-			appOAuthRedirectURI:    resourceAppOAuthRedirectURI(),
-			appSaml:                resourceAppSaml(),
-			appSecurePasswordStore: resourceAppSecurePasswordStore(),
-			appSwa:                 resourceAppSwa(),
-			appSharedCredentials:   resourceAppSharedCredentials(),
-			appThreeField:          resourceAppThreeField(),
-			appUserSchema:          resourceAppUserSchema(),
-			appUserBaseSchema:      resourceAppUserBaseSchema(),
-			authServer:             resourceAuthServer(),
-			authServerDefault:      resourceAuthServerDefault(),
-			authServerClaim:        resourceAuthServerClaim(),
-			authServerClaimDefault: resourceAuthServerClaimDefault(),
-			authServerPolicy:       resourceAuthServerPolicy(),
-			authServerPolicyRule:   resourceAuthServerPolicyRule(),
-			authServerScope:        resourceAuthServerScope(),
-			eventHook:              resourceEventHook(),
-			factor:                 resourceFactor(),
-			factorTotp:             resourceFactorTOTP(),
-			groupRole:              resourceGroupRole(),
-			groupRoles:             resourceGroupRoles(),
-			groupRule:              resourceGroupRule(),
-			idpOidc:                resourceIdpOidc(),
-			idpSaml:                resourceIdpSaml(),
-			idpSamlKey:             resourceIdpSigningKey(),
-			idpSocial:              resourceIdpSocial(),
-			inlineHook:             resourceInlineHook(),
-			networkZone:            resourceNetworkZone(),
-			oktaGroup:              resourceGroup(),
-			oktaGroupMembership:    resourceGroupMembership(),
-			oktaGroupMemberships:   resourceGroupMemberships(),
-			oktaProfileMapping:     resourceOktaProfileMapping(),
-			oktaUser:               resourceUser(),
-			policyMfa:              resourcePolicyMfa(),
-			policyMfaDefault:       resourcePolicyMfaDefault(),
-			policyPassword:         resourcePolicyPassword(),
-			policyPasswordDefault:  resourcePolicyPasswordDefault(),
-			policySignOn:           resourcePolicySignOn(),
-			policyRuleIdpDiscovery: resourcePolicyRuleIdpDiscovery(),
-			policyRuleMfa:          resourcePolicyMfaRule(),
-			policyRulePassword:     resourcePolicyPasswordRule(),
-			policyRuleSignOn:       resourcePolicySignOnRule(),
-			templateEmail:          resourceTemplateEmail(),
-			templateSms:            resourceTemplateSms(),
-			trustedOrigin:          resourceTrustedOrigin(),
-			userSchema:             resourceUserSchema(),
-			userBaseSchema:         resourceUserBaseSchema(),
-			userType:               resourceUserType(),
-			userGroupMemberships:   resourceUserGroupMemberships(),
+			adminRoleCustom:              resourceAdminRoleCustom(),
+			adminRoleCustomAssignment:    resourceAdminRoleCustomAssignment(),
+			adminRoleTargets:             resourceAdminRoleTargets(),
+			appAutoLogin:                 resourceAppAutoLogin(),
+			appBookmark:                  resourceAppBookmark(),
+			appBasicAuth:                 resourceAppBasicAuth(),
+			appGroupAssignment:           resourceAppGroupAssignment(),
+			appGroupAssignments:          resourceAppGroupAssignments(),
+			appKeyRotation:               resourceAppKeyRotation(),
+			appUser:                      resourceAppUser(),
+			appOAuth:                     resourceAppOAuth(),
+			appOAuthAPIScope:             resourceAppOAuthAPIScope(),
+			appOAuthRoleAssignment:       resourceAppOAuthRoleAssignment(),
+			appOAuthRedirectURI:          resourceAppOAuthRedirectURI(),
+			appSaml:                      resourceAppSaml(),
+			appSecurePasswordStore:       resourceAppSecurePasswordStore(),
+			appSignOnPolicy:              resourceAppSignOnPolicy(),
+			appSignOnPolicyRule:          resourceAppSignOnPolicyRule(),
+			appSwa:                       resourceAppSwa(),
+			appSharedCredentials:         resourceAppSharedCredentials(),
+			appThreeField:                resourceAppThreeField(),
+			appUserSchema:                resourceAppUserSchema(),
+			appUserProvisioning:          resourceAppUserProvisioning(),
+			appUserBaseSchema:            resourceAppUserBaseSchema(),
+			authenticator:                resourceAuthenticator(),
+			authServer:                   resourceAuthServer(),
+			authServerDefault:            resourceAuthServerDefault(),
+			authServerClaim:              resourceAuthServerClaim(),
+			authServerClaimDefault:       resourceAuthServerClaimDefault(),
+			authServerPolicy:             resourceAuthServerPolicy(),
+			authServerPolicyRule:         resourceAuthServerPolicyRule(),
+			authServerScope:              resourceAuthServerScope(),
+			behavior:                     resourceBehavior(),
+			defaultBrand:                 resourceDefaultBrand(),
+			emailSender:                  resourceEmailSender(),
+			emailSenderVerification:      resourceEmailSenderVerification(),
+			emailSMTPServer:              resourceEmailSMTPServer(),
+			eventHook:                    resourceEventHook(),
+			eventHookVerification:        resourceEventHookVerification(),
+			factor:                       resourceFactor(),
+			factorTotp:                   resourceFactorTOTP(),
+			groupRole:                    resourceGroupRole(),
+			groupRoles:                   resourceGroupRoles(),
+			groupRule:                    resourceGroupRule(),
+			groupSchemaProperty:          resourceGroupSchemaProperty(),
+			idpOidc:                      resourceIdpOidc(),
+			idpSaml:                      resourceIdpSaml(),
+			idpSamlKey:                   resourceIdpSigningKey(),
+			idpSocial:                    resourceIdpSocial(),
+			inlineHook:                   resourceInlineHook(),
+			networkZone:                  resourceNetworkZone(),
+			oktaGroup:                    resourceGroup(),
+			oktaGroupMembership:          resourceGroupMembership(),
+			oktaGroupMemberships:         resourceGroupMemberships(),
+			oktaProfileMapping:           resourceOktaProfileMapping(),
+			oktaUser:                     resourceUser(),
+			orgSettings:                  resourceOrgSettings(),
+			policyDeviceAssuranceAndroid: resourcePolicyDeviceAssuranceAndroid(),
+			policyDeviceAssuranceIOS:     resourcePolicyDeviceAssuranceIOS(),
+			policyDeviceAssuranceMacOS:   resourcePolicyDeviceAssuranceMacOS(),
+			policyDeviceAssuranceWindows: resourcePolicyDeviceAssuranceWindows(),
+			policyMfa:                    resourcePolicyMfa(),
+			policyMfaDefault:             resourcePolicyMfaDefault(),
+			policyPassword:               resourcePolicyPassword(),
+			policyPasswordDefault:        resourcePolicyPasswordDefault(),
+			policyProfileEnrollment:      resourcePolicyProfileEnrollment(),
+			policySignOn:                 resourcePolicySignOn(),
+			policyRuleIdpDiscovery:       resourcePolicyRuleIdpDiscovery(),
+			policyRuleMfa:                resourcePolicyMfaRule(),
+			policyRulePassword:           resourcePolicyPasswordRule(),
+			policyRuleOrder:              resourcePolicyRuleOrder(),
+			policyRuleProfileEnrollment:  resourcePolicyProfileEnrollmentRule(),
+			policyRuleSignOn:             resourcePolicySignOnRule(),
+			rateLimiting:                 resourceRateLimiting(),
+			roleSubscription:             resourceRoleSubscription(),
+			templateEmail:                resourceTemplateEmail(),
+			templateSms:                  resourceTemplateSms(),
+			trustedOrigin:                resourceTrustedOrigin(),
+			userSchema:                   resourceUserSchema(),
+			userBaseSchema:               resourceUserBaseSchema(),
+			userFactorReset:              resourceUserFactorReset(),
+			userSessionRevocation:        resourceUserSessionRevocation(),
+			userType:                     resourceUserType(),
+			userGroupMemberships:         resourceUserGroupMemberships(),
 
 			// The day I realized I was naming stuff wrong :'-(
 			"okta_idp":                       deprecateIncorrectNaming(resourceIdpOidc(), idpOidc),
@@ -250,8 +320,10 @@ func Provider() *schema.Provider {
 			"okta_mfa_policy":                deprecateIncorrectNaming(resourcePolicyMfa(), policyMfa),
 			"okta_mfa_policy_rule":           deprecateIncorrectNaming(resourcePolicyMfaRule(), policyRuleMfa),
 		},
-		DataSourcesMap: map[string]*schema.Resource{
+		DataSourcesMap: coalesceDataSourceRequests(map[string]*schema.Resource{
+			apiHealth:                          dataSourceAPIHealth(),
 			"okta_app":                         dataSourceApp(),
+			"okta_apps":                        dataSourceApps(),
 			appGroupAssignments:                dataSourceAppGroupAssignments(),
 			appSaml:                            dataSourceAppSaml(),
 			appOAuth:                           dataSourceAppOauth(),
@@ -264,23 +336,48 @@ func Provider() *schema.Provider {
 			behaviors:                          dataSourceBehaviors(),
 			oktaGroup:                          dataSourceGroup(),
 			oktaGroups:                         dataSourceGroups(),
+			networkZone:                        dataSourceNetworkZone(),
+			networkZones:                       dataSourceNetworkZones(),
+			oinApps:                            dataSourceOinApps(),
+			"okta_org_metadata":                dataSourceOrgMetadata(),
+			groupRule:                          dataSourceGroupRule(),
 			"okta_idp_metadata_saml":           dataSourceIdpMetadataSaml(),
 			idpSaml:                            dataSourceIdpSaml(),
 			idpOidc:                            dataSourceIdpOidc(),
 			idpSocial:                          dataSourceIdpSocial(),
 			"okta_policy":                      dataSourcePolicy(),
+			roleSubscription:                   dataSourceRoleSubscription(),
 			authServerPolicy:                   dataSourceAuthServerPolicy(),
 			"okta_user_profile_mapping_source": dataSourceUserProfileMappingSource(),
 			oktaUser:                           dataSourceUser(),
 			"okta_users":                       dataSourceUsers(),
+			trustedOrigins:                     dataSourceTrustedOrigins(),
 			authServer:                         dataSourceAuthServer(),
+			"okta_auth_server_claims":          dataSourceAuthServerClaims(),
 			"okta_auth_server_scopes":          dataSourceAuthServerScopes(),
 			userType:                           dataSourceUserType(),
-		},
+			userTypes:                          dataSourceUserTypes(),
+		}),
 		ConfigureContextFunc: providerConfigure,
 	}
 }
 
+// coalesceDataSourceRequests makes identical GET requests of the data sources deduplicated, since large
+// configurations often have lots of data sources reading the same objects. The API health data source is left out,
+// since it has to measure the API with a request of its own.
+func coalesceDataSourceRequests(dataSources map[string]*schema.Resource) map[string]*schema.Resource {
+	for name, ds := range dataSources {
+		if name == apiHealth {
+			continue
+		}
+		read := ds.ReadContext
+		ds.ReadContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return read(context.WithValue(ctx, coalesceRequests, true), d, m)
+		}
+	}
+	return dataSources
+}
+
 func deprecateIncorrectNaming(d *schema.Resource, newResource string) *schema.Resource {
 	d.DeprecationMessage = fmt.Sprintf("Resource is deprecated due to a correction in naming conventions, please use %s instead.", newResource)
 	return d
@@ -289,19 +386,21 @@ func deprecateIncorrectNaming(d *schema.Resource, newResource string) *schema.Re
 func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	log.Printf("[INFO] Initializing Okta client")
 	config := Config{
-		orgName:        d.Get("org_name").(string),
-		domain:         d.Get("base_url").(string),
-		apiToken:       d.Get("api_token").(string),
-		parallelism:    d.Get("parallelism").(int),
-		clientID:       d.Get("client_id").(string),
-		privateKey:     d.Get("private_key").(string),
-		scopes:         convertInterfaceToStringSet(d.Get("scopes")),
-		retryCount:     d.Get("max_retries").(int),
-		minWait:        d.Get("min_wait_seconds").(int),
-		maxWait:        d.Get("max_wait_seconds").(int),
-		backoff:        d.Get("backoff").(bool),
-		logLevel:       d.Get("log_level").(int),
-		requestTimeout: d.Get("request_timeout").(int),
+		orgName:              d.Get("org_name").(string),
+		domain:               d.Get("base_url").(string),
+		apiToken:             d.Get("api_token").(string),
+		parallelism:          d.Get("parallelism").(int),
+		clientID:             d.Get("client_id").(string),
+		privateKey:           d.Get("private_key").(string),
+		scopes:               convertInterfaceToStringSet(d.Get("scopes")),
+		retryCount:           d.Get("max_retries").(int),
+		minWait:              d.Get("min_wait_seconds").(int),
+		maxWait:              d.Get("max_wait_seconds").(int),
+		backoff:              d.Get("backoff").(bool),
+		logLevel:             d.Get("log_level").(int),
+		requestTimeout:       d.Get("request_timeout").(int),
+		tracingEndpoint:      d.Get("tracing_endpoint").(string),
+		validateUserPassword: d.Get("validate_user_password").(bool),
 	}
 	if err := config.loadAndValidate(); err != nil {
 		return nil, diag.Errorf("[ERROR] Error initializing the Okta SDK clients: %v", err)
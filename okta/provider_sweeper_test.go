@@ -24,6 +24,8 @@ func TestMain(m *testing.M) {
 	// Acceptance test sweepers necessary to prevent dangling resources
 	setupSweeper(policyPassword, deletePasswordPolicies)
 	setupSweeper(policySignOn, deleteSignOnPolicies)
+	setupSweeper(appSignOnPolicy, deleteAppSignOnPolicies)
+	setupSweeper(policyProfileEnrollment, deleteProfileEnrollmentPolicies)
 	setupSweeper(policyRuleIdpDiscovery, deletePolicyRuleIdpDiscovery)
 	setupSweeper(policyMfa, deleteMfaPolicies)
 	setupSweeper(policyRuleSignOn, deleteSignOnPolicyRules)
@@ -36,8 +38,11 @@ func TestMain(m *testing.M) {
 	setupSweeper(oktaGroup, sweepGroups)
 	setupSweeper(oktaUser, sweepUsers)
 	setupSweeper(userSchema, sweepUserSchema)
+	setupSweeper(groupSchemaProperty, sweepGroupSchemaProperties)
 	setupSweeper(userBaseSchema, sweepUserBaseSchema)
 	setupSweeper(networkZone, sweepNetworkZones)
+	setupSweeper(behavior, sweepBehaviors)
+	setupSweeper("okta_policy_device_assurance_*", sweepDeviceAssurances)
 	setupSweeper(inlineHook, sweepInlineHooks)
 	setupSweeper(userType, sweepUserTypes)
 
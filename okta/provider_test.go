@@ -1,13 +1,19 @@
 package okta
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/terraform-provider-okta/sdk"
 )
 
 var (
@@ -73,3 +79,24 @@ func accPreCheck() error {
 	}
 	return nil
 }
+
+// newTestOktaClient serves the Okta API requests of the test with the handler, and returns the provider config
+// using it. The server is closed when the test ends.
+func newTestOktaClient(t *testing.T, handler http.Handler) *Config {
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+	_, client, err := okta.NewClient(context.Background(),
+		okta.WithOrgUrl(ts.URL),
+		okta.WithToken("token"),
+		okta.WithCache(false),
+		okta.WithTestingDisableHttpsCheck(true),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Config{
+		oktaClient:       client,
+		supplementClient: &sdk.ApiSupplement{RequestExecutor: client.GetRequestExecutor()},
+		logger:           hclog.NewNullLogger(),
+	}
+}
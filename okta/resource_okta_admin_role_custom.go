@@ -0,0 +1,241 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+// userProfileConditionKey is the only attribute the permission conditions can be set on
+const userProfileConditionKey = "okta:ResourceAttribute/User/Profile"
+
+var permissionConditionsResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"permission": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The permission the conditions are applied to, it must be one of the 'permissions' of the role",
+		},
+		"include": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "User profile attributes the permission is limited to",
+		},
+		"exclude": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "User profile attributes the permission does not apply to",
+		},
+	},
+}
+
+func resourceAdminRoleCustom() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAdminRoleCustomCreate,
+		ReadContext:   resourceAdminRoleCustomRead,
+		UpdateContext: resourceAdminRoleCustomUpdate,
+		DeleteContext: resourceAdminRoleCustomDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: validateAdminRoleCustomConditions,
+		Schema: map[string]*schema.Schema{
+			"label": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringLenBetween(1, 50),
+				Description:      "The name given to the new Role",
+			},
+			"description": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringLenBetween(1, 255),
+				Description:      "A human-readable description of the new Role",
+			},
+			"permissions": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: stringIsPermission},
+				Description: "The permissions that the new Role grants.",
+			},
+			"conditions": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        permissionConditionsResource,
+				Description: "Conditions limiting the user profile attributes the permissions of the Role apply to.",
+			},
+		},
+	}
+}
+
+func resourceAdminRoleCustomCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	role, _, err := getSupplementFromMetadata(m).CreateCustomRole(ctx, buildCustomRole(d))
+	if err != nil {
+		return diag.Errorf("failed to create custom admin role: %v", err)
+	}
+	d.SetId(role.ID)
+	for permission, conditions := range buildPermissionConditions(d.Get("conditions").(*schema.Set)) {
+		_, err = getSupplementFromMetadata(m).UpdateCustomRolePermissionConditions(ctx, d.Id(), permission, conditions)
+		if err != nil {
+			return diag.Errorf("failed to set conditions of %s permission of custom admin role: %v", permission, err)
+		}
+	}
+	return resourceAdminRoleCustomRead(ctx, d, m)
+}
+
+func resourceAdminRoleCustomRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	role, resp, err := getSupplementFromMetadata(m).GetCustomRole(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get custom admin role: %v", err)
+	}
+	if role == nil {
+		d.SetId("")
+		return nil
+	}
+	perms, _, err := getSupplementFromMetadata(m).ListCustomRolePermissions(ctx, d.Id())
+	if err != nil {
+		return diag.Errorf("failed to list permissions of custom admin role: %v", err)
+	}
+	_ = d.Set("label", role.Label)
+	_ = d.Set("description", role.Description)
+	_ = d.Set("permissions", flattenCustomRolePermissions(perms))
+	conditions := make(map[string]*sdk.PermissionConditions)
+	for _, perm := range perms {
+		if perm.Conditions != nil {
+			conditions[perm.Label] = perm.Conditions
+		}
+	}
+	_ = d.Set("conditions", flattenPermissionConditions(conditions))
+	return nil
+}
+
+func resourceAdminRoleCustomUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := getSupplementFromMetadata(m)
+	if d.HasChanges("label", "description") {
+		_, _, err := client.UpdateCustomRole(ctx, d.Id(), buildCustomRole(d))
+		if err != nil {
+			return diag.Errorf("failed to update custom admin role: %v", err)
+		}
+	}
+	if d.HasChange("permissions") {
+		oldPerms, newPerms := d.GetChange("permissions")
+		oldSet := oldPerms.(*schema.Set)
+		newSet := newPerms.(*schema.Set)
+		for _, perm := range convertInterfaceArrToStringArr(newSet.Difference(oldSet).List()) {
+			_, err := client.AddCustomRolePermission(ctx, d.Id(), perm)
+			if err != nil {
+				return diag.Errorf("failed to add %s permission to custom admin role: %v", perm, err)
+			}
+		}
+		for _, perm := range convertInterfaceArrToStringArr(oldSet.Difference(newSet).List()) {
+			_, err := client.DeleteCustomRolePermission(ctx, d.Id(), perm)
+			if err != nil {
+				return diag.Errorf("failed to remove %s permission from custom admin role: %v", perm, err)
+			}
+		}
+	}
+	if d.HasChange("conditions") {
+		// only the conditions blocks whose hash changed are put, the removed blocks are cleared unless
+		// the permission itself was removed along with its conditions
+		oldConditions, newConditions := d.GetChange("conditions")
+		oldSet, newSet := oldConditions.(*schema.Set), newConditions.(*schema.Set)
+		changedByPermission := buildPermissionConditions(newSet.Difference(oldSet))
+		newByPermission := buildPermissionConditions(newSet)
+		permissions := convertInterfaceToStringSet(d.Get("permissions"))
+		for permission := range buildPermissionConditions(oldSet.Difference(newSet)) {
+			if _, ok := newByPermission[permission]; ok || !contains(permissions, permission) {
+				continue
+			}
+			_, err := client.UpdateCustomRolePermissionConditions(ctx, d.Id(), permission, nil)
+			if err != nil {
+				return diag.Errorf("failed to remove conditions of %s permission of custom admin role: %v", permission, err)
+			}
+		}
+		for permission, conditions := range changedByPermission {
+			_, err := client.UpdateCustomRolePermissionConditions(ctx, d.Id(), permission, conditions)
+			if err != nil {
+				return diag.Errorf("failed to set conditions of %s permission of custom admin role: %v", permission, err)
+			}
+		}
+	}
+	return resourceAdminRoleCustomRead(ctx, d, m)
+}
+
+func resourceAdminRoleCustomDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	resp, err := getSupplementFromMetadata(m).DeleteCustomRole(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to delete custom admin role: %v", err)
+	}
+	return nil
+}
+
+func buildCustomRole(d *schema.ResourceData) sdk.CustomRole {
+	return sdk.CustomRole{
+		Label:       d.Get("label").(string),
+		Description: d.Get("description").(string),
+		Permissions: convertInterfaceToStringSetNullable(d.Get("permissions")),
+	}
+}
+
+func flattenCustomRolePermissions(perms []*sdk.CustomRolePermission) *schema.Set {
+	arr := make([]string, len(perms))
+	for i := range perms {
+		arr[i] = perms[i].Label
+	}
+	return convertStringSetToInterface(arr)
+}
+
+func validateAdminRoleCustomConditions(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("permissions") || !d.NewValueKnown("conditions") {
+		return nil
+	}
+	permissions := convertInterfaceToStringSet(d.Get("permissions"))
+	for _, raw := range d.Get("conditions").(*schema.Set).List() {
+		condition := raw.(map[string]interface{})
+		permission := condition["permission"].(string)
+		if !contains(permissions, permission) {
+			return fmt.Errorf("conditions can only be set on the permissions of the role, '%s' is not one of them", permission)
+		}
+		if condition["include"].(*schema.Set).Len() == 0 && condition["exclude"].(*schema.Set).Len() == 0 {
+			return fmt.Errorf("either 'include' or 'exclude' has to be set in the conditions of '%s' permission", permission)
+		}
+	}
+	return nil
+}
+
+func buildPermissionConditions(set *schema.Set) map[string]*sdk.PermissionConditions {
+	conditions := make(map[string]*sdk.PermissionConditions)
+	for _, raw := range set.List() {
+		condition := raw.(map[string]interface{})
+		c := &sdk.PermissionConditions{}
+		if include := convertInterfaceToStringSet(condition["include"]); len(include) > 0 {
+			c.Include = map[string][]string{userProfileConditionKey: include}
+		}
+		if exclude := convertInterfaceToStringSet(condition["exclude"]); len(exclude) > 0 {
+			c.Exclude = map[string][]string{userProfileConditionKey: exclude}
+		}
+		conditions[condition["permission"].(string)] = c
+	}
+	return conditions
+}
+
+func flattenPermissionConditions(conditions map[string]*sdk.PermissionConditions) *schema.Set {
+	var arr []interface{}
+	for permission, c := range conditions {
+		if len(c.Include[userProfileConditionKey]) == 0 && len(c.Exclude[userProfileConditionKey]) == 0 {
+			continue
+		}
+		arr = append(arr, map[string]interface{}{
+			"permission": permission,
+			"include":    convertStringSetToInterface(c.Include[userProfileConditionKey]),
+			"exclude":    convertStringSetToInterface(c.Exclude[userProfileConditionKey]),
+		})
+	}
+	return schema.NewSet(schema.HashResource(permissionConditionsResource), arr)
+}
@@ -0,0 +1,123 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourceAdminRoleCustomAssignment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAdminRoleCustomAssignmentCreate,
+		ReadContext:   resourceAdminRoleCustomAssignmentRead,
+		UpdateContext: resourceAdminRoleCustomAssignmentUpdate,
+		DeleteContext: resourceAdminRoleCustomAssignmentDelete,
+		Importer:      createNestedResourceImporter([]string{"resource_set_id", "custom_role_id"}),
+		Schema: map[string]*schema.Schema{
+			"resource_set_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the target Resource Set",
+			},
+			"custom_role_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the Custom Role",
+			},
+			"members": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The hrefs that point to User(s) and/or Group(s) that receive the Role",
+			},
+		},
+	}
+}
+
+func resourceAdminRoleCustomAssignmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	resourceSetID := d.Get("resource_set_id").(string)
+	customRoleID := d.Get("custom_role_id").(string)
+	body := sdk.CustomRoleBinding{
+		Role:    customRoleID,
+		Members: convertInterfaceToStringSetNullable(d.Get("members")),
+	}
+	_, _, err := getSupplementFromMetadata(m).CreateCustomRoleBinding(ctx, resourceSetID, body)
+	if err != nil {
+		return diag.Errorf("failed to assign custom admin role: %v", err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", resourceSetID, customRoleID))
+	return resourceAdminRoleCustomAssignmentRead(ctx, d, m)
+}
+
+func resourceAdminRoleCustomAssignmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	resourceSetID := d.Get("resource_set_id").(string)
+	customRoleID := d.Get("custom_role_id").(string)
+	binding, resp, err := getSupplementFromMetadata(m).GetCustomRoleBinding(ctx, resourceSetID, customRoleID)
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get custom admin role assignment: %v", err)
+	}
+	if binding == nil {
+		d.SetId("")
+		return nil
+	}
+	members, _, err := getSupplementFromMetadata(m).ListCustomRoleBindingMembers(ctx, resourceSetID, customRoleID)
+	if err != nil {
+		return diag.Errorf("failed to list members of custom admin role assignment: %v", err)
+	}
+	hrefs := make([]string, len(members))
+	for i := range members {
+		hrefs[i] = members[i].Href()
+	}
+	_ = d.Set("members", convertStringSetToInterface(hrefs))
+	return nil
+}
+
+func resourceAdminRoleCustomAssignmentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if !d.HasChange("members") {
+		return nil
+	}
+	client := getSupplementFromMetadata(m)
+	resourceSetID := d.Get("resource_set_id").(string)
+	customRoleID := d.Get("custom_role_id").(string)
+	oldMembers, newMembers := d.GetChange("members")
+	oldSet := oldMembers.(*schema.Set)
+	newSet := newMembers.(*schema.Set)
+	membersToAdd := convertInterfaceArrToStringArr(newSet.Difference(oldSet).List())
+	membersToRemove := convertInterfaceArrToStringArr(oldSet.Difference(newSet).List())
+	if len(membersToAdd) > 0 {
+		_, err := client.AddCustomRoleBindingMembers(ctx, resourceSetID, customRoleID, membersToAdd)
+		if err != nil {
+			return diag.Errorf("failed to add members to custom admin role assignment: %v", err)
+		}
+	}
+	if len(membersToRemove) > 0 {
+		// members can only be removed by their IDs, which are not the same as the IDs of the users or groups
+		members, _, err := client.ListCustomRoleBindingMembers(ctx, resourceSetID, customRoleID)
+		if err != nil {
+			return diag.Errorf("failed to list members of custom admin role assignment: %v", err)
+		}
+		for _, member := range members {
+			if !contains(membersToRemove, member.Href()) {
+				continue
+			}
+			resp, err := client.DeleteCustomRoleBindingMember(ctx, resourceSetID, customRoleID, member.ID)
+			if err := suppressErrorOn404(resp, err); err != nil {
+				return diag.Errorf("failed to remove member from custom admin role assignment: %v", err)
+			}
+		}
+	}
+	return resourceAdminRoleCustomAssignmentRead(ctx, d, m)
+}
+
+func resourceAdminRoleCustomAssignmentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	resp, err := getSupplementFromMetadata(m).DeleteCustomRoleBinding(ctx, d.Get("resource_set_id").(string), d.Get("custom_role_id").(string))
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to delete custom admin role assignment: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// The provider does not manage resource sets, so the test creates one through the API and passes it along with the
+// org URL to the fixtures as variables
+func TestAccOktaAdminRoleCustomAssignment_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(adminRoleCustomAssignment)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", adminRoleCustomAssignment)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			setupAdminRoleCustomAssignmentVars(t, ri)
+		},
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(adminRoleCustomAssignment, doesAdminRoleCustomAssignmentExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, doesAdminRoleCustomAssignmentExist),
+					resource.TestCheckResourceAttrPair(resourceName, "custom_role_id", "okta_admin_role_custom.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "members.#", "2"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, doesAdminRoleCustomAssignmentExist),
+					resource.TestCheckResourceAttr(resourceName, "members.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// setupAdminRoleCustomAssignmentVars creates the resource set for the test and exposes it and the org URL to the
+// fixtures through TF_VAR_ environment variables. Both are cleaned up when the test ends.
+func setupAdminRoleCustomAssignmentVars(t *testing.T, ri int) {
+	c, err := oktaConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	orgURL := strings.TrimSuffix(c.oktaClient.GetConfig().Okta.Client.OrgUrl, "/")
+	ctx := context.Background()
+	re := c.supplementClient.RequestExecutor
+	body := map[string]interface{}{
+		"label":       fmt.Sprintf("testAcc_%d", ri),
+		"description": "Testing custom admin role assignment",
+		"resources":   []string{orgURL + "/api/v1/users"},
+	}
+	req, err := re.NewRequest(http.MethodPost, "/api/v1/iam/resource-sets", body)
+	if err != nil {
+		t.Fatalf("failed to create resource set: %v", err)
+	}
+	var resourceSet struct {
+		ID string `json:"id"`
+	}
+	if _, err := re.Do(ctx, req, &resourceSet); err != nil {
+		t.Fatalf("failed to create resource set: %v", err)
+	}
+	t.Cleanup(func() {
+		req, err := re.NewRequest(http.MethodDelete, "/api/v1/iam/resource-sets/"+resourceSet.ID, nil)
+		if err == nil {
+			_, err = re.Do(ctx, req, nil)
+		}
+		if err != nil {
+			t.Errorf("failed to delete resource set: %v", err)
+		}
+	})
+	setTestEnv(t, "TF_VAR_resource_set_id", resourceSet.ID)
+	setTestEnv(t, "TF_VAR_org_url", orgURL)
+}
+
+func setTestEnv(t *testing.T, key, value string) {
+	if err := os.Setenv(key, value); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.Unsetenv(key) })
+}
+
+func doesAdminRoleCustomAssignmentExist(id string) (bool, error) {
+	parts := strings.Split(id, "/")
+	_, response, err := getSupplementFromMetadata(testAccProvider.Meta()).GetCustomRoleBinding(context.Background(), parts[0], parts[1])
+	return doesResourceExist(response, err)
+}
@@ -0,0 +1,165 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccOktaAdminRoleCustom_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := fmt.Sprintf("%s.test", adminRoleCustom)
+	mgr := newFixtureManager(adminRoleCustom)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(adminRoleCustom, doesAdminRoleCustomExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, doesAdminRoleCustomExist),
+					resource.TestCheckResourceAttr(resourceName, "label", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "description", "Testing custom admin role"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", "okta.users.read"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", "okta.groups.read"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, doesAdminRoleCustomExist),
+					resource.TestCheckResourceAttr(resourceName, "label", fmt.Sprintf("testAccUpdated_%d", ri)),
+					resource.TestCheckResourceAttr(resourceName, "description", "Testing updated custom admin role"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "3"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", "okta.users.read"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", "okta.groups.manage"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", "okta.users.userprofile.manage"),
+					resource.TestCheckResourceAttr(resourceName, "conditions.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "conditions.*", map[string]string{
+						"permission": "okta.users.userprofile.manage",
+						"include.#":  "2",
+						"exclude.#":  "0",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestPermissionConditions(t *testing.T) {
+	set := schema.NewSet(schema.HashResource(permissionConditionsResource), []interface{}{
+		map[string]interface{}{
+			"permission": "okta.users.userprofile.manage",
+			"include":    convertStringSetToInterface([]string{"city", "zipCode"}),
+			"exclude":    convertStringSetToInterface(nil),
+		},
+		map[string]interface{}{
+			"permission": "okta.users.read",
+			"include":    convertStringSetToInterface(nil),
+			"exclude":    convertStringSetToInterface([]string{"ssn"}),
+		},
+	})
+	conditions := buildPermissionConditions(set)
+	if len(conditions) != 2 {
+		t.Fatalf("expected conditions of 2 permissions, got %d", len(conditions))
+	}
+	manage := conditions["okta.users.userprofile.manage"]
+	if len(manage.Include[userProfileConditionKey]) != 2 || manage.Exclude != nil {
+		t.Errorf("unexpected conditions of okta.users.userprofile.manage: %+v", manage)
+	}
+	read := conditions["okta.users.read"]
+	if read.Include != nil || !reflect.DeepEqual(read.Exclude[userProfileConditionKey], []string{"ssn"}) {
+		t.Errorf("unexpected conditions of okta.users.read: %+v", read)
+	}
+	if flattened := flattenPermissionConditions(conditions); !flattened.Equal(set) {
+		t.Errorf("expected flattened conditions to be %v, got %v", set.List(), flattened.List())
+	}
+}
+
+func TestResourceAdminRoleCustomUpdateConditions(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		requests []string
+	)
+	m := newTestOktaClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/iam/roles/cr1":
+			_, _ = w.Write([]byte(`{"id":"cr1","label":"Help desk","description":"Help desk"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/iam/roles/cr1/permissions":
+			_, _ = w.Write([]byte(`{"permissions":[` +
+				`{"label":"okta.users.read","conditions":{"exclude":{"okta:ResourceAttribute/User/Profile":["ssn"]}}},` +
+				`{"label":"okta.users.userprofile.manage","conditions":{"include":{"okta:ResourceAttribute/User/Profile":["city","zipCode"]}}}]}`))
+		default:
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}))
+	r := resourceAdminRoleCustom()
+	prior := r.Data(nil)
+	prior.SetId("cr1")
+	_ = prior.Set("label", "Help desk")
+	_ = prior.Set("description", "Help desk")
+	_ = prior.Set("permissions", convertStringSetToInterface([]string{"okta.users.read", "okta.users.userprofile.manage"}))
+	_ = prior.Set("conditions", []interface{}{
+		map[string]interface{}{"permission": "okta.users.read", "exclude": []interface{}{"ssn"}},
+		map[string]interface{}{"permission": "okta.users.userprofile.manage", "include": []interface{}{"city"}},
+	})
+	config := map[string]interface{}{
+		"label":       "Help desk",
+		"description": "Help desk",
+		"permissions": []interface{}{"okta.users.read", "okta.users.userprofile.manage"},
+		"conditions": []interface{}{
+			map[string]interface{}{"permission": "okta.users.read", "exclude": []interface{}{"ssn"}},
+			map[string]interface{}{"permission": "okta.users.userprofile.manage", "include": []interface{}{"city", "zipCode"}},
+		},
+	}
+	s := prior.State()
+	diff, err := r.Diff(context.Background(), s, terraform.NewResourceConfigRaw(config), m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := schema.InternalMap(r.Schema).Data(s, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diags := resourceAdminRoleCustomUpdate(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("failed to update custom admin role: %v", diags)
+	}
+	expected := []string{
+		"PUT /api/v1/iam/roles/cr1/permissions/okta.users.userprofile.manage",
+		"GET /api/v1/iam/roles/cr1",
+		"GET /api/v1/iam/roles/cr1/permissions",
+	}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Errorf("expected requests %v, got %v", expected, requests)
+	}
+	if d.Get("conditions").(*schema.Set).Len() != 2 {
+		t.Errorf("expected the conditions of 2 permissions to be read from the list of the permissions, got %v", d.Get("conditions"))
+	}
+}
+
+func doesAdminRoleCustomExist(id string) (bool, error) {
+	_, response, err := getSupplementFromMetadata(testAccProvider.Meta()).GetCustomRole(context.Background(), id)
+	return doesResourceExist(response, err)
+}
@@ -6,18 +6,19 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/okta-sdk-golang/v2/okta"
-	"github.com/okta/okta-sdk-golang/v2/okta/query"
 )
 
 func resourceAppAutoLogin() *schema.Resource {
-	return &schema.Resource{
+	return withResumableSteps(&schema.Resource{
 		CreateContext: resourceAppAutoLoginCreate,
 		ReadContext:   resourceAppAutoLoginRead,
 		UpdateContext: resourceAppAutoLoginUpdate,
 		DeleteContext: resourceAppAutoLoginDelete,
+		Timeouts:      appTimeouts(),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: warnAppSwaCredentialsConflict,
 		Schema: buildAppSwaSchema(map[string]*schema.Schema{
 			"preconfigured_app": {
 				Type:        schema.TypeString,
@@ -67,28 +68,30 @@ func resourceAppAutoLogin() *schema.Resource {
 				Description: "Shared password, required for certain schemes.",
 			},
 		}),
-	}
+	}, appStepKeys...)
 }
 
 func resourceAppAutoLoginCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	app := buildAppAutoLogin(d)
-	activate := d.Get("status").(string) == statusActive
-	params := &query.Params{Activate: &activate}
-	_, _, err := getOktaClientFromMetadata(m).Application.CreateApplication(ctx, app, params)
+	err := createApp(ctx, d, m, app)
 	if err != nil {
-		return diag.Errorf("failed to create auto login application: %v", err)
+		return diag.Errorf("failed to create auto login application: %v", appSwaCredentialsError(d, err))
 	}
 	d.SetId(app.Id)
 	err = handleAppLogo(ctx, d, m, app.Id, app.Links)
 	if err != nil {
 		return diag.Errorf("failed to upload logo for auto login application: %v", err)
 	}
+	err = handleAppAuthenticationPolicy(ctx, d, m, app.Id)
+	if err != nil {
+		return diag.Errorf("failed to set authentication policy for auto login application: %v", err)
+	}
 	return resourceAppAutoLoginRead(ctx, d, m)
 }
 
 func resourceAppAutoLoginRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	app := okta.NewAutoLoginApplication()
-	err := fetchApp(ctx, d, m, app)
+	rawApp, err := fetchApp(ctx, d, m, app)
 	if err != nil {
 		return diag.Errorf("failed to get auto login application: %v", err)
 	}
@@ -107,29 +110,35 @@ func resourceAppAutoLoginRead(ctx context.Context, d *schema.ResourceData, m int
 	_ = d.Set("user_name_template_type", app.Credentials.UserNameTemplate.Type)
 	_ = d.Set("user_name_template_suffix", app.Credentials.UserNameTemplate.Suffix)
 	_ = d.Set("logo_url", linksValue(app.Links, "logo", "href"))
-	appRead(d, app.Name, app.Status, app.SignOnMode, app.Label, app.Accessibility, app.Visibility)
-	if d.HasChange("logo") {
-		err = handleAppLogo(ctx, d, m, app.Id, app.Links)
-		if err != nil {
-			o, _ := d.GetChange("logo")
-			_ = d.Set("logo", o)
-			return diag.Errorf("failed to upload logo for basic auth application: %v", err)
-		}
-	}
-	return nil
+	setAppAuthenticationPolicy(d, app.Links)
+	setAppNotes(d, rawApp)
+	diags := appRead(d, app.Name, app.Status, app.SignOnMode, app.Label, app.Accessibility, app.Visibility)
+	return diags
 }
 
 func resourceAppAutoLoginUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := getOktaClientFromMetadata(m)
 	app := buildAppAutoLogin(d)
-	err := updateAppByID(ctx, d.Id(), m, app)
+	err := updateApp(ctx, d, m, app)
 	if err != nil {
-		return diag.Errorf("failed to update auto login application: %v", err)
+		return diag.Errorf("failed to update auto login application: %v", appSwaCredentialsError(d, err))
 	}
 	err = setAppStatus(ctx, d, client, app.Status)
 	if err != nil {
 		return diag.Errorf("failed to set auto login application status: %v", err)
 	}
+	if d.HasChange("logo") {
+		err = handleAppLogo(ctx, d, m, app.Id, app.Links)
+		if err != nil {
+			o, _ := d.GetChange("logo")
+			_ = d.Set("logo", o)
+			return diag.Errorf("failed to upload logo for auto login application: %v", err)
+		}
+	}
+	err = handleAppAuthenticationPolicy(ctx, d, m, app.Id)
+	if err != nil {
+		return diag.Errorf("failed to set authentication policy for auto login application: %v", err)
+	}
 	return resourceAppAutoLoginRead(ctx, d, m)
 }
 
@@ -158,6 +167,7 @@ func buildAppAutoLogin(d *schema.ResourceData) *okta.AutoLoginApplication {
 		},
 	}
 	app.Visibility = buildVisibility(d)
+	app.Accessibility = buildAppAccessibility(d)
 	app.Credentials = buildSchemeCreds(d)
 
 	return app
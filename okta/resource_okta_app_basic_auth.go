@@ -6,15 +6,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/okta-sdk-golang/v2/okta"
-	"github.com/okta/okta-sdk-golang/v2/okta/query"
 )
 
 func resourceAppBasicAuth() *schema.Resource {
-	return &schema.Resource{
+	return withResumableSteps(&schema.Resource{
 		CreateContext: resourceAppBasicAuthCreate,
 		ReadContext:   resourceAppBasicAuthRead,
 		UpdateContext: resourceAppBasicAuthUpdate,
 		DeleteContext: resourceAppBasicAuthDelete,
+		Timeouts:      appTimeouts(),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -32,20 +32,17 @@ func resourceAppBasicAuth() *schema.Resource {
 				ValidateDiagFunc: stringIsURL(validURLSchemes...),
 			},
 		}),
-	}
+	}, appStepKeys...)
 }
 
 func resourceAppBasicAuthCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := getOktaClientFromMetadata(m)
 	app := buildAppBasicAuth(d)
-	activate := d.Get("status").(string) == statusActive
-	params := &query.Params{Activate: &activate}
-	_, _, err := client.Application.CreateApplication(ctx, app, params)
+	err := createApp(ctx, d, m, app)
 	if err != nil {
 		return diag.Errorf("failed to create basic auth application: %v", err)
 	}
 	d.SetId(app.Id)
-	err = handleAppGroupsAndUsers(ctx, app.Id, d, m)
+	err = handleAppAssignments(ctx, app.Id, d, m)
 	if err != nil {
 		return diag.Errorf("failed to handle groups and users for basic auth application: %v", err)
 	}
@@ -53,12 +50,16 @@ func resourceAppBasicAuthCreate(ctx context.Context, d *schema.ResourceData, m i
 	if err != nil {
 		return diag.Errorf("failed to upload logo for basic auth application: %v", err)
 	}
+	err = handleAppAuthenticationPolicy(ctx, d, m, app.Id)
+	if err != nil {
+		return diag.Errorf("failed to set authentication policy for basic auth application: %v", err)
+	}
 	return resourceAppBasicAuthRead(ctx, d, m)
 }
 
 func resourceAppBasicAuthRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	app := okta.NewBasicAuthApplication()
-	err := fetchApp(ctx, d, m, app)
+	rawApp, err := fetchApp(ctx, d, m, app)
 	if err != nil {
 		return diag.Errorf("failed to get basic auth application: %v", err)
 	}
@@ -68,25 +69,21 @@ func resourceAppBasicAuthRead(ctx context.Context, d *schema.ResourceData, m int
 	}
 	_ = d.Set("url", app.Settings.App.Url)
 	_ = d.Set("auth_url", app.Settings.App.AuthURL)
-	_ = d.Set("name", app.Name)
-	_ = d.Set("status", app.Status)
-	_ = d.Set("sign_on_mode", app.SignOnMode)
-	_ = d.Set("label", app.Label)
-	_ = d.Set("auto_submit_toolbar", app.Visibility.AutoSubmitToolbar)
-	_ = d.Set("hide_ios", app.Visibility.Hide.IOS)
-	_ = d.Set("hide_web", app.Visibility.Hide.Web)
+	diags := appRead(d, app.Name, app.Status, app.SignOnMode, app.Label, app.Accessibility, app.Visibility)
 	_ = d.Set("logo_url", linksValue(app.Links, "logo", "href"))
+	setAppAuthenticationPolicy(d, app.Links)
+	setAppNotes(d, rawApp)
 	err = syncGroupsAndUsers(ctx, app.Id, d, m)
 	if err != nil {
 		return diag.Errorf("failed to sync groups and users for basic auth application: %v", err)
 	}
-	return nil
+	return diags
 }
 
 func resourceAppBasicAuthUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := getOktaClientFromMetadata(m)
 	app := buildAppBasicAuth(d)
-	_, _, err := client.Application.UpdateApplication(ctx, d.Id(), app)
+	err := updateApp(ctx, d, m, app)
 	if err != nil {
 		return diag.Errorf("failed to update basic auth application: %v", err)
 	}
@@ -94,7 +91,7 @@ func resourceAppBasicAuthUpdate(ctx context.Context, d *schema.ResourceData, m i
 	if err != nil {
 		return diag.Errorf("failed to set basic auth application status: %v", err)
 	}
-	err = handleAppGroupsAndUsers(ctx, app.Id, d, m)
+	err = handleAppAssignments(ctx, app.Id, d, m)
 	if err != nil {
 		return diag.Errorf("failed to handle groups and users for basic auth application: %v", err)
 	}
@@ -106,6 +103,10 @@ func resourceAppBasicAuthUpdate(ctx context.Context, d *schema.ResourceData, m i
 			return diag.Errorf("failed to upload logo for basic auth application: %v", err)
 		}
 	}
+	err = handleAppAuthenticationPolicy(ctx, d, m, app.Id)
+	if err != nil {
+		return diag.Errorf("failed to set authentication policy for basic auth application: %v", err)
+	}
 	return resourceAppBasicAuthRead(ctx, d, m)
 }
 
@@ -129,6 +130,7 @@ func buildAppBasicAuth(d *schema.ResourceData) *okta.BasicAuthApplication {
 		},
 	}
 	app.Visibility = buildVisibility(d)
+	app.Accessibility = buildAppAccessibility(d)
 
 	return app
 }
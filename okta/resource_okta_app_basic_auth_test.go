@@ -43,6 +43,8 @@ func TestAccAppBasicAuthApplication_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "auth_url", "https://example.com/auth.html"),
 					resource.TestCheckResourceAttr(resourceName, "users.#", "1"),
 					resource.TestCheckResourceAttrSet(resourceName, "logo_url"),
+					resource.TestCheckResourceAttr(resourceName, "admin_note", "managed by terraform"),
+					resource.TestCheckResourceAttr(resourceName, "enduser_note", "contact support for access"),
 				),
 			},
 		},
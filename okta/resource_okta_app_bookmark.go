@@ -6,15 +6,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/okta-sdk-golang/v2/okta"
-	"github.com/okta/okta-sdk-golang/v2/okta/query"
 )
 
 func resourceAppBookmark() *schema.Resource {
-	return &schema.Resource{
+	return withResumableSteps(&schema.Resource{
 		CreateContext: resourceAppBookmarkCreate,
 		ReadContext:   resourceAppBookmarkRead,
 		UpdateContext: resourceAppBookmarkUpdate,
 		DeleteContext: resourceAppBookmarkDelete,
+		Timeouts:      appTimeouts(),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -32,20 +32,17 @@ func resourceAppBookmark() *schema.Resource {
 				Optional: true,
 			},
 		}),
-	}
+	}, appStepKeys...)
 }
 
 func resourceAppBookmarkCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := getOktaClientFromMetadata(m)
 	app := buildAppBookmark(d)
-	activate := d.Get("status").(string) == statusActive
-	params := &query.Params{Activate: &activate}
-	_, _, err := client.Application.CreateApplication(ctx, app, params)
+	err := createApp(ctx, d, m, app)
 	if err != nil {
 		return diag.Errorf("failed to create bookmark application: %v", err)
 	}
 	d.SetId(app.Id)
-	err = handleAppGroupsAndUsers(ctx, app.Id, d, m)
+	err = handleAppAssignments(ctx, app.Id, d, m)
 	if err != nil {
 		return diag.Errorf("failed to handle groups and users for bookmark application: %v", err)
 	}
@@ -53,12 +50,16 @@ func resourceAppBookmarkCreate(ctx context.Context, d *schema.ResourceData, m in
 	if err != nil {
 		return diag.Errorf("failed to upload logo for bookmark application: %v", err)
 	}
+	err = handleAppAuthenticationPolicy(ctx, d, m, app.Id)
+	if err != nil {
+		return diag.Errorf("failed to set authentication policy for bookmark application: %v", err)
+	}
 	return resourceAppBookmarkRead(ctx, d, m)
 }
 
 func resourceAppBookmarkRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	app := okta.NewBookmarkApplication()
-	err := fetchApp(ctx, d, m, app)
+	rawApp, err := fetchApp(ctx, d, m, app)
 	if err != nil {
 		return diag.Errorf("failed to get bookmark application: %v", err)
 	}
@@ -67,27 +68,22 @@ func resourceAppBookmarkRead(ctx context.Context, d *schema.ResourceData, m inte
 		return nil
 	}
 	_ = d.Set("url", app.Settings.App.Url)
-	_ = d.Set("label", app.Label)
 	_ = d.Set("request_integration", app.Settings.App.RequestIntegration)
-	_ = d.Set("name", app.Name)
-	_ = d.Set("status", app.Status)
-	_ = d.Set("sign_on_mode", app.SignOnMode)
-	_ = d.Set("label", app.Label)
-	_ = d.Set("auto_submit_toolbar", app.Visibility.AutoSubmitToolbar)
-	_ = d.Set("hide_ios", app.Visibility.Hide.IOS)
-	_ = d.Set("hide_web", app.Visibility.Hide.Web)
+	diags := appRead(d, app.Name, app.Status, app.SignOnMode, app.Label, app.Accessibility, app.Visibility)
 	_ = d.Set("logo_url", linksValue(app.Links, "logo", "href"))
+	setAppAuthenticationPolicy(d, app.Links)
+	setAppNotes(d, rawApp)
 	err = syncGroupsAndUsers(ctx, app.Id, d, m)
 	if err != nil {
 		return diag.Errorf("failed to sync groups and users for bookmark application: %v", err)
 	}
-	return nil
+	return diags
 }
 
 func resourceAppBookmarkUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := getOktaClientFromMetadata(m)
 	app := buildAppBookmark(d)
-	_, _, err := client.Application.UpdateApplication(ctx, d.Id(), app)
+	err := updateApp(ctx, d, m, app)
 	if err != nil {
 		return diag.Errorf("failed to update bookmark application: %v", err)
 	}
@@ -95,7 +91,7 @@ func resourceAppBookmarkUpdate(ctx context.Context, d *schema.ResourceData, m in
 	if err != nil {
 		return diag.Errorf("failed to set bookmark application status: %v", err)
 	}
-	err = handleAppGroupsAndUsers(ctx, app.Id, d, m)
+	err = handleAppAssignments(ctx, app.Id, d, m)
 	if err != nil {
 		return diag.Errorf("failed to handle groups and users for bookmark application: %v", err)
 	}
@@ -107,6 +103,10 @@ func resourceAppBookmarkUpdate(ctx context.Context, d *schema.ResourceData, m in
 			return diag.Errorf("failed to upload logo for bookmark application: %v", err)
 		}
 	}
+	err = handleAppAuthenticationPolicy(ctx, d, m, app.Id)
+	if err != nil {
+		return diag.Errorf("failed to set authentication policy for bookmark application: %v", err)
+	}
 	return resourceAppBookmarkRead(ctx, d, m)
 }
 
@@ -129,5 +129,6 @@ func buildAppBookmark(d *schema.ResourceData) *okta.BookmarkApplication {
 		},
 	}
 	app.Visibility = buildVisibility(d)
+	app.Accessibility = buildAppAccessibility(d)
 	return app
 }
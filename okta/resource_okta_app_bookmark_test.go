@@ -40,6 +40,8 @@ func TestAccAppBookmarkApplication_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
 					resource.TestCheckResourceAttr(resourceName, "url", "https://test.com"),
 					resource.TestCheckResourceAttr(resourceName, "users.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "accessibility_self_service", "true"),
+					resource.TestCheckResourceAttr(resourceName, "accessibility_error_redirect_url", "https://test.com/error"),
 					resource.TestCheckResourceAttrSet(resourceName, "logo_url"),
 				),
 			},
@@ -36,6 +36,7 @@ func resourceAppGroupAssignment() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
+		CustomizeDiff: validateAppProfileDiff,
 		Schema: map[string]*schema.Schema{
 			"app_id": {
 				Type:        schema.TypeString,
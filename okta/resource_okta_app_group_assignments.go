@@ -5,6 +5,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -18,6 +20,19 @@ func resourceAppGroupAssignments() *schema.Resource {
 		DeleteContext: resourceAppGroupAssignmentsDelete,
 		UpdateContext: resourceAppGroupAssignmentsUpdate,
 		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			if (!d.HasChange("app_id") && !d.HasChange("group")) || !d.NewValueKnown("app_id") {
+				return nil
+			}
+			for _, group := range d.Get("group").(*schema.Set).List() {
+				g := group.(map[string]interface{})
+				err := validateAppProfile(ctx, m, d.Get("app_id").(string), g["profile"].(string))
+				if err != nil {
+					return fmt.Errorf("invalid profile of the group '%s': %v", g["id"], err)
+				}
+			}
+			return nil
+		},
 
 		Schema: map[string]*schema.Schema{
 			"app_id": {
@@ -25,7 +40,12 @@ func resourceAppGroupAssignments() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
-
+			"enforce": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "When disabled, extra and missing group assignments are reported as warnings instead of being reconciled",
+			},
 			"group": {
 				Type:        schema.TypeSet,
 				Required:    true,
@@ -73,6 +93,10 @@ func resourceAppGroupAssignments() *schema.Resource {
 }
 
 func resourceAppGroupAssignmentsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if !d.Get("enforce").(bool) {
+		d.SetId(d.Get("app_id").(string))
+		return resourceAppGroupAssignmentsRead(ctx, d, m)
+	}
 	groups := d.Get("group").(*schema.Set).List()
 	client := getOktaClientFromMetadata(m)
 
@@ -107,6 +131,9 @@ func resourceAppGroupAssignmentsRead(ctx context.Context, d *schema.ResourceData
 	if err != nil {
 		return diag.Errorf("failed to fetch group assignments: %v", err)
 	}
+	if !d.Get("enforce").(bool) {
+		return readDriftOnlyGroupAssignments(d, assignments)
+	}
 
 	tfFlattenedAssignments := make([]interface{}, len(assignments))
 	for i, assignment := range assignments {
@@ -125,6 +152,9 @@ func resourceAppGroupAssignmentsRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceAppGroupAssignmentsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if !d.Get("enforce").(bool) {
+		return nil
+	}
 	client := getOktaClientFromMetadata(m)
 
 	for _, rawGroup := range d.Get("group").(*schema.Set).List() {
@@ -143,12 +173,31 @@ func resourceAppGroupAssignmentsDelete(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceAppGroupAssignmentsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if !d.Get("enforce").(bool) {
+		return resourceAppGroupAssignmentsRead(ctx, d, m)
+	}
 	client := getOktaClientFromMetadata(m)
 	appID := d.Get("app_id").(string)
 
 	old, new := d.GetChange("group")
 	oldSet := old.(*schema.Set)
 	newSet := new.(*schema.Set)
+	if d.HasChange("enforce") {
+		// state only tracked the configured groups while enforcement was disabled,
+		// so reconcile against what is actually assigned in Okta
+		assignments, err := listApplicationGroupAssignments(ctx, client, appID)
+		if err != nil {
+			return diag.Errorf("failed to fetch group assignments: %v", err)
+		}
+		oldSet = schema.NewSet(newSet.F, nil)
+		for _, assignment := range assignments {
+			tfAssignment, err := groupAssignmentToTFGroup(assignment)
+			if err != nil {
+				return diag.Errorf("failed to marshal group profile: %v", err)
+			}
+			oldSet.Add(tfAssignment)
+		}
+	}
 
 	toAdd := tfGroupsToGroupAssignments(
 		newSet.Difference(oldSet).List()...,
@@ -179,6 +228,60 @@ func resourceAppGroupAssignmentsUpdate(ctx context.Context, d *schema.ResourceDa
 	return resourceAppGroupAssignmentsRead(ctx, d, m)
 }
 
+// readDriftOnlyGroupAssignments keeps the configured groups in the state and reports any difference
+// between them and the actual assignments as warnings, without changing anything in Okta.
+func readDriftOnlyGroupAssignments(d *schema.ResourceData, assignments []*okta.ApplicationGroupAssignment) diag.Diagnostics {
+	var diags diag.Diagnostics
+	configured := tfGroupsToGroupAssignments(d.Get("group").(*schema.Set).List()...)
+	var (
+		extra                  []string
+		tfFlattenedAssignments []interface{}
+	)
+	for _, assignment := range assignments {
+		if _, ok := configured[assignment.Id]; !ok {
+			extra = append(extra, assignment.Id)
+			continue
+		}
+		delete(configured, assignment.Id)
+		tfAssignment, err := groupAssignmentToTFGroup(assignment)
+		if err != nil {
+			return diag.Errorf("failed to marshal group profile: %v", err)
+		}
+		tfFlattenedAssignments = append(tfFlattenedAssignments, tfAssignment)
+	}
+	missing := make([]string, 0, len(configured))
+	for groupID, assignment := range configured {
+		missing = append(missing, groupID)
+		profile, _ := json.Marshal(assignment.Profile)
+		tfFlattenedAssignments = append(tfFlattenedAssignments, map[string]interface{}{
+			"id":       groupID,
+			"priority": int(assignment.Priority),
+			"profile":  string(profile),
+		})
+	}
+	if len(extra) > 0 {
+		sort.Strings(extra)
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Application has group assignments that are not configured",
+			Detail:   fmt.Sprintf("Application '%s' is assigned to the following groups that are not managed by Terraform: %s", d.Get("app_id").(string), strings.Join(extra, ", ")),
+		})
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Application is missing configured group assignments",
+			Detail:   fmt.Sprintf("Application '%s' is not assigned to the following configured groups: %s", d.Get("app_id").(string), strings.Join(missing, ", ")),
+		})
+	}
+	err := d.Set("group", tfFlattenedAssignments)
+	if err != nil {
+		return append(diags, diag.Errorf("failed to set groups in tf state: %v", err)...)
+	}
+	return diags
+}
+
 // groupAssignmentToTFGroup
 func groupAssignmentToTFGroup(assignment *okta.ApplicationGroupAssignment) (map[string]interface{}, error) {
 	profile := "{}"
@@ -51,6 +51,29 @@ func TestAccAppGroupAssignments_crud(t *testing.T) {
 	})
 }
 
+func TestAccAppGroupAssignments_enforceDisabled(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := fmt.Sprintf("%s.test", appGroupAssignments)
+	mgr := newFixtureManager(appGroupAssignments)
+	config := mgr.GetFixtures("enforce_disabled.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "app_id"),
+					resource.TestCheckResourceAttr(resourceName, "enforce", "false"),
+					resource.TestCheckResourceAttr(resourceName, "group.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 func ensureAppGroupAssignmentsExist(name string, groupsExpected ...string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		missingErr := fmt.Errorf("resource not found: %s", name)
@@ -0,0 +1,58 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+func resourceAppKeyRotation() *schema.Resource {
+	return buildActionResource(
+		"Rotates the signing key of an application.",
+		map[string]*schema.Schema{
+			"app_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the application.",
+			},
+			"key_years_valid": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          2,
+				ValidateDiagFunc: intBetween(2, 10),
+				Description:      "Number of years the new key is valid.",
+			},
+			"kid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the new signing key.",
+			},
+			"expires_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiration date of the new signing key.",
+			},
+		},
+		func(ctx context.Context, d *schema.ResourceData, m interface{}) (string, error) {
+			appID := d.Get("app_id").(string)
+			logger(m).Info("rotating app signing key", "app_id", appID)
+			qp := query.NewQueryParams(query.WithValidityYears(int64(d.Get("key_years_valid").(int))))
+			key, _, err := getOktaClientFromMetadata(m).Application.GenerateApplicationKey(ctx, appID, qp)
+			if err != nil {
+				return "", fmt.Errorf("failed to generate signing key of application (%s): %v", appID, err)
+			}
+			_, err = getSupplementFromMetadata(m).UpdateAppSigningKey(ctx, appID, key.Kid)
+			if err != nil {
+				return "", fmt.Errorf("failed to set signing key (%s) of application (%s): %v", key.Kid, appID, err)
+			}
+			_ = d.Set("kid", key.Kid)
+			if key.ExpiresAt != nil {
+				_ = d.Set("expires_at", key.ExpiresAt.Format(time.RFC3339))
+			}
+			return key.Kid, nil
+		},
+	)
+}
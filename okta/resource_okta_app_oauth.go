@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/okta-sdk-golang/v2/okta"
-	"github.com/okta/okta-sdk-golang/v2/okta/query"
 	"github.com/okta/terraform-provider-okta/sdk"
 )
 
@@ -77,11 +76,12 @@ var appGrantTypeMap = map[string]*applicationMap{
 }
 
 func resourceAppOAuth() *schema.Resource {
-	return &schema.Resource{
+	return withResumableSteps(&schema.Resource{
 		CreateContext: resourceAppOAuthCreate,
 		ReadContext:   resourceAppOAuthRead,
 		UpdateContext: resourceAppOAuthUpdate,
 		DeleteContext: resourceAppOAuthDelete,
+		Timeouts:      appTimeouts(),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -145,10 +145,27 @@ func resourceAppOAuth() *schema.Resource {
 			"token_endpoint_auth_method": {
 				Type:             schema.TypeString,
 				Optional:         true,
-				ValidateDiagFunc: elemInSlice([]string{"none", "client_secret_post", "client_secret_basic", "client_secret_jwt", "private_key_jwt"}),
+				ValidateDiagFunc: elemInSlice([]string{"none", "client_secret_post", "client_secret_basic", "client_secret_jwt", "private_key_jwt", "tls_client_auth", "self_signed_tls_client_auth"}),
 				Default:          "client_secret_basic",
 				Description:      "Requested authentication method for the token endpoint.",
 			},
+			"dpop_bound_access_tokens": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Require DPoP (Demonstrating Proof-of-Possession) bound access tokens. The feature has to be enabled for the org.",
+			},
+			"tls_client_auth_subject_dn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Subject DN of the client certificate, required when token_endpoint_auth_method is tls_client_auth.",
+			},
+			"tls_client_certificate_bound_access_tokens": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Require access tokens bound to the client certificate, the client has to authenticate with mutual TLS.",
+			},
 			"auto_key_rotation": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -356,11 +373,10 @@ func resourceAppOAuth() *schema.Resource {
 				},
 			},
 		}),
-	}
+	}, append(appStepKeys, "groups_claim")...)
 }
 
 func resourceAppOAuthCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := getOktaClientFromMetadata(m)
 	if err := validateGrantTypes(d); err != nil {
 		return diag.Errorf("failed to create OAuth application: %v", err)
 	}
@@ -368,9 +384,7 @@ func resourceAppOAuthCreate(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.Errorf("failed to create OAuth application: %v", err)
 	}
 	app := buildAppOAuth(d)
-	activate := d.Get("status").(string) == statusActive
-	params := &query.Params{Activate: &activate}
-	_, _, err := client.Application.CreateApplication(ctx, app, params)
+	err := createApp(ctx, d, m, app, buildAppOAuthClientExtra(d))
 	if err != nil {
 		return diag.Errorf("failed to create OAuth application: %v", err)
 	}
@@ -381,7 +395,7 @@ func resourceAppOAuthCreate(ctx context.Context, d *schema.ResourceData, m inter
 	// When the implicit_assignment is turned on, calls to the user/group assignments will error with a bad request
 	// So Skip setting assignments while this is on
 	if !d.Get("implicit_assignment").(bool) {
-		err = handleAppGroupsAndUsers(ctx, app.Id, d, m)
+		err = handleAppAssignments(ctx, app.Id, d, m)
 		if err != nil {
 			return diag.Errorf("failed to handle groups and users for OAuth application: %v", err)
 		}
@@ -390,6 +404,10 @@ func resourceAppOAuthCreate(ctx context.Context, d *schema.ResourceData, m inter
 	if err != nil {
 		return diag.Errorf("failed to upload logo for OAuth application: %v", err)
 	}
+	err = handleAppAuthenticationPolicy(ctx, d, m, app.Id)
+	if err != nil {
+		return diag.Errorf("failed to set authentication policy for OAuth application: %v", err)
+	}
 	err = setAppOauthGroupsClaim(ctx, d, m)
 	if err != nil {
 		return diag.Errorf("failed to update groups claim for an OAuth application: %v", err)
@@ -398,25 +416,27 @@ func resourceAppOAuthCreate(ctx context.Context, d *schema.ResourceData, m inter
 }
 
 func setAppOauthGroupsClaim(ctx context.Context, d *schema.ResourceData, m interface{}) error {
-	raw, ok := d.GetOk("groups_claim")
-	if !ok {
-		return nil
-	}
-	groupsClaim := raw.(*schema.Set).List()[0].(map[string]interface{})
-	gc := &sdk.AppOauthGroupClaim{
-		IssuerMode: "ORG_URL",
-		Name:       groupsClaim["name"].(string),
-		Value:      groupsClaim["value"].(string),
-	}
-	gct := groupsClaim["type"].(string)
-	if gct == "FILTER" {
-		gc.ValueType = "GROUPS"
-		gc.GroupFilterType = groupsClaim["filter_type"].(string)
-	} else {
-		gc.ValueType = gct
-	}
-	_, err := getSupplementFromMetadata(m).UpdateAppOauthGroupsClaim(ctx, d.Id(), gc)
-	return err
+	return stepsFrom(ctx).run([]string{"groups_claim"}, func() error {
+		raw, ok := d.GetOk("groups_claim")
+		if !ok {
+			return nil
+		}
+		groupsClaim := raw.(*schema.Set).List()[0].(map[string]interface{})
+		gc := &sdk.AppOauthGroupClaim{
+			IssuerMode: "ORG_URL",
+			Name:       groupsClaim["name"].(string),
+			Value:      groupsClaim["value"].(string),
+		}
+		gct := groupsClaim["type"].(string)
+		if gct == "FILTER" {
+			gc.ValueType = "GROUPS"
+			gc.GroupFilterType = groupsClaim["filter_type"].(string)
+		} else {
+			gc.ValueType = gct
+		}
+		_, err := getSupplementFromMetadata(m).UpdateAppOauthGroupsClaim(ctx, d.Id(), gc)
+		return err
+	})
 }
 
 func updateAppOauthGroupsClaim(ctx context.Context, d *schema.ResourceData, m interface{}) error {
@@ -436,7 +456,7 @@ func updateAppOauthGroupsClaim(ctx context.Context, d *schema.ResourceData, m in
 
 func resourceAppOAuthRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	app := okta.NewOpenIdConnectApplication()
-	err := fetchApp(ctx, d, m, app)
+	rawApp, err := fetchApp(ctx, d, m, app)
 	if err != nil {
 		return diag.Errorf("failed to get OAuth application: %v", err)
 	}
@@ -449,10 +469,7 @@ func resourceAppOAuthRead(ctx context.Context, d *schema.ResourceData, m interfa
 		p, _ := json.Marshal(app.Profile)
 		rawProfile = string(p)
 	}
-	_ = d.Set("name", app.Name)
-	_ = d.Set("status", app.Status)
-	_ = d.Set("sign_on_mode", app.SignOnMode)
-	_ = d.Set("label", app.Label)
+	diags := appRead(d, app.Name, app.Status, app.SignOnMode, app.Label, app.Accessibility, app.Visibility)
 	_ = d.Set("profile", rawProfile)
 	_ = d.Set("type", app.Settings.OauthClient.ApplicationType)
 	// Not setting client_secret, it is only provided on create and update for auth methods that require it
@@ -465,10 +482,13 @@ func resourceAppOAuthRead(ctx context.Context, d *schema.ResourceData, m interfa
 	_ = d.Set("policy_uri", app.Settings.OauthClient.PolicyUri)
 	_ = d.Set("login_uri", app.Settings.OauthClient.InitiateLoginUri)
 	_ = d.Set("wildcard_redirect", app.Settings.OauthClient.WildcardRedirect)
-	_ = d.Set("auto_submit_toolbar", app.Visibility.AutoSubmitToolbar)
-	_ = d.Set("hide_ios", app.Visibility.Hide.IOS)
-	_ = d.Set("hide_web", app.Visibility.Hide.Web)
 	_ = d.Set("logo_url", linksValue(app.Links, "logo", "href"))
+	setAppAuthenticationPolicy(d, app.Links)
+	setAppNotes(d, rawApp)
+	extra := rawApp.OAuthClientExtra()
+	_ = d.Set("dpop_bound_access_tokens", extra.DPoPBoundAccessTokens)
+	_ = d.Set("tls_client_auth_subject_dn", extra.TLSClientAuthSubjectDN)
+	_ = d.Set("tls_client_certificate_bound_access_tokens", extra.TLSClientCertificateBoundAccessTokens)
 	if app.Settings.ImplicitAssignment != nil {
 		_ = d.Set("implicit_assignment", *app.Settings.ImplicitAssignment)
 	}
@@ -555,7 +575,7 @@ func resourceAppOAuthRead(ctx context.Context, d *schema.ResourceData, m interfa
 			return diag.Errorf("failed to set OAuth application properties: %v", err)
 		}
 	}
-	return nil
+	return diags
 }
 
 func resourceAppOAuthUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -567,7 +587,7 @@ func resourceAppOAuthUpdate(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.Errorf("failed to create OAuth application: %v", err)
 	}
 	app := buildAppOAuth(d)
-	_, _, err := client.Application.UpdateApplication(ctx, d.Id(), app)
+	err := updateApp(ctx, d, m, app, buildAppOAuthClientExtra(d))
 	if err != nil {
 		return diag.Errorf("failed to update OAuth application: %v", err)
 	}
@@ -581,7 +601,7 @@ func resourceAppOAuthUpdate(ctx context.Context, d *schema.ResourceData, m inter
 	// When the implicit_assignment is turned on, calls to the user/group assignments will error with a bad request
 	// So Skip setting assignments while this is on
 	if !d.Get("implicit_assignment").(bool) {
-		err = handleAppGroupsAndUsers(ctx, app.Id, d, m)
+		err = handleAppAssignments(ctx, app.Id, d, m)
 		if err != nil {
 			return diag.Errorf("failed to handle groups and users for OAuth application: %v", err)
 		}
@@ -594,6 +614,10 @@ func resourceAppOAuthUpdate(ctx context.Context, d *schema.ResourceData, m inter
 			return diag.Errorf("failed to upload logo for OAuth application: %v", err)
 		}
 	}
+	err = handleAppAuthenticationPolicy(ctx, d, m, app.Id)
+	if err != nil {
+		return diag.Errorf("failed to set authentication policy for OAuth application: %v", err)
+	}
 	err = updateAppOauthGroupsClaim(ctx, d, m)
 	if err != nil {
 		return diag.Errorf("failed to update groups claim for an OAuth application: %v", err)
@@ -720,6 +744,7 @@ func buildAppOAuth(d *schema.ResourceData) *okta.OpenIdConnectApplication {
 	}
 
 	app.Visibility = buildVisibility(d)
+	app.Accessibility = buildAppAccessibility(d)
 
 	if rawAttrs, ok := d.GetOk("profile"); ok {
 		var attrs map[string]interface{}
@@ -759,8 +784,15 @@ func validateAppOAuth(d *schema.ResourceData) error {
 			return errors.New("'name' 'value' and in 'groups_claim' should not be empty")
 		}
 	}
-	if _, ok := d.GetOk("jwks"); !ok && d.Get("token_endpoint_auth_method").(string) == "private_key_jwt" {
-		return errors.New("'jwks' is required when 'token_endpoint_auth_method' is 'private_key_jwt'")
+	authMethod := d.Get("token_endpoint_auth_method").(string)
+	if _, ok := d.GetOk("jwks"); !ok && (authMethod == "private_key_jwt" || authMethod == "self_signed_tls_client_auth") {
+		return fmt.Errorf("'jwks' is required when 'token_endpoint_auth_method' is '%s'", authMethod)
+	}
+	if d.Get("tls_client_auth_subject_dn").(string) == "" && authMethod == "tls_client_auth" {
+		return errors.New("'tls_client_auth_subject_dn' is required when 'token_endpoint_auth_method' is 'tls_client_auth'")
+	}
+	if d.Get("tls_client_certificate_bound_access_tokens").(bool) && authMethod != "tls_client_auth" && authMethod != "self_signed_tls_client_auth" {
+		return errors.New("'tls_client_certificate_bound_access_tokens' requires 'token_endpoint_auth_method' to be 'tls_client_auth' or 'self_signed_tls_client_auth'")
 	}
 	if d.Get("login_mode").(string) != "DISABLED" {
 		if d.Get("login_uri").(string) == "" {
@@ -793,3 +825,12 @@ func validateAppOAuth(d *schema.ResourceData) error {
 	}
 	return nil
 }
+
+// buildAppOAuthClientExtra sets the DPoP and mutual TLS settings, which the app model lacks
+func buildAppOAuthClientExtra(d *schema.ResourceData) sdk.AppFields {
+	return sdk.WithAppOAuthClientExtra(&sdk.AppOAuthClientExtra{
+		DPoPBoundAccessTokens:                 d.Get("dpop_bound_access_tokens").(bool),
+		TLSClientAuthSubjectDN:                d.Get("tls_client_auth_subject_dn").(string),
+		TLSClientCertificateBoundAccessTokens: d.Get("tls_client_certificate_bound_access_tokens").(bool),
+	})
+}
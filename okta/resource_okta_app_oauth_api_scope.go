@@ -4,33 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/okta-sdk-golang/v2/okta"
 )
 
-var validScopes = []string{
-	"okta.apps.manage", "okta.apps.read",
-	"okta.authorizationServers.manage", "okta.authorizationServers.read",
-	"okta.clients.manage", "okta.clients.read", "okta.clients.register",
-	"okta.eventHooks.manage", "okta.eventHooks.read",
-	"okta.events.read",
-	"okta.factors.manage", "okta.factors.read",
-	"okta.groups.manage", "okta.groups.read",
-	"okta.idps.manage", "okta.idps.read",
-	"okta.inlineHooks.manage", "okta.inlineHooks.read",
-	"okta.linkedObjects.manage", "okta.linkedObjects.read",
-	"okta.logs.read",
-	"okta.roles.manage", "okta.roles.read",
-	"okta.schemas.manage", "okta.schemas.read",
-	"okta.sessions.manage", "okta.sessions.read",
-	"okta.templates.manage", "okta.templates.read",
-	"okta.trustedOrigins.manage", "okta.trustedOrigins.read",
-	"okta.users.manage", "okta.users.read", "okta.users.manage.self", "okta.users.read.self",
-	"okta.policies.manage", "okta.policies.read",
-}
-
 func resourceAppOAuthAPIScope() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceAppOAuthAPIScopeCreate,
@@ -58,6 +39,7 @@ func resourceAppOAuthAPIScope() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: validateOAuthApiScopes,
 		Schema: map[string]*schema.Schema{
 			"app_id": {
 				Required:    true,
@@ -66,16 +48,17 @@ func resourceAppOAuthAPIScope() *schema.Resource {
 				ForceNew:    true,
 			},
 			"issuer": {
-				Required:    true,
-				Type:        schema.TypeString,
-				Description: "The issuer of your Org Authorization Server, your Org URL.",
+				Optional:         true,
+				Computed:         true,
+				Type:             schema.TypeString,
+				Description:      "The issuer of your Org Authorization Server, your Org URL. Defaults to the org URL of the provider.",
+				DiffSuppressFunc: suppressIssuerDiff,
 			},
 			"scopes": {
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Required: true,
 				Elem: &schema.Schema{
-					Type:             schema.TypeString,
-					ValidateDiagFunc: elemInSlice(validScopes),
+					Type: schema.TypeString,
 				},
 				Description: "Scopes of the application for which consent is granted.",
 			},
@@ -84,12 +67,10 @@ func resourceAppOAuthAPIScope() *schema.Resource {
 }
 
 func resourceAppOAuthAPIScopeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	scopes := make([]string, 0)
-
-	for _, scope := range d.Get("scopes").([]interface{}) {
-		scopes = append(scopes, scope.(string))
+	if d.Get("issuer").(string) == "" {
+		_ = d.Set("issuer", m.(*Config).orgURL())
 	}
-	grantScopeList := getOAuthApiScopeList(scopes, d.Get("issuer").(string))
+	grantScopeList := getOAuthApiScopeList(convertInterfaceToStringSet(d.Get("scopes")), d.Get("issuer").(string))
 	err := grantOAuthApiScopes(ctx, d, m, grantScopeList)
 	if err != nil {
 		return diag.Errorf("failed to create application scope consent grant: %v", err)
@@ -154,8 +135,8 @@ func resourceAppOAuthAPIScopeDelete(ctx context.Context, d *schema.ResourceData,
 	}
 
 	revokeListIds := make([]string, 0)
-	for _, scope := range d.Get("scopes").([]interface{}) {
-		revokeListIds = append(revokeListIds, scopeMap[scope.(string)])
+	for _, scope := range convertInterfaceToStringSet(d.Get("scopes")) {
+		revokeListIds = append(revokeListIds, scopeMap[scope])
 	}
 	err = revokeOAuthApiScope(ctx, d, m, revokeListIds)
 	if err != nil {
@@ -166,10 +147,43 @@ func resourceAppOAuthAPIScopeDelete(ctx context.Context, d *schema.ResourceData,
 }
 
 // Resource Helpers
+// Checks that the scopes are available in the org authorization server, so that the invalid ones are reported
+// during the plan instead of failing while granting the consent
+func validateOAuthApiScopes(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.HasChange("scopes") || !d.NewValueKnown("scopes") {
+		return nil
+	}
+	supported, _, err := getSupplementFromMetadata(m).GetOrgAuthorizationServerScopes(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get scopes of the org authorization server: %v", err)
+	}
+	var invalid []string
+	for _, scope := range convertInterfaceToStringSet(d.Get("scopes")) {
+		if !contains(supported, scope) {
+			invalid = append(invalid, scope)
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("scopes %s are not available in the org authorization server, the valid scopes are: %s",
+			strings.Join(invalid, ", "), strings.Join(supported, ", "))
+	}
+	return nil
+}
+
+// suppressIssuerDiff ignores the trailing slash and casing differences of the issuer, since those would
+// otherwise cause a revoke and re-grant of all the scopes
+func suppressIssuerDiff(_, old, new string, _ *schema.ResourceData) bool {
+	return new == "" || strings.EqualFold(normalizeIssuer(old), normalizeIssuer(new))
+}
+
+func normalizeIssuer(issuer string) string {
+	return strings.TrimSuffix(strings.TrimSpace(issuer), "/")
+}
+
 // Creates a new OAuth2ScopeConsentGrant struct
 func newOAuthApiScope(scopeId, issuer string) *okta.OAuth2ScopeConsentGrant {
 	return &okta.OAuth2ScopeConsentGrant{
-		Issuer:  issuer,
+		Issuer:  normalizeIssuer(issuer),
 		ScopeId: scopeId,
 	}
 }
@@ -209,25 +223,52 @@ func setOAuthApiScopes(d *schema.ResourceData, to []*okta.OAuth2ScopeConsentGran
 }
 
 // Grant a list of scopes to an OAuth application. For convenience this function takes a list of OAuth2ScopeConsentGrant structs.
+// Grants are made concurrently, limited by the provider's parallelism.
 func grantOAuthApiScopes(ctx context.Context, d *schema.ResourceData, m interface{}, scopeGrants []*okta.OAuth2ScopeConsentGrant) error {
-	for _, scopeGrant := range scopeGrants {
-		_, _, err := getOktaClientFromMetadata(m).Application.GrantConsentToScope(ctx, d.Get("app_id").(string), *scopeGrant)
-		if err != nil {
-			return fmt.Errorf("failed to grant application api scope: %v", err)
+	if len(scopeGrants) == 0 {
+		return nil
+	}
+	appID := d.Get("app_id").(string)
+	client := getOktaClientFromMetadata(m)
+	handlers := make([]func() error, len(scopeGrants))
+	for i := range scopeGrants {
+		scopeGrant := scopeGrants[i]
+		handlers[i] = func() error {
+			_, _, err := client.Application.GrantConsentToScope(ctx, appID, *scopeGrant)
+			if err != nil {
+				return fmt.Errorf("scope '%s': %v", scopeGrant.ScopeId, err)
+			}
+			return nil
 		}
 	}
-	return nil
+	var wg sync.WaitGroup
+	resultChan := make(chan []*result, 1)
+	promiseAll(getParallelismFromMetadata(m), &wg, resultChan, handlers...)
+	wg.Wait()
+	return getPromiseError(<-resultChan, "failed to grant application api scopes")
 }
 
 // Revoke a list of scopes from an OAuth application. The scope ID is needed for a revoke.
+// Revokes are made concurrently, limited by the provider's parallelism.
 func revokeOAuthApiScope(ctx context.Context, d *schema.ResourceData, m interface{}, ids []string) error {
-	for _, id := range ids {
-		resp, err := getOktaClientFromMetadata(m).Application.RevokeScopeConsentGrant(ctx, d.Get("app_id").(string), id)
-		if err := suppressErrorOn404(resp, err); err != nil {
-			return fmt.Errorf("failed to revoke application api scope: %v", err)
+	if len(ids) == 0 {
+		return nil
+	}
+	appID := d.Get("app_id").(string)
+	client := getOktaClientFromMetadata(m)
+	handlers := make([]func() error, len(ids))
+	for i := range ids {
+		id := ids[i]
+		handlers[i] = func() error {
+			resp, err := client.Application.RevokeScopeConsentGrant(ctx, appID, id)
+			return suppressErrorOn404(resp, err)
 		}
 	}
-	return nil
+	var wg sync.WaitGroup
+	resultChan := make(chan []*result, 1)
+	promiseAll(getParallelismFromMetadata(m), &wg, resultChan, handlers...)
+	wg.Wait()
+	return getPromiseError(<-resultChan, "failed to revoke application api scopes")
 }
 
 // Diff function to identify which scope needs to be added or removed to the application
@@ -235,10 +276,8 @@ func getOAuthApiScopeUpdateLists(d *schema.ResourceData, from []*okta.OAuth2Scop
 	desiredScopes := make([]string, 0)
 	currentScopes := make([]string, 0)
 
-	// cast list of interface{} to strings
-	for _, scope := range d.Get("scopes").([]interface{}) {
-		desiredScopes = append(desiredScopes, scope.(string))
-	}
+	// cast set of interface{} to strings
+	desiredScopes = append(desiredScopes, convertInterfaceToStringSet(d.Get("scopes"))...)
 
 	// extract scope list form []okta.OAuth2ScopeConsentGrant
 	for _, currentScope := range from {
@@ -3,6 +3,7 @@ package okta
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -16,11 +17,13 @@ func TestAccAppOAuthApplication_apiScope(t *testing.T) {
 	mgr := newFixtureManager(appOAuthAPIScope)
 	plainConfig := mgr.GetFixtures("basic.tf", ri, t)
 	plainUpdatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+	defaultIssuerConfig := mgr.GetFixtures("default_issuer.tf", ri, t)
 	resourceName := fmt.Sprintf("%s.test_app_scopes", appOAuthAPIScope)
 
 	// Replace example org url with actual url to prevent API error
 	config := strings.ReplaceAll(plainConfig, "https://your.okta.org", getOktaDomainName())
 	updatedConfig := strings.ReplaceAll(plainUpdatedConfig, "https://your.okta.org", getOktaDomainName())
+	trailingSlashConfig := strings.ReplaceAll(plainUpdatedConfig, "https://your.okta.org", strings.ToUpper(getOktaDomainName())+"/")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
@@ -46,6 +49,36 @@ func TestAccAppOAuthApplication_apiScope(t *testing.T) {
 					resource.TestCheckTypeSetElemAttr(resourceName, "scopes.*", "okta.users.manage"),
 				),
 			},
+			{
+				Config:   trailingSlashConfig,
+				PlanOnly: true,
+			},
+			{
+				Config: defaultIssuerConfig,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, apiScopeExists()),
+					resource.TestCheckResourceAttr(resourceName, "issuer", getOktaDomainName()),
+					resource.TestCheckTypeSetElemAttr(resourceName, "scopes.*", "okta.users.manage"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppOAuthApplication_apiScopeInvalid(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appOAuthAPIScope)
+	config := mgr.GetFixtures("invalid_scope.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      strings.ReplaceAll(config, "https://your.okta.org", getOktaDomainName()),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`scopes okta.unicorns.manage are not available`),
+			},
 		},
 	})
 }
@@ -76,3 +109,21 @@ func getOktaDomainName() string {
 	}
 	return fmt.Sprintf("https://%v.%v", c.orgName, domain)
 }
+
+func TestSuppressIssuerDiff(t *testing.T) {
+	tests := []struct {
+		old, new string
+		expected bool
+	}{
+		{"https://example.okta.com", "https://example.okta.com", true},
+		{"https://example.okta.com", "https://example.okta.com/", true},
+		{"https://example.okta.com", "https://Example.Okta.com", true},
+		{"https://example.okta.com", "", true},
+		{"https://example.okta.com", "https://other.okta.com", false},
+	}
+	for _, test := range tests {
+		if actual := suppressIssuerDiff("issuer", test.old, test.new, nil); actual != test.expected {
+			t.Errorf("suppressIssuerDiff test failed, old '%s', new '%s', expected %t, actual %t", test.old, test.new, test.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+const customRoleType = "CUSTOM"
+
+func resourceAppOAuthRoleAssignment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAppOAuthRoleAssignmentCreate,
+		ReadContext:   resourceAppOAuthRoleAssignmentRead,
+		DeleteContext: resourceAppOAuthRoleAssignmentDelete,
+		Importer:      &schema.ResourceImporter{StateContext: resourceAppOAuthRoleAssignmentImporter},
+		Schema: map[string]*schema.Schema{
+			"client_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Client ID of the OAuth service app to assign the admin role to",
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: elemInSlice(append(validAdminRoles, customRoleType)),
+				Description:      "Type of the admin role to assign, use 'CUSTOM' for the custom roles",
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"resource_set"},
+				Description:  "ID of the custom role, required for the 'CUSTOM' type",
+			},
+			"resource_set": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"role"},
+				Description:  "ID of the resource set of the custom role, required for the 'CUSTOM' type",
+			},
+			"label": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Label of the role assignment",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the role assignment",
+			},
+		},
+	}
+}
+
+func resourceAppOAuthRoleAssignmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clientID := d.Get("client_id").(string)
+	roleType := d.Get("type").(string)
+	body := sdk.ClientRoleAssignment{Type: roleType}
+	if roleType == customRoleType {
+		body.Role = d.Get("role").(string)
+		body.ResourceSet = d.Get("resource_set").(string)
+		if body.Role == "" || body.ResourceSet == "" {
+			return diag.Errorf("'role' and 'resource_set' are required for the '%s' role type", customRoleType)
+		}
+	} else if d.Get("role").(string) != "" {
+		return diag.Errorf("'role' and 'resource_set' can only be used with the '%s' role type", customRoleType)
+	}
+	logger(m).Info("assigning role to OAuth app", "client_id", clientID, "type", roleType)
+	role, _, err := getSupplementFromMetadata(m).AssignClientRole(ctx, clientID, body)
+	if err != nil {
+		return diag.Errorf("failed to assign role %s to OAuth app %s: %v", roleType, clientID, err)
+	}
+	d.SetId(role.ID)
+	return resourceAppOAuthRoleAssignmentRead(ctx, d, m)
+}
+
+func resourceAppOAuthRoleAssignmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clientID := d.Get("client_id").(string)
+	role, resp, err := getSupplementFromMetadata(m).GetClientRole(ctx, clientID, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get role %s assigned to OAuth app %s: %v", d.Id(), clientID, err)
+	}
+	if role == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("type", role.Type)
+	_ = d.Set("label", role.Label)
+	_ = d.Set("status", role.Status)
+	if role.Type == customRoleType {
+		_ = d.Set("role", role.Role)
+		_ = d.Set("resource_set", role.ResourceSet)
+	}
+	return nil
+}
+
+func resourceAppOAuthRoleAssignmentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clientID := d.Get("client_id").(string)
+	logger(m).Info("deleting assigned role from OAuth app", "client_id", clientID, "type", d.Get("type").(string))
+	resp, err := getSupplementFromMetadata(m).UnassignClientRole(ctx, clientID, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to remove role %s assigned to OAuth app %s: %v", d.Id(), clientID, err)
+	}
+	return nil
+}
+
+func resourceAppOAuthRoleAssignmentImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := strings.Split(d.Id(), "/")
+	if len(importID) != 2 {
+		return nil, fmt.Errorf("invalid format used for import ID, format must be client_id/role_assignment_id")
+	}
+	_ = d.Set("client_id", importID[0])
+	d.SetId(importID[1])
+	return []*schema.ResourceData{d}, nil
+}
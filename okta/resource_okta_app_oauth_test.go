@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/okta/okta-sdk-golang/v2/okta"
 	"github.com/okta/okta-sdk-golang/v2/okta/query"
@@ -39,6 +40,8 @@ func TestAccAppOauth_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "hide_ios", "true"),
 					resource.TestCheckResourceAttr(resourceName, "hide_web", "true"),
 					resource.TestCheckResourceAttr(resourceName, "auto_submit_toolbar", "false"),
+					resource.TestCheckResourceAttr(resourceName, "dpop_bound_access_tokens", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tls_client_certificate_bound_access_tokens", "false"),
 					resource.TestCheckResourceAttr(resourceName, "redirect_uris.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "response_types.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "client_secret", "something_from_somewhere"),
@@ -418,3 +421,30 @@ resource "%s" "%s" {
 }
 `, appOAuth, name, name)
 }
+
+func TestValidateAppOAuthTLSClientAuth(t *testing.T) {
+	jwks := []interface{}{map[string]interface{}{"kid": "test", "kty": "RSA", "e": "AQAB", "n": "xyz"}}
+	tests := []struct {
+		name  string
+		raw   map[string]interface{}
+		valid bool
+	}{
+		{"tls_client_auth", map[string]interface{}{"token_endpoint_auth_method": "tls_client_auth", "tls_client_auth_subject_dn": "CN=client"}, true},
+		{"tls_client_auth_no_subject_dn", map[string]interface{}{"token_endpoint_auth_method": "tls_client_auth"}, false},
+		{"self_signed_tls_client_auth", map[string]interface{}{"token_endpoint_auth_method": "self_signed_tls_client_auth", "jwks": jwks}, true},
+		{"self_signed_tls_client_auth_no_jwks", map[string]interface{}{"token_endpoint_auth_method": "self_signed_tls_client_auth"}, false},
+		{"bound_tokens", map[string]interface{}{"token_endpoint_auth_method": "tls_client_auth", "tls_client_auth_subject_dn": "CN=client", "tls_client_certificate_bound_access_tokens": true}, true},
+		{"bound_tokens_without_tls", map[string]interface{}{"token_endpoint_auth_method": "client_secret_basic", "tls_client_certificate_bound_access_tokens": true}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.raw["label"] = "test"
+			test.raw["type"] = "web"
+			d := schema.TestResourceDataRaw(t, resourceAppOAuth().Schema, test.raw)
+			err := validateAppOAuth(d)
+			if (err == nil) != test.valid {
+				t.Errorf("expected valid %v, got error %v", test.valid, err)
+			}
+		})
+	}
+}
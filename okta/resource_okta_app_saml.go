@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/okta-sdk-golang/v2/okta"
-	"github.com/okta/okta-sdk-golang/v2/okta/query"
 )
 
 const (
@@ -31,14 +30,16 @@ var customAppSamlRequiredFields = []string{
 }
 
 func resourceAppSaml() *schema.Resource {
-	return &schema.Resource{
+	return withResumableSteps(&schema.Resource{
 		CreateContext: resourceAppSamlCreate,
 		ReadContext:   resourceAppSamlRead,
 		UpdateContext: resourceAppSamlUpdate,
 		DeleteContext: resourceAppSamlDelete,
+		Timeouts:      appTimeouts(),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: validateAppSettingsJSONDiff,
 		// For those familiar with Terraform schemas be sure to check the base application schema and/or
 		// the examples in the documentation
 		Schema: buildAppSchema(map[string]*schema.Schema{
@@ -215,24 +216,6 @@ func resourceAppSaml() *schema.Resource {
 				Optional:    true,
 				Description: "Identifies the SAML authentication context class for the assertion’s authentication statement",
 			},
-			"accessibility_self_service": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Enable self service",
-			},
-			"accessibility_error_redirect_url": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Description:      "Custom error page URL",
-				ValidateDiagFunc: stringIsURL(validURLSchemes...),
-			},
-			"accessibility_login_redirect_url": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Description:      "Custom login page URL",
-				ValidateDiagFunc: stringIsURL(validURLSchemes...),
-			},
 			"features": {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -267,9 +250,7 @@ func resourceAppSaml() *schema.Resource {
 				Description:      "Application settings in JSON format",
 				ValidateDiagFunc: stringIsJSON,
 				StateFunc:        normalizeDataJSON,
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					return new == ""
-				},
+				DiffSuppressFunc: suppressAppSettingsJSONDiff,
 			},
 			"acs_endpoints": {
 				Type:        schema.TypeSet,
@@ -344,7 +325,7 @@ func resourceAppSaml() *schema.Resource {
 				RequiredWith: []string{"single_logout_issuer", "single_logout_url"},
 			},
 		}),
-	}
+	}, append(appStepKeys, "key_name", "key_years_valid")...)
 }
 
 func resourceAppSamlCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -356,9 +337,7 @@ func resourceAppSamlCreate(ctx context.Context, d *schema.ResourceData, m interf
 	if err != nil {
 		return diag.Errorf("failed to create SAML application: %v", err)
 	}
-	activate := d.Get("status").(string) == statusActive
-	params := &query.Params{Activate: &activate}
-	_, _, err = getOktaClientFromMetadata(m).Application.CreateApplication(ctx, app, params)
+	err = createApp(ctx, d, m, app)
 	if err != nil {
 		return diag.Errorf("failed to create SAML application: %v", err)
 	}
@@ -368,7 +347,7 @@ func resourceAppSamlCreate(ctx context.Context, d *schema.ResourceData, m interf
 	if err != nil {
 		return diag.Errorf("failed to create new certificate for SAML application: %v", err)
 	}
-	err = handleAppGroupsAndUsers(ctx, app.Id, d, m)
+	err = handleAppAssignments(ctx, app.Id, d, m)
 	if err != nil {
 		return diag.Errorf("failed to handle groups and users for SAML application: %v", err)
 	}
@@ -376,12 +355,16 @@ func resourceAppSamlCreate(ctx context.Context, d *schema.ResourceData, m interf
 	if err != nil {
 		return diag.Errorf("failed to upload logo for SAML application: %v", err)
 	}
+	err = handleAppAuthenticationPolicy(ctx, d, m, app.Id)
+	if err != nil {
+		return diag.Errorf("failed to set authentication policy for SAML application: %v", err)
+	}
 	return resourceAppSamlRead(ctx, d, m)
 }
 
 func resourceAppSamlRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	app := okta.NewSamlApplication()
-	err := fetchApp(ctx, d, m, app)
+	rawApp, err := fetchApp(ctx, d, m, app)
 	if err != nil {
 		return diag.Errorf("failed to get SAML application: %v", err)
 	}
@@ -407,6 +390,8 @@ func resourceAppSamlRead(ctx context.Context, d *schema.ResourceData, m interfac
 	_ = d.Set("user_name_template_suffix", app.Credentials.UserNameTemplate.Suffix)
 	_ = d.Set("preconfigured_app", app.Name)
 	_ = d.Set("logo_url", linksValue(app.Links, "logo", "href"))
+	setAppAuthenticationPolicy(d, app.Links)
+	setAppNotes(d, rawApp)
 	if app.Credentials.Signing.Kid != "" && app.Status != statusInactive {
 		keyID := app.Credentials.Signing.Kid
 		_ = d.Set("key_id", keyID)
@@ -429,12 +414,12 @@ func resourceAppSamlRead(ctx context.Context, d *schema.ResourceData, m interfac
 		_ = d.Set("entity_key", key)
 		_ = d.Set("certificate", desc.KeyDescriptors[0].KeyInfo.Certificate)
 	}
-	appRead(d, app.Name, app.Status, app.SignOnMode, app.Label, app.Accessibility, app.Visibility)
+	diags := appRead(d, app.Name, app.Status, app.SignOnMode, app.Label, app.Accessibility, app.Visibility)
 	err = syncGroupsAndUsers(ctx, app.Id, d, m)
 	if err != nil {
 		return diag.Errorf("failed to sync groups and users for SAML application: %v", err)
 	}
-	return nil
+	return diags
 }
 
 func resourceAppSamlUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -447,7 +432,7 @@ func resourceAppSamlUpdate(ctx context.Context, d *schema.ResourceData, m interf
 	if err != nil {
 		return diag.Errorf("failed to create SAML application: %v", err)
 	}
-	_, _, err = client.Application.UpdateApplication(ctx, d.Id(), app)
+	err = updateApp(ctx, d, m, app)
 	if err != nil {
 		return diag.Errorf("failed to update SAML application: %v", err)
 	}
@@ -461,7 +446,7 @@ func resourceAppSamlUpdate(ctx context.Context, d *schema.ResourceData, m interf
 			return diag.Errorf("failed to create new certificate for SAML application: %v", err)
 		}
 	}
-	err = handleAppGroupsAndUsers(ctx, app.Id, d, m)
+	err = handleAppAssignments(ctx, app.Id, d, m)
 	if err != nil {
 		return diag.Errorf("failed to handle groups and users for SAML application: %v", err)
 	}
@@ -473,6 +458,10 @@ func resourceAppSamlUpdate(ctx context.Context, d *schema.ResourceData, m interf
 			return diag.Errorf("failed to upload logo for SAML application: %v", err)
 		}
 	}
+	err = handleAppAuthenticationPolicy(ctx, d, m, app.Id)
+	if err != nil {
+		return diag.Errorf("failed to set authentication policy for SAML application: %v", err)
+	}
 	return resourceAppSamlRead(ctx, d, m)
 }
 
@@ -512,7 +501,6 @@ func buildSamlApp(d *schema.ResourceData) (*okta.SamlApplication, error) {
 	autoSubmit := d.Get("auto_submit_toolbar").(bool)
 	hideMobile := d.Get("hide_ios").(bool)
 	hideWeb := d.Get("hide_web").(bool)
-	a11ySelfService := d.Get("accessibility_self_service").(bool)
 	app.Settings = okta.NewSamlApplicationSettings()
 	app.Visibility = &okta.ApplicationVisibility{
 		AutoSubmitToolbar: &autoSubmit,
@@ -547,6 +535,7 @@ func buildSamlApp(d *schema.ResourceData) (*okta.SamlApplication, error) {
 		SignatureAlgorithm:    d.Get("signature_algorithm").(string),
 		DigestAlgorithm:       d.Get("digest_algorithm").(string),
 		HonorForceAuthn:       &honorForce,
+		RequestCompressed:     boolPtr(d.Get("request_compressed").(bool)),
 		AuthnContextClassRef:  d.Get("authn_context_class_ref").(string),
 		Slo:                   &okta.SingleLogout{Enabled: boolPtr(false)},
 	}
@@ -568,11 +557,7 @@ func buildSamlApp(d *schema.ResourceData) (*okta.SamlApplication, error) {
 			Suffix:   d.Get("user_name_template_suffix").(string),
 		},
 	}
-	app.Accessibility = &okta.ApplicationAccessibility{
-		SelfService:      &a11ySelfService,
-		ErrorRedirectUrl: d.Get("accessibility_error_redirect_url").(string),
-		LoginRedirectUrl: d.Get("accessibility_login_redirect_url").(string),
-	}
+	app.Accessibility = buildAppAccessibility(d)
 
 	// Assumes that sso url is already part of the acs endpoints as part of the desired state.
 	acsEndpoints := convertInterfaceToStringSet(d.Get("acs_endpoints"))
@@ -635,17 +620,19 @@ func generateCertificate(ctx context.Context, d *schema.ResourceData, m interfac
 }
 
 func tryCreateCertificate(ctx context.Context, d *schema.ResourceData, m interface{}, appID string) error {
-	if _, ok := d.GetOk("key_name"); ok {
-		key, err := generateCertificate(ctx, d, m, appID)
-		if err != nil {
-			return err
-		}
+	return stepsFrom(ctx).run([]string{"key_name", "key_years_valid"}, func() error {
+		if _, ok := d.GetOk("key_name"); ok {
+			key, err := generateCertificate(ctx, d, m, appID)
+			if err != nil {
+				return err
+			}
 
-		// Set ID and the read done at the end of update and create will do the GET on metadata
-		_ = d.Set("key_id", key.Kid)
-	}
+			// Set ID and the read done at the end of update and create will do the GET on metadata
+			_ = d.Set("key_id", key.Kid)
+		}
 
-	return nil
+		return nil
+	})
 }
 
 func validateAppSaml(d *schema.ResourceData) error {
@@ -77,6 +77,8 @@ func TestAccAppSaml_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "signature_algorithm", "RSA_SHA1"),
 					resource.TestCheckResourceAttr(resourceName, "digest_algorithm", "SHA1"),
 					resource.TestCheckResourceAttr(resourceName, "honor_force_authn", "true"),
+					resource.TestCheckResourceAttr(resourceName, "request_compressed", "true"),
+					resource.TestCheckResourceAttr(resourceName, "default_relay_state", "https://example.com/landing"),
 					resource.TestCheckResourceAttr(resourceName, "authn_context_class_ref", "urn:oasis:names:tc:SAML:2.0:ac:classes:PasswordProtectedTransport"),
 					resource.TestCheckResourceAttr(resourceName, "attribute_statements.0.name", "Attr One"),
 					resource.TestCheckResourceAttr(resourceName, "attribute_statements.0.namespace", "urn:oasis:names:tc:SAML:2.0:attrname-format:unspecified"),
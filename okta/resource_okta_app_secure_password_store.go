@@ -6,18 +6,19 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/okta-sdk-golang/v2/okta"
-	"github.com/okta/okta-sdk-golang/v2/okta/query"
 )
 
 func resourceAppSecurePasswordStore() *schema.Resource {
-	return &schema.Resource{
+	return withResumableSteps(&schema.Resource{
 		CreateContext: resourceAppSecurePasswordStoreCreate,
 		ReadContext:   resourceAppSecurePasswordStoreRead,
 		UpdateContext: resourceAppSecurePasswordStoreUpdate,
 		DeleteContext: resourceAppSecurePasswordStoreDelete,
+		Timeouts:      appTimeouts(),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: warnAppSwaCredentialsConflict,
 
 		// For those familiar with Terraform schemas be sure to check the base application schema and/or
 		// the examples in the documentation
@@ -99,24 +100,30 @@ func resourceAppSecurePasswordStore() *schema.Resource {
 				Description: "Shared password, required for certain schemes.",
 			},
 		}),
-	}
+	}, appStepKeys...)
 }
 
 func resourceAppSecurePasswordStoreCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	app := buildAppSecurePasswordStore(d)
-	activate := d.Get("status").(string) == statusActive
-	params := &query.Params{Activate: &activate}
-	_, _, err := getOktaClientFromMetadata(m).Application.CreateApplication(ctx, app, params)
+	err := createApp(ctx, d, m, app)
 	if err != nil {
-		return diag.Errorf("failed to create secure password store application: %v", err)
+		return diag.Errorf("failed to create secure password store application: %v", appSwaCredentialsError(d, err))
 	}
 	d.SetId(app.Id)
+	err = handleAppLogo(ctx, d, m, app.Id, app.Links)
+	if err != nil {
+		return diag.Errorf("failed to upload logo for secure password store application: %v", err)
+	}
+	err = handleAppAuthenticationPolicy(ctx, d, m, app.Id)
+	if err != nil {
+		return diag.Errorf("failed to set authentication policy for secure password store application: %v", err)
+	}
 	return resourceAppSecurePasswordStoreRead(ctx, d, m)
 }
 
 func resourceAppSecurePasswordStoreRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	app := okta.NewSecurePasswordStoreApplication()
-	err := fetchApp(ctx, d, m, app)
+	rawApp, err := fetchApp(ctx, d, m, app)
 	if err != nil {
 		return diag.Errorf("failed to get secure password store application: %v", err)
 	}
@@ -139,21 +146,36 @@ func resourceAppSecurePasswordStoreRead(ctx context.Context, d *schema.ResourceD
 	_ = d.Set("user_name_template", app.Credentials.UserNameTemplate.Template)
 	_ = d.Set("user_name_template_type", app.Credentials.UserNameTemplate.Type)
 	_ = d.Set("user_name_template_suffix", app.Credentials.UserNameTemplate.Suffix)
-	appRead(d, app.Name, app.Status, app.SignOnMode, app.Label, app.Accessibility, app.Visibility)
-	return nil
+	_ = d.Set("logo_url", linksValue(app.Links, "logo", "href"))
+	setAppAuthenticationPolicy(d, app.Links)
+	setAppNotes(d, rawApp)
+	diags := appRead(d, app.Name, app.Status, app.SignOnMode, app.Label, app.Accessibility, app.Visibility)
+	return diags
 }
 
 func resourceAppSecurePasswordStoreUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := getOktaClientFromMetadata(m)
 	app := buildAppSecurePasswordStore(d)
-	_, _, err := client.Application.UpdateApplication(ctx, d.Id(), app)
+	err := updateApp(ctx, d, m, app)
 	if err != nil {
-		return diag.Errorf("failed to update secure password store application: %v", err)
+		return diag.Errorf("failed to update secure password store application: %v", appSwaCredentialsError(d, err))
 	}
 	err = setAppStatus(ctx, d, client, app.Status)
 	if err != nil {
 		return diag.Errorf("failed to set secure password store application status: %v", err)
 	}
+	if d.HasChange("logo") {
+		err = handleAppLogo(ctx, d, m, app.Id, app.Links)
+		if err != nil {
+			o, _ := d.GetChange("logo")
+			_ = d.Set("logo", o)
+			return diag.Errorf("failed to upload logo for secure password store application: %v", err)
+		}
+	}
+	err = handleAppAuthenticationPolicy(ctx, d, m, app.Id)
+	if err != nil {
+		return diag.Errorf("failed to set authentication policy for secure password store application: %v", err)
+	}
 	return resourceAppSecurePasswordStoreRead(ctx, d, m)
 }
 
@@ -185,6 +207,7 @@ func buildAppSecurePasswordStore(d *schema.ResourceData) *okta.SecurePasswordSto
 	}
 	app.Credentials = buildSchemeCreds(d)
 	app.Visibility = buildVisibility(d)
+	app.Accessibility = buildAppAccessibility(d)
 
 	return app
 }
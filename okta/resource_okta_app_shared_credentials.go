@@ -6,24 +6,19 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/okta-sdk-golang/v2/okta"
-	"github.com/okta/okta-sdk-golang/v2/okta/query"
 )
 
 func resourceAppSharedCredentials() *schema.Resource {
-	return &schema.Resource{
+	return withResumableSteps(&schema.Resource{
 		CreateContext: resourceAppSharedCredentialsCreate,
 		ReadContext:   resourceAppSharedCredentialsRead,
 		UpdateContext: resourceAppSharedCredentialsUpdate,
 		DeleteContext: resourceAppSharedCredentialsDelete,
+		Timeouts:      appTimeouts(),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: buildAppSwaSchema(map[string]*schema.Schema{
-			"accessibility_login_redirect_url": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Custom login page URL",
-			},
 			"button_field": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -71,14 +66,12 @@ func resourceAppSharedCredentials() *schema.Resource {
 				Description: "Shared password, required for certain schemes.",
 			},
 		}),
-	}
+	}, appStepKeys...)
 }
 
 func resourceAppSharedCredentialsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	app := buildAppSharedCredentials(d)
-	activate := d.Get("status").(string) == statusActive
-	params := &query.Params{Activate: &activate}
-	_, _, err := getOktaClientFromMetadata(m).Application.CreateApplication(ctx, app, params)
+	err := createApp(ctx, d, m, app)
 	if err != nil {
 		return diag.Errorf("failed to create SWA shared credentials application: %v", err)
 	}
@@ -87,12 +80,16 @@ func resourceAppSharedCredentialsCreate(ctx context.Context, d *schema.ResourceD
 	if err != nil {
 		return diag.Errorf("failed to upload logo for SWA shared credentials application: %v", err)
 	}
+	err = handleAppAuthenticationPolicy(ctx, d, m, app.Id)
+	if err != nil {
+		return diag.Errorf("failed to set authentication policy for SWA shared credentials application: %v", err)
+	}
 	return resourceAppSharedCredentialsRead(ctx, d, m)
 }
 
 func resourceAppSharedCredentialsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	app := okta.NewBrowserPluginApplication()
-	err := fetchApp(ctx, d, m, app)
+	rawApp, err := fetchApp(ctx, d, m, app)
 	if err != nil {
 		return diag.Errorf("failed to get SWA shared credentials application: %v", err)
 	}
@@ -113,14 +110,16 @@ func resourceAppSharedCredentialsRead(ctx context.Context, d *schema.ResourceDat
 	_ = d.Set("user_name_template_type", app.Credentials.UserNameTemplate.Type)
 	_ = d.Set("user_name_template_suffix", app.Credentials.UserNameTemplate.Suffix)
 	_ = d.Set("logo_url", linksValue(app.Links, "logo", "href"))
-	appRead(d, app.Name, app.Status, app.SignOnMode, app.Label, app.Accessibility, app.Visibility)
-	return nil
+	setAppAuthenticationPolicy(d, app.Links)
+	setAppNotes(d, rawApp)
+	diags := appRead(d, app.Name, app.Status, app.SignOnMode, app.Label, app.Accessibility, app.Visibility)
+	return diags
 }
 
 func resourceAppSharedCredentialsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := getOktaClientFromMetadata(m)
 	app := buildAppSharedCredentials(d)
-	_, _, err := client.Application.UpdateApplication(ctx, d.Id(), app)
+	err := updateApp(ctx, d, m, app)
 	if err != nil {
 		return diag.Errorf("failed to update SWA shared credentials application: %v", err)
 	}
@@ -136,6 +135,10 @@ func resourceAppSharedCredentialsUpdate(ctx context.Context, d *schema.ResourceD
 			return diag.Errorf("failed to upload logo for SWA shared credentials application: %v", err)
 		}
 	}
+	err = handleAppAuthenticationPolicy(ctx, d, m, app.Id)
+	if err != nil {
+		return diag.Errorf("failed to set authentication policy for SWA shared credentials application: %v", err)
+	}
 	return resourceAppSharedCredentialsRead(ctx, d, m)
 }
 
@@ -174,11 +177,7 @@ func buildAppSharedCredentials(d *schema.ResourceData) *okta.BrowserPluginApplic
 		Scheme:   "SHARED_USERNAME_AND_PASSWORD",
 		UserName: d.Get("shared_username").(string),
 	}
-	app.Accessibility = &okta.ApplicationAccessibility{
-		SelfService:      boolPtr(d.Get("accessibility_self_service").(bool)),
-		ErrorRedirectUrl: d.Get("accessibility_error_redirect_url").(string),
-		LoginRedirectUrl: d.Get("accessibility_login_redirect_url").(string),
-	}
+	app.Accessibility = buildAppAccessibility(d)
 	app.Visibility = buildVisibility(d)
 	return app
 }
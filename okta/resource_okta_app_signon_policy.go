@@ -0,0 +1,91 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourceAppSignOnPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAppSignOnPolicyCreate,
+		ReadContext:   resourceAppSignOnPolicyRead,
+		UpdateContext: resourceAppSignOnPolicyUpdate,
+		DeleteContext: resourceAppSignOnPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Policy Name",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Policy Description",
+			},
+			"status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          statusActive,
+				ValidateDiagFunc: elemInSlice([]string{statusActive, statusInactive}),
+				Description:      "Policy Status: ACTIVE or INACTIVE.",
+			},
+		},
+	}
+}
+
+func resourceAppSignOnPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	template := buildAppSignOnPolicy(d)
+	err := createPolicy(ctx, d, m, template)
+	if err != nil {
+		return diag.Errorf("failed to create app sign-on policy: %v", err)
+	}
+	return resourceAppSignOnPolicyRead(ctx, d, m)
+}
+
+func resourceAppSignOnPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	policy, err := getPolicy(ctx, d, m)
+	if err != nil {
+		return diag.Errorf("failed to get app sign-on policy: %v", err)
+	}
+	if policy == nil {
+		return nil
+	}
+	_ = d.Set("name", policy.Name)
+	_ = d.Set("description", policy.Description)
+	_ = d.Set("status", policy.Status)
+	return nil
+}
+
+func resourceAppSignOnPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	template := buildAppSignOnPolicy(d)
+	err := updatePolicy(ctx, d, m, template)
+	if err != nil {
+		return diag.Errorf("failed to update app sign-on policy: %v", err)
+	}
+	return resourceAppSignOnPolicyRead(ctx, d, m)
+}
+
+func resourceAppSignOnPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	err := deletePolicy(ctx, d, m)
+	if err != nil {
+		return diag.Errorf("failed to delete app sign-on policy: %v", err)
+	}
+	return nil
+}
+
+// create or update an app sign-on policy
+func buildAppSignOnPolicy(d *schema.ResourceData) sdk.Policy {
+	template := sdk.AccessPolicy()
+	template.Name = d.Get("name").(string)
+	template.Status = d.Get("status").(string)
+	if description, ok := d.GetOk("description"); ok {
+		template.Description = description.(string)
+	}
+	return template
+}
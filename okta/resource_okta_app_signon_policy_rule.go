@@ -0,0 +1,369 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourceAppSignOnPolicyRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAppSignOnPolicyRuleCreate,
+		ReadContext:   resourceAppSignOnPolicyRuleRead,
+		UpdateContext: resourceAppSignOnPolicyRuleUpdate,
+		DeleteContext: resourceAppSignOnPolicyRuleDelete,
+		Importer:      createPolicyRuleImporter(),
+		Schema: map[string]*schema.Schema{
+			"policy_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the app sign-on policy",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Policy Rule Name",
+			},
+			"priority": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Policy Rule Priority, this attribute can be set to a valid priority. To avoid endless diff situation we error if an invalid priority is provided. API defaults it to the last (lowest) if not there.",
+				// Suppress diff if config is empty.
+				DiffSuppressFunc: createValueDiffSuppression("0"),
+			},
+			"status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          statusActive,
+				ValidateDiagFunc: elemInSlice([]string{statusActive, statusInactive}),
+				Description:      "Policy Rule Status: ACTIVE or INACTIVE.",
+			},
+			"access": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "ALLOW",
+				ValidateDiagFunc: elemInSlice([]string{"ALLOW", "DENY"}),
+				Description:      "Allow or deny access based on the rule conditions: ALLOW or DENY",
+			},
+			"network_connection": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "ANYWHERE",
+				ValidateDiagFunc: elemInSlice([]string{"ANYWHERE", "ZONE"}),
+				Description:      "Network selection mode: ANYWHERE or ZONE.",
+			},
+			"network_includes": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Description:   "The zones to include",
+				ConflictsWith: []string{"network_excludes"},
+				Elem:          &schema.Schema{Type: schema.TypeString},
+			},
+			"network_excludes": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Description:   "The zones to exclude",
+				ConflictsWith: []string{"network_includes"},
+				Elem:          &schema.Schema{Type: schema.TypeString},
+			},
+			"risk_score": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "ANY",
+				ValidateDiagFunc: elemInSlice([]string{"ANY", "LOW", "MEDIUM", "HIGH"}),
+				Description:      "The risk score specifies a particular level of risk to match on: ANY, LOW, MEDIUM or HIGH",
+			},
+			"device_is_registered": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether the device needs to be registered",
+			},
+			"device_is_managed": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				RequiredWith: []string{"device_is_registered"},
+				Description:  "Whether the device needs to be managed, 'device_is_registered' should be set to true",
+			},
+			"groups_included": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Set of Group IDs to Include",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"groups_excluded": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Set of Group IDs to Exclude",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"users_included": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Set of User IDs to Include",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"users_excluded": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Set of User IDs to Exclude",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"custom_expression": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Okta Expression Language expression the user must match to use the rule",
+			},
+			"factor_mode": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "2FA",
+				ValidateDiagFunc: elemInSlice([]string{"1FA", "2FA"}),
+				Description:      "The number of factors required to satisfy this assurance level: 1FA or 2FA",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "ASSURANCE",
+				Description: "The verification method type",
+			},
+			"re_authentication_frequency": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "PT2H",
+				Description: "The duration after which the end user must re-authenticate, regardless of user activity, in ISO 8601 format, e.g. 'PT2H'. Use 'PT0S' to require re-authentication on every sign-in attempt",
+			},
+			"require_phishing_resistant_factor": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Description:   "Require a phishing resistant possession factor, expands to the two factor assurance with a phishing resistant possession constraint.",
+				ConflictsWith: []string{"constraints", "factor_mode"},
+			},
+			"constraints": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "List of authenticator constraints in JSON format, e.g. '{\"knowledge\":{\"types\":[\"password\"]}}'",
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: stringIsJSON,
+					StateFunc:        normalizeDataJSON,
+				},
+			},
+		},
+	}
+}
+
+func resourceAppSignOnPolicyRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	logger(m).Info("creating app sign-on policy rule", "name", d.Get("name").(string))
+	template, err := buildAppSignOnPolicyRule(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	rule, _, err := getSupplementFromMetadata(m).CreateAccessPolicyRule(ctx, d.Get("policy_id").(string), template)
+	if err != nil {
+		return diag.Errorf("failed to create app sign-on policy rule: %v", err)
+	}
+	// We want to put this under Terraform's control even if priority is invalid.
+	d.SetId(rule.Id)
+	err = validatePriority(template.Priority, rule.Priority)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = policyRuleActivate(ctx, d, m)
+	if err != nil {
+		return diag.Errorf("failed to change app sign-on policy rule status: %v", err)
+	}
+	return resourceAppSignOnPolicyRuleRead(ctx, d, m)
+}
+
+func resourceAppSignOnPolicyRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	logger(m).Info("reading app sign-on policy rule", "id", d.Id())
+	rule, resp, err := getSupplementFromMetadata(m).GetAccessPolicyRule(ctx, d.Get("policy_id").(string), d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get app sign-on policy rule: %v", err)
+	}
+	if rule == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", rule.Name)
+	_ = d.Set("status", rule.Status)
+	_ = d.Set("priority", rule.Priority)
+	attrs := map[string]interface{}{}
+	if rule.Conditions != nil {
+		if rule.Conditions.Network != nil {
+			_ = d.Set("network_connection", rule.Conditions.Network.Connection)
+			attrs["network_includes"] = convertStringArrToInterface(rule.Conditions.Network.Include)
+			attrs["network_excludes"] = convertStringArrToInterface(rule.Conditions.Network.Exclude)
+		}
+		if rule.Conditions.RiskScore != nil {
+			_ = d.Set("risk_score", rule.Conditions.RiskScore.Level)
+		}
+		if rule.Conditions.Device != nil {
+			if rule.Conditions.Device.Registered != nil {
+				_ = d.Set("device_is_registered", *rule.Conditions.Device.Registered)
+			}
+			if rule.Conditions.Device.Managed != nil {
+				_ = d.Set("device_is_managed", *rule.Conditions.Device.Managed)
+			}
+		}
+		if rule.Conditions.ElCondition != nil {
+			_ = d.Set("custom_expression", rule.Conditions.ElCondition.Condition)
+		}
+		if rule.Conditions.People != nil {
+			if rule.Conditions.People.Groups != nil {
+				attrs["groups_included"] = convertStringSetToInterface(rule.Conditions.People.Groups.Include)
+				attrs["groups_excluded"] = convertStringSetToInterface(rule.Conditions.People.Groups.Exclude)
+			}
+			if rule.Conditions.People.Users != nil {
+				attrs["users_included"] = convertStringSetToInterface(rule.Conditions.People.Users.Include)
+				attrs["users_excluded"] = convertStringSetToInterface(rule.Conditions.People.Users.Exclude)
+			}
+		}
+	}
+	if rule.Actions.AppSignOn != nil {
+		_ = d.Set("access", rule.Actions.AppSignOn.Access)
+		if vm := rule.Actions.AppSignOn.VerificationMethod; vm != nil {
+			_ = d.Set("type", vm.Type)
+			_ = d.Set("re_authentication_frequency", vm.ReauthenticateIn)
+			phishingResistant := isPhishingResistantVerificationMethod(vm)
+			if d.Get("require_phishing_resistant_factor").(bool) || phishingResistant {
+				_ = d.Set("require_phishing_resistant_factor", phishingResistant)
+			}
+			if !phishingResistant {
+				_ = d.Set("factor_mode", vm.FactorMode)
+			}
+			constraints := make([]interface{}, len(vm.Constraints))
+			for i := range vm.Constraints {
+				b, err := json.Marshal(vm.Constraints[i])
+				if err != nil {
+					return diag.Errorf("failed to marshal app sign-on policy rule constraint: %v", err)
+				}
+				constraints[i] = string(b)
+			}
+			if !phishingResistant {
+				attrs["constraints"] = constraints
+			}
+		}
+	}
+	err = setNonPrimitives(d, attrs)
+	if err != nil {
+		return diag.Errorf("failed to set app sign-on policy rule properties: %v", err)
+	}
+	return nil
+}
+
+func resourceAppSignOnPolicyRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	logger(m).Info("updating app sign-on policy rule", "name", d.Get("name").(string))
+	template, err := buildAppSignOnPolicyRule(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	rule, _, err := getSupplementFromMetadata(m).UpdateAccessPolicyRule(ctx, d.Get("policy_id").(string), d.Id(), template)
+	if err != nil {
+		return diag.Errorf("failed to update app sign-on policy rule: %v", err)
+	}
+	err = validatePriority(template.Priority, rule.Priority)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = policyRuleActivate(ctx, d, m)
+	if err != nil {
+		return diag.Errorf("failed to change app sign-on policy rule status: %v", err)
+	}
+	return resourceAppSignOnPolicyRuleRead(ctx, d, m)
+}
+
+func resourceAppSignOnPolicyRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	err := deleteRule(ctx, d, m, true)
+	if err != nil {
+		return diag.Errorf("failed to delete app sign-on policy rule: %v", err)
+	}
+	return nil
+}
+
+// Build app sign-on policy rule from resource data
+func buildAppSignOnPolicyRule(d *schema.ResourceData) (sdk.AccessPolicyRule, error) {
+	template := sdk.AccessPolicyRule{Type: sdk.AccessPolicyType}
+	template.Name = d.Get("name").(string)
+	template.Status = d.Get("status").(string)
+	if priority, ok := d.GetOk("priority"); ok {
+		template.Priority = int64(priority.(int))
+	}
+	template.Conditions = &sdk.AccessPolicyRuleConditions{
+		Network: getNetwork(d),
+		People: &okta.PolicyPeopleCondition{
+			Groups: &okta.GroupCondition{
+				Include: convertInterfaceToStringSet(d.Get("groups_included")),
+				Exclude: convertInterfaceToStringSet(d.Get("groups_excluded")),
+			},
+			Users: &okta.UserCondition{
+				Include: convertInterfaceToStringSet(d.Get("users_included")),
+				Exclude: convertInterfaceToStringSet(d.Get("users_excluded")),
+			},
+		},
+		RiskScore: &okta.RiskScorePolicyRuleCondition{
+			Level: d.Get("risk_score").(string),
+		},
+	}
+	if registered, ok := d.GetOk("device_is_registered"); ok && registered.(bool) {
+		template.Conditions.Device = &sdk.AccessPolicyDeviceCondition{
+			Registered: boolPtr(true),
+			Managed:    boolPtr(d.Get("device_is_managed").(bool)),
+		}
+	}
+	if expression, ok := d.GetOk("custom_expression"); ok {
+		template.Conditions.ElCondition = &sdk.AccessPolicyExpressionCondition{
+			Condition: expression.(string),
+		}
+	}
+	template.Actions = sdk.AccessPolicyRuleActions{
+		AppSignOn: &sdk.AccessPolicyRuleAppSignOn{
+			Access: d.Get("access").(string),
+			VerificationMethod: &sdk.AccessPolicyVerificationMethod{
+				FactorMode:       d.Get("factor_mode").(string),
+				ReauthenticateIn: d.Get("re_authentication_frequency").(string),
+				Type:             d.Get("type").(string),
+			},
+		},
+	}
+	if d.Get("require_phishing_resistant_factor").(bool) {
+		template.Actions.AppSignOn.VerificationMethod.FactorMode = "2FA"
+		template.Actions.AppSignOn.VerificationMethod.Constraints = []map[string]interface{}{phishingResistantConstraint()}
+		return template, nil
+	}
+	constraints := convertInterfaceToStringArr(d.Get("constraints"))
+	for _, c := range constraints {
+		var constraint map[string]interface{}
+		if err := json.Unmarshal([]byte(c), &constraint); err != nil {
+			return template, fmt.Errorf("failed to unmarshal app sign-on policy rule constraint '%s': %v", c, err)
+		}
+		template.Actions.AppSignOn.VerificationMethod.Constraints = append(template.Actions.AppSignOn.VerificationMethod.Constraints, constraint)
+	}
+	return template, nil
+}
+
+// phishingResistantConstraint is the authenticator constraint requiring a phishing resistant possession factor,
+// e.g. FIDO2 (WebAuthn) or Okta Verify with FastPass.
+func phishingResistantConstraint() map[string]interface{} {
+	return map[string]interface{}{
+		"possession": map[string]interface{}{
+			"phishingResistant": "REQUIRED",
+		},
+	}
+}
+
+// isPhishingResistantVerificationMethod returns whether the verification method is the expansion of
+// 'require_phishing_resistant_factor'
+func isPhishingResistantVerificationMethod(vm *sdk.AccessPolicyVerificationMethod) bool {
+	if vm.FactorMode != "2FA" || len(vm.Constraints) != 1 || len(vm.Constraints[0]) != 1 {
+		return false
+	}
+	possession, ok := vm.Constraints[0]["possession"].(map[string]interface{})
+	return ok && possession["phishingResistant"] == "REQUIRED"
+}
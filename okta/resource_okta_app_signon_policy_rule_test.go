@@ -0,0 +1,55 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaAppSignOnPolicyRule_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appSignOnPolicyRule)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+	phishingResistant := mgr.GetFixtures("phishing_resistant.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", appSignOnPolicyRule)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createPolicyCheckDestroy(appSignOnPolicy),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
+					resource.TestCheckResourceAttr(resourceName, "access", "ALLOW"),
+					resource.TestCheckResourceAttr(resourceName, "factor_mode", "2FA"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "factor_mode", "1FA"),
+					resource.TestCheckResourceAttr(resourceName, "re_authentication_frequency", "PT43800H"),
+					resource.TestCheckResourceAttr(resourceName, "device_is_registered", "true"),
+					resource.TestCheckResourceAttr(resourceName, "groups_included.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "users_excluded.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "constraints.#", "1"),
+				),
+			},
+			{
+				Config: phishingResistant,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "require_phishing_resistant_factor", "true"),
+					resource.TestCheckResourceAttr(resourceName, "constraints.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -6,18 +6,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/okta-sdk-golang/v2/okta"
-	"github.com/okta/okta-sdk-golang/v2/okta/query"
+	"github.com/okta/terraform-provider-okta/sdk"
 )
 
 func resourceAppSwa() *schema.Resource {
-	return &schema.Resource{
+	return withResumableSteps(&schema.Resource{
 		CreateContext: resourceAppSwaCreate,
 		ReadContext:   resourceAppSwaRead,
 		UpdateContext: resourceAppSwaUpdate,
 		DeleteContext: resourceAppSwaDelete,
+		Timeouts:      appTimeouts(),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: warnAppSwaCredentialsConflict,
 		Schema: buildAppSwaSchema(map[string]*schema.Schema{
 			"preconfigured_app": {
 				Type:        schema.TypeString,
@@ -50,21 +52,60 @@ func resourceAppSwa() *schema.Resource {
 				Optional:    true,
 				Description: "A regex that further restricts URL to the specified regex",
 			},
+			"checkbox": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "CSS selector for the checkbox",
+			},
+			"redirect_url": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "If going to the login page URL redirects to another page, then enter that URL here",
+				ValidateDiagFunc: stringIsURL(validURLSchemes...),
+			},
+			"credentials_scheme": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateDiagFunc: elemInSlice(
+					[]string{
+						"EDIT_USERNAME_AND_PASSWORD",
+						"ADMIN_SETS_CREDENTIALS",
+						"EDIT_PASSWORD_ONLY",
+						"EXTERNAL_PASSWORD_SYNC",
+						"SHARED_USERNAME_AND_PASSWORD",
+					}),
+				Description: "Application credentials scheme",
+			},
+			"reveal_password": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Allow user to reveal password",
+			},
+			"shared_username": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Shared username, required for certain schemes.",
+			},
+			"shared_password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Shared password, required for certain schemes.",
+			},
 		}),
-	}
+	}, appStepKeys...)
 }
 
 func resourceAppSwaCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := getOktaClientFromMetadata(m)
 	app := buildAppSwa(d)
-	activate := d.Get("status").(string) == statusActive
-	params := &query.Params{Activate: &activate}
-	_, _, err := client.Application.CreateApplication(ctx, app, params)
+	err := createApp(ctx, d, m, app, buildAppSwaSettingsExtra(d))
 	if err != nil {
-		return diag.Errorf("failed to create SWA application: %v", err)
+		return diag.Errorf("failed to create SWA application: %v", appSwaCredentialsError(d, err))
 	}
 	d.SetId(app.Id)
-	err = handleAppGroupsAndUsers(ctx, app.Id, d, m)
+	err = handleAppAssignments(ctx, app.Id, d, m)
 	if err != nil {
 		return diag.Errorf("failed to handle groups and users for SWA application: %v", err)
 	}
@@ -72,12 +113,16 @@ func resourceAppSwaCreate(ctx context.Context, d *schema.ResourceData, m interfa
 	if err != nil {
 		return diag.Errorf("failed to upload logo for SWA application: %v", err)
 	}
+	err = handleAppAuthenticationPolicy(ctx, d, m, app.Id)
+	if err != nil {
+		return diag.Errorf("failed to set authentication policy for SWA application: %v", err)
+	}
 	return resourceAppSwaRead(ctx, d, m)
 }
 
 func resourceAppSwaRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	app := okta.NewSwaApplication()
-	err := fetchApp(ctx, d, m, app)
+	app := sdk.NewSwaApplication()
+	rawApp, err := fetchApp(ctx, d, m, app)
 	if err != nil {
 		return diag.Errorf("failed to get SWA application: %v", err)
 	}
@@ -90,30 +135,38 @@ func resourceAppSwaRead(ctx context.Context, d *schema.ResourceData, m interface
 	_ = d.Set("username_field", app.Settings.App.UsernameField)
 	_ = d.Set("url", app.Settings.App.Url)
 	_ = d.Set("url_regex", app.Settings.App.LoginUrlRegex)
+	_ = d.Set("credentials_scheme", app.Credentials.Scheme)
+	_ = d.Set("reveal_password", app.Credentials.RevealPassword)
+	_ = d.Set("shared_username", app.Credentials.UserName) // We can sync shared username but not password from upstream
 	_ = d.Set("user_name_template", app.Credentials.UserNameTemplate.Template)
 	_ = d.Set("user_name_template_type", app.Credentials.UserNameTemplate.Type)
 	_ = d.Set("user_name_template_suffix", app.Credentials.UserNameTemplate.Suffix)
 	_ = d.Set("logo_url", linksValue(app.Links, "logo", "href"))
-	appRead(d, app.Name, app.Status, app.SignOnMode, app.Label, app.Accessibility, app.Visibility)
+	setAppAuthenticationPolicy(d, app.Links)
+	setAppNotes(d, rawApp)
+	extra := rawApp.SwaSettingsExtra()
+	_ = d.Set("checkbox", extra.Checkbox)
+	_ = d.Set("redirect_url", extra.RedirectURL)
+	diags := appRead(d, app.Name, app.Status, app.SignOnMode, app.Label, app.Accessibility, app.Visibility)
 	err = syncGroupsAndUsers(ctx, app.Id, d, m)
 	if err != nil {
 		return diag.Errorf("failed to sync groups and users for SWA application: %v", err)
 	}
-	return nil
+	return diags
 }
 
 func resourceAppSwaUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := getOktaClientFromMetadata(m)
 	app := buildAppSwa(d)
-	_, _, err := client.Application.UpdateApplication(ctx, d.Id(), app)
+	err := updateApp(ctx, d, m, app, buildAppSwaSettingsExtra(d))
 	if err != nil {
-		return diag.Errorf("failed to update SWA application: %v", err)
+		return diag.Errorf("failed to update SWA application: %v", appSwaCredentialsError(d, err))
 	}
 	err = setAppStatus(ctx, d, client, app.Status)
 	if err != nil {
 		return diag.Errorf("failed to set SWA application status: %v", err)
 	}
-	err = handleAppGroupsAndUsers(ctx, app.Id, d, m)
+	err = handleAppAssignments(ctx, app.Id, d, m)
 	if err != nil {
 		return diag.Errorf("failed to handle groups and users for SWA application: %v", err)
 	}
@@ -125,6 +178,10 @@ func resourceAppSwaUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 			return diag.Errorf("failed to upload logo for SWA application: %v", err)
 		}
 	}
+	err = handleAppAuthenticationPolicy(ctx, d, m, app.Id)
+	if err != nil {
+		return diag.Errorf("failed to set authentication policy for SWA application: %v", err)
+	}
 	return resourceAppSwaRead(ctx, d, m)
 }
 
@@ -136,9 +193,9 @@ func resourceAppSwaDelete(ctx context.Context, d *schema.ResourceData, m interfa
 	return nil
 }
 
-func buildAppSwa(d *schema.ResourceData) *okta.SwaApplication {
+func buildAppSwa(d *schema.ResourceData) *sdk.SwaApplication {
 	// Abstracts away name and SignOnMode which are constant for this app type.
-	app := okta.NewSwaApplication()
+	app := sdk.NewSwaApplication()
 	app.Label = d.Get("label").(string)
 	name := d.Get("preconfigured_app").(string)
 	if name != "" {
@@ -155,12 +212,15 @@ func buildAppSwa(d *schema.ResourceData) *okta.SwaApplication {
 		},
 	}
 	app.Visibility = buildVisibility(d)
-	app.Credentials = &okta.ApplicationCredentials{
-		UserNameTemplate: &okta.ApplicationCredentialsUsernameTemplate{
-			Suffix:   d.Get("user_name_template_suffix").(string),
-			Template: d.Get("user_name_template").(string),
-			Type:     d.Get("user_name_template_type").(string),
-		},
-	}
+	app.Accessibility = buildAppAccessibility(d)
+	app.Credentials = buildSchemeCreds(d)
 	return app
 }
+
+// buildAppSwaSettingsExtra sets the checkbox and redirect URL settings, which the SWA app model lacks
+func buildAppSwaSettingsExtra(d *schema.ResourceData) sdk.AppFields {
+	return sdk.WithSwaAppSettingsExtra(&sdk.SwaAppSettingsExtra{
+		Checkbox:    d.Get("checkbox").(string),
+		RedirectURL: d.Get("redirect_url").(string),
+	})
+}
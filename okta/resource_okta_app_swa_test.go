@@ -78,6 +78,12 @@ func TestAccAppSwaApplication_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "button_field", "btn-login-updated"),
 					resource.TestCheckResourceAttr(resourceName, "password_field", "txtbox-password-updated"),
 					resource.TestCheckResourceAttr(resourceName, "username_field", "txtbox-username-updated"),
+					resource.TestCheckResourceAttr(resourceName, "checkbox", "chk-remember"),
+					resource.TestCheckResourceAttr(resourceName, "credentials_scheme", "SHARED_USERNAME_AND_PASSWORD"),
+					resource.TestCheckResourceAttr(resourceName, "shared_username", "shared-user"),
+					resource.TestCheckResourceAttr(resourceName, "redirect_url", "https://example.com/redirect.html"),
+					resource.TestCheckResourceAttr(resourceName, "skip_users", "true"),
+					resource.TestCheckResourceAttr(resourceName, "skip_groups", "true"),
 				),
 			},
 		},
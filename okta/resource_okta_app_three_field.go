@@ -6,15 +6,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/okta-sdk-golang/v2/okta"
-	"github.com/okta/okta-sdk-golang/v2/okta/query"
 )
 
 func resourceAppThreeField() *schema.Resource {
-	return &schema.Resource{
+	return withResumableSteps(&schema.Resource{
 		CreateContext: resourceAppThreeFieldCreate,
 		ReadContext:   resourceAppThreeFieldRead,
 		UpdateContext: resourceAppThreeFieldUpdate,
 		DeleteContext: resourceAppThreeFieldDelete,
+		Timeouts:      appTimeouts(),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -59,15 +59,12 @@ func resourceAppThreeField() *schema.Resource {
 				Description: "A regex that further restricts URL to the specified regex",
 			},
 		}),
-	}
+	}, appStepKeys...)
 }
 
 func resourceAppThreeFieldCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := getOktaClientFromMetadata(m)
 	app := buildAppThreeField(d)
-	activate := d.Get("status").(string) == statusActive
-	params := &query.Params{Activate: &activate}
-	_, _, err := client.Application.CreateApplication(ctx, app, params)
+	err := createApp(ctx, d, m, app)
 	if err != nil {
 		return diag.Errorf("failed to create three field application: %v", err)
 	}
@@ -75,13 +72,17 @@ func resourceAppThreeFieldCreate(ctx context.Context, d *schema.ResourceData, m
 	if err != nil {
 		return diag.Errorf("failed to upload logo for three field application: %v", err)
 	}
+	err = handleAppAuthenticationPolicy(ctx, d, m, app.Id)
+	if err != nil {
+		return diag.Errorf("failed to set authentication policy for three field application: %v", err)
+	}
 	d.SetId(app.Id)
 	return resourceAppThreeFieldRead(ctx, d, m)
 }
 
 func resourceAppThreeFieldRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	app := okta.NewSwaThreeFieldApplication()
-	err := fetchApp(ctx, d, m, app)
+	rawApp, err := fetchApp(ctx, d, m, app)
 	if err != nil {
 		return diag.Errorf("failed to get three field application: %v", err)
 	}
@@ -100,14 +101,16 @@ func resourceAppThreeFieldRead(ctx context.Context, d *schema.ResourceData, m in
 	_ = d.Set("user_name_template_type", app.Credentials.UserNameTemplate.Type)
 	_ = d.Set("user_name_template_suffix", app.Credentials.UserNameTemplate.Suffix)
 	_ = d.Set("logo_url", linksValue(app.Links, "logo", "href"))
-	appRead(d, app.Name, app.Status, app.SignOnMode, app.Label, app.Accessibility, app.Visibility)
-	return nil
+	setAppAuthenticationPolicy(d, app.Links)
+	setAppNotes(d, rawApp)
+	diags := appRead(d, app.Name, app.Status, app.SignOnMode, app.Label, app.Accessibility, app.Visibility)
+	return diags
 }
 
 func resourceAppThreeFieldUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := getOktaClientFromMetadata(m)
 	app := buildAppThreeField(d)
-	_, _, err := client.Application.UpdateApplication(ctx, d.Id(), app)
+	err := updateApp(ctx, d, m, app)
 	if err != nil {
 		return diag.Errorf("failed to update three field application: %v", err)
 	}
@@ -123,6 +126,10 @@ func resourceAppThreeFieldUpdate(ctx context.Context, d *schema.ResourceData, m
 			return diag.Errorf("failed to upload logo for three field application: %v", err)
 		}
 	}
+	err = handleAppAuthenticationPolicy(ctx, d, m, app.Id)
+	if err != nil {
+		return diag.Errorf("failed to set authentication policy for three field application: %v", err)
+	}
 	return resourceAppThreeFieldRead(ctx, d, m)
 }
 
@@ -150,6 +157,7 @@ func buildAppThreeField(d *schema.ResourceData) *okta.SwaThreeFieldApplication {
 		},
 	}
 	app.Visibility = buildVisibility(d)
+	app.Accessibility = buildAppAccessibility(d)
 
 	return app
 }
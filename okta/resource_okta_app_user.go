@@ -39,6 +39,7 @@ func resourceAppUser() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
+		CustomizeDiff: validateAppProfileDiff,
 
 		Schema: map[string]*schema.Schema{
 			"app_id": {
@@ -0,0 +1,171 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+const (
+	provisioningEnabled  = "ENABLED"
+	provisioningDisabled = "DISABLED"
+)
+
+func resourceAppUserProvisioning() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAppUserProvisioningCreate,
+		ReadContext:   resourceAppUserProvisioningRead,
+		UpdateContext: resourceAppUserProvisioningUpdate,
+		DeleteContext: resourceAppUserProvisioningDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				_ = d.Set("app_id", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"app_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the application.",
+			},
+			"create_users": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Create users in the application when they are assigned to it in Okta",
+			},
+			"update_user_attributes": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Push profile updates of the assigned users from Okta to the application",
+			},
+			"deactivate_users": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Deactivate users' accounts in the application when they are unassigned from it or deactivated in Okta",
+			},
+			"sync_password": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Push users' passwords to the application",
+			},
+			"password_seed": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "RANDOM",
+				ValidateDiagFunc: elemInSlice([]string{"OKTA", "RANDOM"}),
+				Description:      "Whether to push the Okta password or a random password to the application",
+			},
+			"password_change": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "KEEP_EXISTING",
+				ValidateDiagFunc: elemInSlice([]string{"CHANGE", "KEEP_EXISTING"}),
+				Description:      "Whether to change or keep the existing password of the user in the application",
+			},
+		},
+	}
+}
+
+func resourceAppUserProvisioningCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	_, _, err := getSupplementFromMetadata(m).UpdateApplicationFeature(ctx, d.Get("app_id").(string),
+		sdk.UserProvisioningFeature, buildAppUserProvisioning(d))
+	if err != nil {
+		return diag.Errorf("failed to set user provisioning settings of the application: %v", err)
+	}
+	d.SetId(d.Get("app_id").(string))
+	return resourceAppUserProvisioningRead(ctx, d, m)
+}
+
+func resourceAppUserProvisioningRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	feature, resp, err := getSupplementFromMetadata(m).GetApplicationFeature(ctx, d.Get("app_id").(string), sdk.UserProvisioningFeature)
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get user provisioning settings of the application: %v", err)
+	}
+	if feature == nil || feature.Capabilities == nil {
+		d.SetId("")
+		return nil
+	}
+	if c := feature.Capabilities.Create; c != nil && c.LifecycleCreate != nil {
+		_ = d.Set("create_users", c.LifecycleCreate.Status == provisioningEnabled)
+	}
+	u := feature.Capabilities.Update
+	if u == nil {
+		return nil
+	}
+	if u.Profile != nil {
+		_ = d.Set("update_user_attributes", u.Profile.Status == provisioningEnabled)
+	}
+	if u.LifecycleDeactivate != nil {
+		_ = d.Set("deactivate_users", u.LifecycleDeactivate.Status == provisioningEnabled)
+	}
+	if u.Password != nil {
+		_ = d.Set("sync_password", u.Password.Status == provisioningEnabled)
+		_ = d.Set("password_seed", u.Password.Seed)
+		_ = d.Set("password_change", u.Password.Change)
+	}
+	return nil
+}
+
+func resourceAppUserProvisioningUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	_, _, err := getSupplementFromMetadata(m).UpdateApplicationFeature(ctx, d.Get("app_id").(string),
+		sdk.UserProvisioningFeature, buildAppUserProvisioning(d))
+	if err != nil {
+		return diag.Errorf("failed to update user provisioning settings of the application: %v", err)
+	}
+	return resourceAppUserProvisioningRead(ctx, d, m)
+}
+
+// Provisioning can't be turned off via the API, so on delete all the capabilities are disabled
+func resourceAppUserProvisioningDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	capabilities := sdk.ApplicationCapabilities{
+		Create: &sdk.CapabilitiesCreate{
+			LifecycleCreate: &sdk.LifecycleCreateSetting{Status: provisioningDisabled},
+		},
+		Update: &sdk.CapabilitiesUpdate{
+			LifecycleDeactivate: &sdk.LifecycleDeactivateSetting{Status: provisioningDisabled},
+			Password: &sdk.PasswordSetting{
+				Change: "KEEP_EXISTING",
+				Seed:   "RANDOM",
+				Status: provisioningDisabled,
+			},
+			Profile: &sdk.ProfileSetting{Status: provisioningDisabled},
+		},
+	}
+	_, resp, err := getSupplementFromMetadata(m).UpdateApplicationFeature(ctx, d.Get("app_id").(string), sdk.UserProvisioningFeature, capabilities)
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to disable user provisioning settings of the application: %v", err)
+	}
+	return nil
+}
+
+func buildAppUserProvisioning(d *schema.ResourceData) sdk.ApplicationCapabilities {
+	return sdk.ApplicationCapabilities{
+		Create: &sdk.CapabilitiesCreate{
+			LifecycleCreate: &sdk.LifecycleCreateSetting{Status: enabledStatus(d.Get("create_users").(bool))},
+		},
+		Update: &sdk.CapabilitiesUpdate{
+			LifecycleDeactivate: &sdk.LifecycleDeactivateSetting{Status: enabledStatus(d.Get("deactivate_users").(bool))},
+			Password: &sdk.PasswordSetting{
+				Change: d.Get("password_change").(string),
+				Seed:   d.Get("password_seed").(string),
+				Status: enabledStatus(d.Get("sync_password").(bool)),
+			},
+			Profile: &sdk.ProfileSetting{Status: enabledStatus(d.Get("update_user_attributes").(bool))},
+		},
+	}
+}
+
+func enabledStatus(enabled bool) string {
+	if enabled {
+		return provisioningEnabled
+	}
+	return provisioningDisabled
+}
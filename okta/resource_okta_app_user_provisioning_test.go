@@ -0,0 +1,119 @@
+package okta
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Provisioning can't be enabled via the API, so the test needs an app with provisioning enabled and the API
+// integration configured via the Admin UI, its ID is passed to the fixtures as a variable
+func TestAccAppUserProvisioning_crud(t *testing.T) {
+	appID := os.Getenv("OKTA_PROVISIONING_APP_ID")
+	if appID == "" {
+		t.Skip("OKTA_PROVISIONING_APP_ID must be set to the ID of an app with provisioning enabled to test provisioning settings")
+	}
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appUserProvisioning)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", appUserProvisioning)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			setTestEnv(t, "TF_VAR_app_id", appID)
+		},
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "app_id", appID),
+					resource.TestCheckResourceAttr(resourceName, "create_users", "true"),
+					resource.TestCheckResourceAttr(resourceName, "update_user_attributes", "true"),
+					resource.TestCheckResourceAttr(resourceName, "deactivate_users", "true"),
+					resource.TestCheckResourceAttr(resourceName, "sync_password", "false"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "deactivate_users", "false"),
+					resource.TestCheckResourceAttr(resourceName, "sync_password", "true"),
+					resource.TestCheckResourceAttr(resourceName, "password_seed", "OKTA"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceAppUserProvisioningCreate(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      map[string]interface{}
+		expected string
+	}{
+		{
+			name: "defaults",
+			raw:  map[string]interface{}{"app_id": "0oa1"},
+			expected: `{"create":{"lifecycleCreate":{"status":"DISABLED"}},"update":{` +
+				`"lifecycleDeactivate":{"status":"DISABLED"},` +
+				`"password":{"change":"KEEP_EXISTING","seed":"RANDOM","status":"DISABLED"},` +
+				`"profile":{"status":"DISABLED"}}}`,
+		},
+		{
+			name: "enabled",
+			raw: map[string]interface{}{
+				"app_id":                 "0oa1",
+				"create_users":           true,
+				"update_user_attributes": true,
+				"deactivate_users":       true,
+				"sync_password":          true,
+				"password_seed":          "OKTA",
+				"password_change":        "CHANGE",
+			},
+			expected: `{"create":{"lifecycleCreate":{"status":"ENABLED"}},"update":{` +
+				`"lifecycleDeactivate":{"status":"ENABLED"},` +
+				`"password":{"change":"CHANGE","seed":"OKTA","status":"ENABLED"},` +
+				`"profile":{"status":"ENABLED"}}}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var body []byte
+			m := newTestOktaClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/v1/apps/0oa1/features/USER_PROVISIONING" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				if r.Method == http.MethodPut {
+					raw, _ := ioutil.ReadAll(r.Body)
+					body = bytes.TrimSpace(raw)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"name":"USER_PROVISIONING","status":"ENABLED","capabilities":` + string(body) + `}`))
+			}))
+			d := schema.TestResourceDataRaw(t, resourceAppUserProvisioning().Schema, test.raw)
+			if diags := resourceAppUserProvisioningCreate(context.Background(), d, m); diags.HasError() {
+				t.Fatalf("failed to create user provisioning settings: %v", diags)
+			}
+			if string(body) != test.expected {
+				t.Errorf("expected request body %s, got %s", test.expected, body)
+			}
+			for k, v := range test.raw {
+				if actual := d.Get(k); actual != v {
+					t.Errorf("expected %s to be read as %v, got %v", k, v, actual)
+				}
+			}
+		})
+	}
+}
@@ -75,6 +75,13 @@ func resourceAuthServerCreate(ctx context.Context, d *schema.ResourceData, m int
 		return diag.Errorf("failed to create authorization server: %v", err)
 	}
 	d.SetId(responseAuthServer.Id)
+	if d.Get("status").(string) == statusInactive {
+		// Auth servers are always created active
+		dErr := handleAuthServerLifecycle(ctx, d, m)
+		if dErr != nil {
+			return dErr
+		}
+	}
 	if d.Get("credentials_rotation_mode").(string) == "MANUAL" {
 		// Auth servers can only be set to manual on update. No clue why.
 		dErr := resourceAuthServerUpdate(ctx, d, m)
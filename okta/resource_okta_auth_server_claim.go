@@ -2,6 +2,8 @@ package okta
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -24,6 +26,7 @@ func resourceAuthServerClaim() *schema.Resource {
 			"auth_server_id": {
 				Type:        schema.TypeString,
 				Required:    true,
+				ForceNew:    true,
 				Description: "Auth server ID",
 			},
 			"scopes": {
@@ -64,6 +67,10 @@ func resourceAuthServerClaim() *schema.Resource {
 }
 
 func resourceAuthServerClaimCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	err := validateAuthServerClaim(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	claim := buildAuthServerClaim(d)
 	respClaim, _, err := getOktaClientFromMetadata(m).AuthorizationServer.CreateOAuth2Claim(ctx, d.Get("auth_server_id").(string), claim)
 	if err != nil {
@@ -82,8 +89,10 @@ func resourceAuthServerClaimRead(ctx context.Context, d *schema.ResourceData, m
 		d.SetId("")
 		return nil
 	}
-	if claim.Conditions != nil && len(claim.Conditions.Scopes) > 0 {
+	if claim.Conditions != nil {
 		_ = d.Set("scopes", convertStringSetToInterface(claim.Conditions.Scopes))
+	} else {
+		_ = d.Set("scopes", convertStringSetToInterface([]string{}))
 	}
 	_ = d.Set("name", claim.Name)
 	_ = d.Set("status", claim.Status)
@@ -96,8 +105,12 @@ func resourceAuthServerClaimRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceAuthServerClaimUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	err := validateAuthServerClaim(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	claim := buildAuthServerClaim(d)
-	_, _, err := getOktaClientFromMetadata(m).AuthorizationServer.UpdateOAuth2Claim(ctx, d.Get("auth_server_id").(string), d.Id(), claim)
+	_, _, err = getOktaClientFromMetadata(m).AuthorizationServer.UpdateOAuth2Claim(ctx, d.Get("auth_server_id").(string), d.Id(), claim)
 	if err != nil {
 		return diag.Errorf("failed to update auth server claim: %v", err)
 	}
@@ -129,3 +142,15 @@ func buildAuthServerClaim(d *schema.ResourceData) okta.OAuth2Claim {
 		GroupFilterType:      d.Get("group_filter_type").(string),
 	}
 }
+
+func validateAuthServerClaim(d *schema.ResourceData) error {
+	valueType := d.Get("value_type").(string)
+	groupFilterType := d.Get("group_filter_type").(string)
+	if valueType == "GROUPS" && groupFilterType == "" {
+		return errors.New("'group_filter_type' is required when 'value_type' is 'GROUPS'")
+	}
+	if valueType != "GROUPS" && groupFilterType != "" {
+		return fmt.Errorf("'group_filter_type' can only be set when 'value_type' is 'GROUPS', got '%s'", valueType)
+	}
+	return nil
+}
@@ -91,15 +91,8 @@ func resourceAuthServerClaimDefaultRead(ctx context.Context, d *schema.ResourceD
 		d.SetId("")
 		return nil
 	}
-	if claim.Conditions != nil && len(claim.Conditions.Scopes) > 0 {
-		_ = d.Set("scopes", convertStringSetToInterface(claim.Conditions.Scopes))
-	}
-	_ = d.Set("name", claim.Name)
-	_ = d.Set("status", claim.Status)
+	setAuthServerClaimDefault(d, claim)
 	_ = d.Set("value", claim.Value)
-	_ = d.Set("value_type", claim.ValueType)
-	_ = d.Set("claim_type", claim.ClaimType)
-	_ = d.Set("always_include_in_token", claim.AlwaysIncludeInToken)
 	return nil
 }
 
@@ -110,14 +103,7 @@ func resourceAuthServerClaimDefaultUpdate(ctx context.Context, d *schema.Resourc
 			return diag.FromErr(err)
 		}
 		d.SetId(claim.Id)
-		if claim.Conditions != nil && len(claim.Conditions.Scopes) > 0 {
-			_ = d.Set("scopes", convertStringSetToInterface(claim.Conditions.Scopes))
-		}
-		_ = d.Set("name", claim.Name)
-		_ = d.Set("status", claim.Status)
-		_ = d.Set("value_type", claim.ValueType)
-		_ = d.Set("claim_type", claim.ClaimType)
-		_ = d.Set("always_include_in_token", claim.AlwaysIncludeInToken)
+		setAuthServerClaimDefault(d, claim)
 		if d.Get("name").(string) != "sub" {
 			_ = d.Set("value", claim.Value)
 			return nil // all the values are computed, so just stop here
@@ -142,6 +128,20 @@ func resourceAuthServerClaimDefaultDelete(ctx context.Context, d *schema.Resourc
 	return nil
 }
 
+// setAuthServerClaimDefault sets all the computed attributes of the default claim, 'value' is handled by the caller
+func setAuthServerClaimDefault(d *schema.ResourceData, claim *okta.OAuth2Claim) {
+	if claim.Conditions != nil {
+		_ = d.Set("scopes", convertStringSetToInterface(claim.Conditions.Scopes))
+	} else {
+		_ = d.Set("scopes", convertStringSetToInterface([]string{}))
+	}
+	_ = d.Set("name", claim.Name)
+	_ = d.Set("status", claim.Status)
+	_ = d.Set("value_type", claim.ValueType)
+	_ = d.Set("claim_type", claim.ClaimType)
+	_ = d.Set("always_include_in_token", claim.AlwaysIncludeInToken)
+}
+
 func buildAuthServerClaimDefault(d *schema.ResourceData) okta.OAuth2Claim {
 	return okta.OAuth2Claim{
 		Status:               d.Get("status").(string),
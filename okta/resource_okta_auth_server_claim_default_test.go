@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccOktaAuthServerClaimDefault(t *testing.T) {
@@ -30,3 +31,45 @@ func TestAccOktaAuthServerClaimDefault(t *testing.T) {
 		},
 	})
 }
+
+func TestAccOktaAuthServerClaimDefault_sub(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := fmt.Sprintf("%s.test", authServerClaimDefault)
+	mgr := newFixtureManager(authServerClaimDefault)
+	config := mgr.GetFixtures("sub.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("sub_updated.tf", ri, t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(authServer, authServerExists),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "sub"),
+					resource.TestCheckResourceAttr(resourceName, "value", "(appuser != null) ? appuser.userName : app.clientId"),
+					resource.TestCheckResourceAttr(resourceName, "claim_type", "RESOURCE"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "sub"),
+					resource.TestCheckResourceAttr(resourceName, "value", "(appuser != null) ? appuser.email : app.clientId"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("failed to find %s", resourceName)
+					}
+					return fmt.Sprintf("%s/sub", rs.Primary.Attributes["auth_server_id"]), nil
+				},
+			},
+		},
+	})
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccOktaAuthServerClaim_create(t *testing.T) {
@@ -28,6 +29,7 @@ func TestAccOktaAuthServerClaim_create(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "value_type", "EXPRESSION"),
 					resource.TestCheckResourceAttr(resourceName, "value", "cool"),
 					resource.TestCheckResourceAttr(resourceName, "claim_type", "RESOURCE"),
+					resource.TestCheckResourceAttr(resourceName, "scopes.#", "1"),
 				),
 			},
 			{
@@ -38,8 +40,21 @@ func TestAccOktaAuthServerClaim_create(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "value_type", "EXPRESSION"),
 					resource.TestCheckResourceAttr(resourceName, "value", "cool_updated"),
 					resource.TestCheckResourceAttr(resourceName, "claim_type", "RESOURCE"),
+					resource.TestCheckResourceAttr(resourceName, "scopes.#", "0"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("failed to find %s", resourceName)
+					}
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["auth_server_id"], rs.Primary.ID), nil
+				},
+			},
 		},
 	})
 }
@@ -39,7 +39,7 @@ func resourceAuthServerPolicy() *schema.Resource {
 			"priority": {
 				Type:        schema.TypeInt,
 				Required:    true,
-				Description: "Priority of the auth server policy",
+				Description: "Priority of the auth server policy. To avoid endless diff situation we error if an invalid priority is provided. API defaults it to the last (lowest) if not there.",
 			},
 			"description": {
 				Type:     schema.TypeString,
@@ -56,15 +56,23 @@ func resourceAuthServerPolicy() *schema.Resource {
 }
 
 func resourceAuthServerPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	if d.Get("status").(string) == statusInactive {
-		return diag.Errorf("can not create an inactive auth server policy, only existing ones can be deactivated")
-	}
 	policy := buildAuthServerPolicy(d)
 	respPolicy, _, err := getOktaClientFromMetadata(m).AuthorizationServer.CreateAuthorizationServerPolicy(ctx, d.Get("auth_server_id").(string), policy)
 	if err != nil {
 		return diag.Errorf("failed to create authorization server policy: %v", err)
 	}
+	// Even if priority is invalid we want to add the policy to Terraform to reflect upstream.
 	d.SetId(respPolicy.Id)
+	err = validatePriority(policy.Priority, respPolicy.Priority)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.Get("status").(string) == statusInactive {
+		_, err = getSupplementFromMetadata(m).DeactivateAuthorizationServerPolicy(ctx, d.Get("auth_server_id").(string), d.Id())
+		if err != nil {
+			return diag.Errorf("failed to deactivate authorization server policy: %v", err)
+		}
+	}
 	return resourceAuthServerPolicyRead(ctx, d, m)
 }
 
@@ -81,16 +89,23 @@ func resourceAuthServerPolicyRead(ctx context.Context, d *schema.ResourceData, m
 	_ = d.Set("description", policy.Description)
 	_ = d.Set("status", policy.Status)
 	_ = d.Set("priority", policy.Priority)
-	_ = d.Set("client_whitelist", convertStringSetToInterface(policy.Conditions.Clients.Include))
+	if policy.Conditions != nil && policy.Conditions.Clients != nil {
+		_ = d.Set("client_whitelist", convertStringSetToInterface(policy.Conditions.Clients.Include))
+	}
 	return nil
 }
 
 func resourceAuthServerPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	policy := buildAuthServerPolicy(d)
-	_, _, err := getOktaClientFromMetadata(m).AuthorizationServer.UpdateAuthorizationServerPolicy(ctx, d.Get("auth_server_id").(string), d.Id(), policy)
+	respPolicy, _, err := getOktaClientFromMetadata(m).AuthorizationServer.UpdateAuthorizationServerPolicy(ctx, d.Get("auth_server_id").(string), d.Id(), policy)
 	if err != nil {
 		return diag.Errorf("failed to update auth server policy: %v", err)
 	}
+	// avoiding perpetual diffs by erroring when the configured priority is not valid and the API defaults it.
+	err = validatePriority(policy.Priority, respPolicy.Priority)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	oldStatus, newStatus := d.GetChange("status")
 	if oldStatus != newStatus {
 		if newStatus == statusActive {
@@ -44,7 +44,7 @@ func resourceAuthServerPolicyRule() *schema.Resource {
 			"priority": {
 				Type:        schema.TypeInt,
 				Required:    true,
-				Description: "Priority of the auth server policy rule",
+				Description: "Priority of the auth server policy rule. To avoid endless diff situation we error if an invalid priority is provided. API defaults it to the last (lowest) if not there.",
 			},
 			"grant_type_whitelist": {
 				Type:     schema.TypeSet,
@@ -112,7 +112,7 @@ func resourceAuthServerPolicyRuleCreate(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 	authServerPolicyRule := buildAuthServerPolicyRule(d)
-	responseAuthServerPolicyRule, _, err := getSupplementFromMetadata(m).CreateAuthorizationServerPolicyRule(
+	respRule, _, err := getSupplementFromMetadata(m).CreateAuthorizationServerPolicyRule(
 		ctx,
 		d.Get("auth_server_id").(string),
 		d.Get("policy_id").(string),
@@ -122,7 +122,18 @@ func resourceAuthServerPolicyRuleCreate(ctx context.Context, d *schema.ResourceD
 	if err != nil {
 		return diag.Errorf("failed to create auth server policy rule: %v", err)
 	}
-	d.SetId(responseAuthServerPolicyRule.Id)
+	// We want to put this under Terraform's control even if priority is invalid.
+	d.SetId(respRule.Id)
+	err = validatePriority(int64(authServerPolicyRule.Priority), int64(respRule.Priority))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.Get("status").(string) == statusInactive {
+		dErr := handleAuthServerPolicyRuleLifecycle(ctx, d, m)
+		if dErr != nil {
+			return dErr
+		}
+	}
 	return resourceAuthServerPolicyRuleRead(ctx, d, m)
 }
 
@@ -144,8 +155,16 @@ func resourceAuthServerPolicyRuleRead(ctx context.Context, d *schema.ResourceDat
 	_ = d.Set("status", authServerPolicyRule.Status)
 	_ = d.Set("priority", authServerPolicyRule.Priority)
 	_ = d.Set("type", authServerPolicyRule.Type)
-	if authServerPolicyRule.Actions.Token.InlineHook != nil {
-		_ = d.Set("inline_hook_id", authServerPolicyRule.Actions.Token.InlineHook.Id)
+	if authServerPolicyRule.Actions != nil && authServerPolicyRule.Actions.Token != nil {
+		token := authServerPolicyRule.Actions.Token
+		_ = d.Set("access_token_lifetime_minutes", token.AccessTokenLifetimeMinutes)
+		_ = d.Set("refresh_token_lifetime_minutes", token.RefreshTokenLifetimeMinutes)
+		_ = d.Set("refresh_token_window_minutes", token.RefreshTokenWindowMinutes)
+		if token.InlineHook != nil {
+			_ = d.Set("inline_hook_id", token.InlineHook.Id)
+		} else {
+			_ = d.Set("inline_hook_id", "")
+		}
 	}
 	err = setNonPrimitives(d, map[string]interface{}{
 		"grant_type_whitelist": authServerPolicyRule.Conditions.GrantTypes.Include,
@@ -167,7 +186,7 @@ func resourceAuthServerPolicyRuleUpdate(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 	authServerPolicyRule := buildAuthServerPolicyRule(d)
-	_, _, err = getSupplementFromMetadata(m).UpdateAuthorizationServerPolicyRule(
+	respRule, _, err := getSupplementFromMetadata(m).UpdateAuthorizationServerPolicyRule(
 		ctx,
 		d.Get("auth_server_id").(string),
 		d.Get("policy_id").(string), d.Id(),
@@ -177,6 +196,11 @@ func resourceAuthServerPolicyRuleUpdate(ctx context.Context, d *schema.ResourceD
 	if err != nil {
 		return diag.Errorf("failed to update auth server policy rule: %v", err)
 	}
+	// avoiding perpetual diffs by erroring when the configured priority is not valid and the API defaults it.
+	err = validatePriority(int64(authServerPolicyRule.Priority), int64(respRule.Priority))
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	if d.HasChange("status") {
 		err := handleAuthServerPolicyRuleLifecycle(ctx, d, m)
 		if err != nil {
@@ -192,14 +216,14 @@ func handleAuthServerPolicyRuleLifecycle(ctx context.Context, d *schema.Resource
 		_, err := client.ActivateAuthorizationServerPolicyRule(ctx, d.Get("auth_server_id").(string),
 			d.Get("policy_id").(string), d.Id())
 		if err != nil {
-			return diag.Errorf("failed to activate authorization server: %v", err)
+			return diag.Errorf("failed to activate authorization server policy rule: %v", err)
 		}
 		return nil
 	}
 	_, err := client.DeactivateAuthorizationServerPolicyRule(ctx, d.Get("auth_server_id").(string),
 		d.Get("policy_id").(string), d.Id())
 	if err != nil {
-		return diag.Errorf("failed to deactivate authorization server: %v", err)
+		return diag.Errorf("failed to deactivate authorization server policy rule: %v", err)
 	}
 	return nil
 }
@@ -250,6 +274,9 @@ func buildAuthServerPolicyRule(d *schema.ResourceData) *sdk.AuthorizationServerP
 }
 
 func setPeopleAssignments(d *schema.ResourceData, c *okta.GroupRulePeopleCondition) error {
+	if c == nil {
+		return nil
+	}
 	if c.Groups != nil {
 		err := setNonPrimitives(d, map[string]interface{}{
 			"group_whitelist": convertStringSetToInterface(c.Groups.Include),
@@ -264,6 +291,9 @@ func setPeopleAssignments(d *schema.ResourceData, c *okta.GroupRulePeopleConditi
 			"group_blacklist": convertStringSetToInterface([]string{}),
 		})
 	}
+	if c.Users == nil {
+		return nil
+	}
 	return setNonPrimitives(d, map[string]interface{}{
 		"user_whitelist": convertStringSetToInterface(c.Users.Include),
 		"user_blacklist": convertStringSetToInterface(c.Users.Exclude),
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccOktaAuthServerPolicyRule_create(t *testing.T) {
@@ -37,3 +38,42 @@ func TestAccOktaAuthServerPolicyRule_create(t *testing.T) {
 		},
 	})
 }
+
+func TestAccOktaAuthServerPolicyRule_tokenLifetimes(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := fmt.Sprintf("%s.test", authServerPolicyRule)
+	mgr := newFixtureManager(authServerPolicyRule)
+	config := mgr.GetFixtures("token_lifetimes.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(authServer, authServerExists),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", statusInactive),
+					resource.TestCheckResourceAttr(resourceName, "priority", "1"),
+					resource.TestCheckResourceAttr(resourceName, "grant_type_whitelist.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "scope_whitelist.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access_token_lifetime_minutes", "120"),
+					resource.TestCheckResourceAttr(resourceName, "refresh_token_lifetime_minutes", "1440"),
+					resource.TestCheckResourceAttr(resourceName, "refresh_token_window_minutes", "720"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("failed to find %s", resourceName)
+					}
+					return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["auth_server_id"], rs.Primary.Attributes["policy_id"], rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
@@ -24,6 +24,7 @@ func resourceAuthServerScope() *schema.Resource {
 			"auth_server_id": {
 				Type:        schema.TypeString,
 				Required:    true,
+				ForceNew:    true,
 				Description: "Auth server ID",
 			},
 			"description": {
@@ -36,10 +37,11 @@ func resourceAuthServerScope() *schema.Resource {
 				Description: "Name of the end user displayed in a consent dialog box",
 			},
 			"consent": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "IMPLICIT",
-				Description: "EA Feature and thus it is simply ignored if the feature is off",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "IMPLICIT",
+				Description:      "EA Feature and thus it is simply ignored if the feature is off",
+				ValidateDiagFunc: elemInSlice([]string{"REQUIRED", "IMPLICIT", "FLEXIBLE"}),
 			},
 			"metadata_publish": {
 				Type:             schema.TypeString,
@@ -54,6 +56,11 @@ func resourceAuthServerScope() *schema.Resource {
 				Default:     false,
 				Description: "A default scope will be returned in an access token when the client omits the scope parameter in a token request, provided this scope is allowed as part of the access policy rule.",
 			},
+			"system": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether Okta created the Resource",
+			},
 		},
 	}
 }
@@ -82,6 +89,7 @@ func resourceAuthServerScopeRead(ctx context.Context, d *schema.ResourceData, m
 	_ = d.Set("display_name", scope.DisplayName)
 	_ = d.Set("metadata_publish", scope.MetadataPublish)
 	_ = d.Set("default", scope.Default)
+	_ = d.Set("system", scope.System)
 	if scope.Consent != "" {
 		_ = d.Set("consent", scope.Consent)
 	}
@@ -98,6 +106,10 @@ func resourceAuthServerScopeUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAuthServerScopeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.Get("system").(bool) {
+		logger(m).Warn("cannot delete a system scope, removing it from the state only", "name", d.Get("name").(string))
+		return nil
+	}
 	resp, err := getOktaClientFromMetadata(m).AuthorizationServer.DeleteOAuth2Scope(ctx, d.Get("auth_server_id").(string), d.Id())
 	if err := suppressErrorOn404(resp, err); err != nil {
 		return diag.Errorf("failed to delete auth server scope: %v", err)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccOktaAuthServerScope_crud(t *testing.T) {
@@ -27,6 +28,7 @@ func TestAccOktaAuthServerScope_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "name", "test:something"),
 					resource.TestCheckResourceAttr(resourceName, "description", "test"),
 					resource.TestCheckResourceAttr(resourceName, "display_name", "test"),
+					resource.TestCheckResourceAttr(resourceName, "system", "false"),
 				),
 			},
 			{
@@ -38,6 +40,18 @@ func TestAccOktaAuthServerScope_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "display_name", "test_updated"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("failed to find %s", resourceName)
+					}
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["auth_server_id"], rs.Primary.ID), nil
+				},
+			},
 		},
 	})
 }
@@ -72,6 +72,46 @@ func TestAccOktaAuthServer_crud(t *testing.T) {
 	})
 }
 
+func TestAccOktaAuthServer_status(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := fmt.Sprintf("%s.test", authServer)
+	mgr := newFixtureManager(authServer)
+	config := mgr.GetFixtures("inactive.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("inactive_updated.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(authServer, authServerExists),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, authServerExists),
+					resource.TestCheckResourceAttr(resourceName, "status", statusInactive),
+					resource.TestCheckResourceAttr(resourceName, "issuer_mode", "ORG_URL"),
+					resource.TestCheckResourceAttrSet(resourceName, "issuer"),
+					resource.TestCheckResourceAttrSet(resourceName, "kid"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, authServerExists),
+					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
+					resource.TestCheckResourceAttr(resourceName, "audiences.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Activated authorization server"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccOktaAuthServer_fullStack(t *testing.T) {
 	ri := acctest.RandInt()
 	name := buildResourceName(ri)
@@ -0,0 +1,176 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+var authenticatorKeys = []string{
+	"custom_app", "duo", "external_idp", "google_otp", "okta_email", "okta_password", "okta_verify", "onprem_mfa",
+	"phone_number", "rsa_token", "security_question", "symantec_vip", "webauthn", "yubikey_token",
+}
+
+func resourceAuthenticator() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAuthenticatorCreate,
+		ReadContext:   resourceAuthenticatorRead,
+		UpdateContext: resourceAuthenticatorUpdate,
+		DeleteContext: resourceAuthenticatorDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: elemInSlice(authenticatorKeys),
+				Description:      "A human-readable string that identifies the authenticator.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Display name of the authenticator.",
+			},
+			"status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          statusActive,
+				ValidateDiagFunc: elemInSlice([]string{statusActive, statusInactive}),
+				Description:      "Authenticator status: ACTIVE or INACTIVE",
+			},
+			"settings": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: stringIsJSON,
+				StateFunc:        normalizeDataJSON,
+				DiffSuppressFunc: suppressAuthenticatorSettingsDiff,
+				Description:      "JSON formatted settings of the authenticator, only the listed settings are managed.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of authenticator.",
+			},
+		},
+	}
+}
+
+// Authenticators can not be created, the authenticator with the given key is looked up, and is then updated
+func resourceAuthenticatorCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	key := d.Get("key").(string)
+	authenticators, _, err := getSupplementFromMetadata(m).ListAuthenticators(ctx)
+	if err != nil {
+		return diag.Errorf("failed to list authenticators: %v", err)
+	}
+	for _, authenticator := range authenticators {
+		if authenticator.Key == key {
+			d.SetId(authenticator.ID)
+			return resourceAuthenticatorUpdate(ctx, d, m)
+		}
+	}
+	return diag.Errorf("authenticator with key '%s' is not available in the org", key)
+}
+
+func resourceAuthenticatorRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	authenticator, resp, err := getSupplementFromMetadata(m).GetAuthenticator(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get authenticator: %v", err)
+	}
+	if authenticator == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("key", authenticator.Key)
+	_ = d.Set("name", authenticator.Name)
+	_ = d.Set("status", authenticator.Status)
+	_ = d.Set("type", authenticator.Type)
+	if authenticator.Settings != nil {
+		settings, _ := json.Marshal(authenticator.Settings)
+		_ = d.Set("settings", string(settings))
+	}
+	return nil
+}
+
+func resourceAuthenticatorUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := getSupplementFromMetadata(m)
+	if d.IsNewResource() || d.HasChanges("name", "settings") {
+		authenticator, _, err := client.GetAuthenticator(ctx, d.Id())
+		if err != nil {
+			return diag.Errorf("failed to get authenticator: %v", err)
+		}
+		err = buildAuthenticator(d, authenticator)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		_, _, err = client.UpdateAuthenticator(ctx, d.Id(), *authenticator)
+		if err != nil {
+			return diag.Errorf("failed to update authenticator: %v", err)
+		}
+	}
+	if d.IsNewResource() || d.HasChange("status") {
+		var err error
+		if d.Get("status").(string) == statusActive {
+			_, err = client.ActivateAuthenticator(ctx, d.Id())
+		} else {
+			_, err = client.DeactivateAuthenticator(ctx, d.Id())
+		}
+		if err != nil {
+			return diag.Errorf("failed to change authenticator status: %v", err)
+		}
+	}
+	return resourceAuthenticatorRead(ctx, d, m)
+}
+
+// Authenticators can not be deleted, they are only removed from the state
+func resourceAuthenticatorDelete(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return nil
+}
+
+// buildAuthenticator sets the configured name and settings on the authenticator, the settings which are not
+// configured are kept as they are
+func buildAuthenticator(d *schema.ResourceData, authenticator *sdk.Authenticator) error {
+	if name, ok := d.GetOk("name"); ok {
+		authenticator.Name = name.(string)
+	}
+	rawSettings, ok := d.GetOk("settings")
+	if !ok {
+		return nil
+	}
+	var settings map[string]interface{}
+	if err := json.Unmarshal([]byte(rawSettings.(string)), &settings); err != nil {
+		return fmt.Errorf("failed to parse authenticator settings: %v", err)
+	}
+	if authenticator.Settings == nil {
+		authenticator.Settings = make(map[string]interface{})
+	}
+	for k, v := range settings {
+		authenticator.Settings[k] = v
+	}
+	return nil
+}
+
+// suppressAuthenticatorSettingsDiff ignores the settings that are returned by Okta but are not configured
+func suppressAuthenticatorSettingsDiff(_, old, new string, _ *schema.ResourceData) bool {
+	if new == "" {
+		return true
+	}
+	var oldSettings, newSettings map[string]interface{}
+	if json.Unmarshal([]byte(old), &oldSettings) != nil || json.Unmarshal([]byte(new), &newSettings) != nil {
+		return false
+	}
+	for k, v := range newSettings {
+		if !reflect.DeepEqual(oldSettings[k], v) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,65 @@
+package okta
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaAuthenticator_email(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(authenticator)
+	config := mgr.GetFixtures("email.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("email_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", authenticator)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "key", "okta_email"),
+					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
+					resource.TestCheckResourceAttr(resourceName, "type", "email"),
+					resource.TestCheckResourceAttrSet(resourceName, "name"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "key", "okta_email"),
+					resource.TestMatchResourceAttr(resourceName, "settings", regexp.MustCompile(`"tokenLifetimeInMinutes":15`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestSuppressAuthenticatorSettingsDiff(t *testing.T) {
+	tests := []struct {
+		old      string
+		new      string
+		expected bool
+	}{
+		{`{"allowedFor":"any","tokenLifetimeInMinutes":10}`, ``, true},
+		{`{"allowedFor":"any","tokenLifetimeInMinutes":10}`, `{"tokenLifetimeInMinutes":10}`, true},
+		{`{"allowedFor":"any","tokenLifetimeInMinutes":10}`, `{"tokenLifetimeInMinutes":15}`, false},
+		{`{"allowedFor":"any"}`, `{"allowedFor":"any","tokenLifetimeInMinutes":10}`, false},
+		{``, `{"allowedFor":"any"}`, false},
+	}
+	for i, test := range tests {
+		if actual := suppressAuthenticatorSettingsDiff("settings", test.old, test.new, nil); actual != test.expected {
+			t.Errorf("case %d: expected %v, got %v", i, test.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+const (
+	behaviorAnomalousLocation = "ANOMALOUS_LOCATION"
+	behaviorAnomalousDevice   = "ANOMALOUS_DEVICE"
+	behaviorAnomalousIP       = "ANOMALOUS_IP"
+	behaviorVelocity          = "VELOCITY"
+)
+
+func resourceBehavior() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBehaviorCreate,
+		ReadContext:   resourceBehaviorRead,
+		UpdateContext: resourceBehaviorUpdate,
+		DeleteContext: resourceBehaviorDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the behavior",
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateDiagFunc: elemInSlice([]string{
+					behaviorAnomalousLocation, behaviorAnomalousDevice, behaviorAnomalousIP, behaviorVelocity,
+				}),
+				Description: "Type of the behavior: ANOMALOUS_LOCATION, ANOMALOUS_DEVICE, ANOMALOUS_IP or VELOCITY",
+			},
+			"status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          statusActive,
+				ValidateDiagFunc: elemInSlice([]string{statusActive, statusInactive}),
+				Description:      "Behavior status: ACTIVE or INACTIVE.",
+			},
+			"location_granularity_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: elemInSlice([]string{"CITY", "COUNTRY", "LAT_LONG", "SUBDIVISION"}),
+				Description:      "Granularity of the location the new location is detected with: CITY, COUNTRY, LAT_LONG or SUBDIVISION. Only for ANOMALOUS_LOCATION behaviors",
+			},
+			"radius_from_location": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Radius in kilometers around the known locations, which are not considered new. Only for LAT_LONG location granularity",
+			},
+			"number_of_authentications": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Number of recent authentications used to evaluate the behavior. Not used by VELOCITY behaviors",
+			},
+			"velocity": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Velocity in kilometers per hour, which is considered as the travelling between the locations is impossible. Only for VELOCITY behaviors",
+			},
+		},
+	}
+}
+
+func resourceBehaviorCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	err := validateBehavior(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	behavior, _, err := getSupplementFromMetadata(m).CreateBehavior(ctx, buildBehavior(d))
+	if err != nil {
+		return diag.Errorf("failed to create behavior: %v", err)
+	}
+	d.SetId(behavior.ID)
+	if behavior.Status != d.Get("status").(string) {
+		err = setBehaviorStatus(ctx, d, m)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceBehaviorRead(ctx, d, m)
+}
+
+func resourceBehaviorRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	behavior, resp, err := getSupplementFromMetadata(m).GetBehavior(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get behavior: %v", err)
+	}
+	if behavior == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", behavior.Name)
+	_ = d.Set("type", behavior.Type)
+	_ = d.Set("status", behavior.Status)
+	_ = d.Set("location_granularity_type", behavior.Settings["granularity"])
+	_ = d.Set("radius_from_location", behaviorSettingInt(behavior.Settings, "radiusKilometers"))
+	_ = d.Set("number_of_authentications", behaviorSettingInt(behavior.Settings, "maxEventsUsedForEvaluation"))
+	_ = d.Set("velocity", behaviorSettingInt(behavior.Settings, "velocityKph"))
+	return nil
+}
+
+func resourceBehaviorUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	err := validateBehavior(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_, _, err = getSupplementFromMetadata(m).UpdateBehavior(ctx, d.Id(), buildBehavior(d))
+	if err != nil {
+		return diag.Errorf("failed to update behavior: %v", err)
+	}
+	if d.HasChange("status") {
+		err = setBehaviorStatus(ctx, d, m)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceBehaviorRead(ctx, d, m)
+}
+
+func resourceBehaviorDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	resp, err := getSupplementFromMetadata(m).DeleteBehavior(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to delete behavior: %v", err)
+	}
+	return nil
+}
+
+func buildBehavior(d *schema.ResourceData) sdk.Behavior {
+	behavior := sdk.Behavior{
+		Name:     d.Get("name").(string),
+		Type:     d.Get("type").(string),
+		Settings: make(map[string]interface{}),
+	}
+	if granularity, ok := d.GetOk("location_granularity_type"); ok {
+		behavior.Settings["granularity"] = granularity.(string)
+	}
+	// the values defaulted by Okta are kept in the state, so they are only sent along with the settings they belong to
+	if radius, ok := d.GetOk("radius_from_location"); ok && behavior.Settings["granularity"] == "LAT_LONG" {
+		behavior.Settings["radiusKilometers"] = radius.(int)
+	}
+	if number, ok := d.GetOk("number_of_authentications"); ok && behavior.Type != behaviorVelocity {
+		behavior.Settings["maxEventsUsedForEvaluation"] = number.(int)
+	}
+	if velocity, ok := d.GetOk("velocity"); ok {
+		behavior.Settings["velocityKph"] = velocity.(int)
+	}
+	return behavior
+}
+
+func setBehaviorStatus(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	var err error
+	if d.Get("status").(string) == statusActive {
+		_, err = getSupplementFromMetadata(m).ActivateBehavior(ctx, d.Id())
+	} else {
+		_, err = getSupplementFromMetadata(m).DeactivateBehavior(ctx, d.Id())
+	}
+	if err != nil {
+		return fmt.Errorf("failed to change behavior status: %v", err)
+	}
+	return nil
+}
+
+func validateBehavior(d *schema.ResourceData) error {
+	behaviorType := d.Get("type").(string)
+	granularity := d.Get("location_granularity_type").(string)
+	switch {
+	case behaviorType == behaviorAnomalousLocation && granularity == "":
+		return fmt.Errorf("'location_granularity_type' should be set for the %s behaviors", behaviorAnomalousLocation)
+	case behaviorType != behaviorAnomalousLocation && granularity != "":
+		return fmt.Errorf("'location_granularity_type' can only be set for the %s behaviors", behaviorAnomalousLocation)
+	case granularity == "LAT_LONG" && d.Get("radius_from_location").(int) == 0:
+		return fmt.Errorf("'radius_from_location' should be set when 'location_granularity_type' is LAT_LONG")
+	case granularity != "LAT_LONG" && d.HasChange("radius_from_location") && d.Get("radius_from_location").(int) != 0:
+		return fmt.Errorf("'radius_from_location' can only be set when 'location_granularity_type' is LAT_LONG")
+	case behaviorType == behaviorVelocity && d.Get("velocity").(int) == 0:
+		return fmt.Errorf("'velocity' should be set for the %s behaviors", behaviorVelocity)
+	case behaviorType != behaviorVelocity && d.Get("velocity").(int) != 0:
+		return fmt.Errorf("'velocity' can only be set for the %s behaviors", behaviorVelocity)
+	case behaviorType == behaviorVelocity && d.HasChange("number_of_authentications") && d.Get("number_of_authentications").(int) != 0:
+		return fmt.Errorf("'number_of_authentications' can not be set for the %s behaviors", behaviorVelocity)
+	}
+	return nil
+}
+
+func behaviorSettingInt(settings map[string]interface{}, key string) int {
+	if v, ok := settings[key].(float64); ok {
+		return int(v)
+	}
+	return 0
+}
@@ -0,0 +1,86 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func sweepBehaviors(client *testClient) error {
+	var errorList []error
+	behaviors, _, err := client.apiSupplement.ListBehaviors(context.Background(), nil)
+	if err != nil {
+		return err
+	}
+	for _, behavior := range behaviors {
+		if strings.HasPrefix(behavior.Name, testResourcePrefix) {
+			if _, err := client.apiSupplement.DeleteBehavior(context.Background(), behavior.ID); err != nil {
+				errorList = append(errorList, err)
+			}
+		}
+	}
+	return condenseError(errorList)
+}
+
+func TestAccOktaBehavior_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(behavior)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+	locationName := fmt.Sprintf("%s.my_location", behavior)
+	deviceName := fmt.Sprintf("%s.my_device", behavior)
+	ipName := fmt.Sprintf("%s.my_ip", behavior)
+	velocityName := fmt.Sprintf("%s.my_velocity", behavior)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(behavior, doesBehaviorExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(locationName, "name", fmt.Sprintf("%s Location", buildResourceName(ri))),
+					resource.TestCheckResourceAttr(locationName, "type", behaviorAnomalousLocation),
+					resource.TestCheckResourceAttr(locationName, "status", statusActive),
+					resource.TestCheckResourceAttr(locationName, "number_of_authentications", "50"),
+					resource.TestCheckResourceAttr(locationName, "location_granularity_type", "LAT_LONG"),
+					resource.TestCheckResourceAttr(locationName, "radius_from_location", "20"),
+					resource.TestCheckResourceAttr(deviceName, "type", behaviorAnomalousDevice),
+					resource.TestCheckResourceAttr(deviceName, "number_of_authentications", "50"),
+					resource.TestCheckResourceAttr(ipName, "type", behaviorAnomalousIP),
+					resource.TestCheckResourceAttr(ipName, "number_of_authentications", "50"),
+					resource.TestCheckResourceAttr(velocityName, "type", behaviorVelocity),
+					resource.TestCheckResourceAttr(velocityName, "velocity", "25"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(locationName, "name", fmt.Sprintf("%s Location Updated", buildResourceName(ri))),
+					resource.TestCheckResourceAttr(locationName, "status", statusInactive),
+					resource.TestCheckResourceAttr(locationName, "number_of_authentications", "20"),
+					resource.TestCheckResourceAttr(locationName, "location_granularity_type", "COUNTRY"),
+					resource.TestCheckResourceAttr(locationName, "radius_from_location", "0"),
+					resource.TestCheckResourceAttr(deviceName, "number_of_authentications", "30"),
+					resource.TestCheckResourceAttr(ipName, "status", statusInactive),
+					resource.TestCheckResourceAttr(velocityName, "velocity", "40"),
+				),
+			},
+			{
+				ResourceName:      locationName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func doesBehaviorExist(id string) (bool, error) {
+	_, response, err := getSupplementFromMetadata(testAccProvider.Meta()).GetBehavior(context.Background(), id)
+	return doesResourceExist(response, err)
+}
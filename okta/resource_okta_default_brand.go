@@ -0,0 +1,85 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourceDefaultBrand() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDefaultBrandCreateOrUpdate,
+		ReadContext:   resourceDefaultBrandRead,
+		UpdateContext: resourceDefaultBrandCreateOrUpdate,
+		DeleteContext: resourceDefaultBrandDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				brand, _, err := getSupplementFromMetadata(m).GetDefaultBrand(ctx)
+				if err != nil {
+					return nil, err
+				}
+				d.SetId(brand.ID)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"custom_privacy_policy_url": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringIsURL(validURLSchemes...),
+				Description:      "Custom privacy policy URL. Setting it implies the agreement to the custom privacy policy",
+			},
+			"remove_powered_by_okta": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Remove 'Powered by Okta' from the Okta-hosted sign-in page",
+			},
+		},
+	}
+}
+
+func resourceDefaultBrandCreateOrUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	if id == "" {
+		brand, _, err := getSupplementFromMetadata(m).GetDefaultBrand(ctx)
+		if err != nil {
+			return diag.Errorf("failed to get default brand: %v", err)
+		}
+		id = brand.ID
+	}
+	url := d.Get("custom_privacy_policy_url").(string)
+	_, _, err := getSupplementFromMetadata(m).UpdateBrand(ctx, id, sdk.Brand{
+		AgreeToCustomPrivacyPolicy: url != "",
+		CustomPrivacyPolicyURL:     url,
+		RemovePoweredByOkta:        d.Get("remove_powered_by_okta").(bool),
+	})
+	if err != nil {
+		return diag.Errorf("failed to update default brand: %v", err)
+	}
+	d.SetId(id)
+	return resourceDefaultBrandRead(ctx, d, m)
+}
+
+func resourceDefaultBrandRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	brands, _, err := getSupplementFromMetadata(m).ListBrands(ctx)
+	if err != nil {
+		return diag.Errorf("failed to list brands: %v", err)
+	}
+	for _, brand := range brands {
+		if brand.ID == d.Id() {
+			_ = d.Set("custom_privacy_policy_url", brand.CustomPrivacyPolicyURL)
+			_ = d.Set("remove_powered_by_okta", brand.RemovePoweredByOkta)
+			return nil
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+// Default brand can not be removed
+func resourceDefaultBrandDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return nil
+}
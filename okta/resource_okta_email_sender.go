@@ -0,0 +1,130 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourceEmailSender() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEmailSenderCreate,
+		ReadContext:   resourceEmailSenderRead,
+		DeleteContext: resourceEmailSenderDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"from_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of sender.",
+			},
+			"from_address": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: stringIsEmail,
+				Description:      "Email address to send from.",
+			},
+			"subdomain": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Mail domain to send from.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Verification status.",
+			},
+			"dns_records": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "TXT and CNAME records to be registered for the domain.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"fqdn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "DNS record name.",
+						},
+						"record_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Record type can be TXT or CNAME.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "DNS verification value.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceEmailSenderCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sender, _, err := getSupplementFromMetadata(m).CreateEmailSender(ctx, buildEmailSender(d))
+	if err != nil {
+		return diag.Errorf("failed to create custom email sender: %v", err)
+	}
+	d.SetId(sender.ID)
+	return resourceEmailSenderRead(ctx, d, m)
+}
+
+func resourceEmailSenderRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sender, resp, err := getSupplementFromMetadata(m).GetEmailSender(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get custom email sender: %v", err)
+	}
+	if sender == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("from_name", sender.FromName)
+	_ = d.Set("from_address", sender.FromAddress)
+	_ = d.Set("subdomain", sender.Subdomain)
+	_ = d.Set("status", sender.Status)
+	err = setNonPrimitives(d, map[string]interface{}{
+		"dns_records": flattenEmailSenderDNSRecords(sender.DNSRecords),
+	})
+	if err != nil {
+		return diag.Errorf("failed to set custom email sender properties: %v", err)
+	}
+	return nil
+}
+
+// Okta does not delete custom email senders, they are disabled instead, so the emails are sent from the Okta domain again
+func resourceEmailSenderDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	resp, err := getSupplementFromMetadata(m).DisableEmailSender(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to disable custom email sender: %v", err)
+	}
+	return nil
+}
+
+func buildEmailSender(d *schema.ResourceData) sdk.EmailSender {
+	return sdk.EmailSender{
+		FromName:    d.Get("from_name").(string),
+		FromAddress: d.Get("from_address").(string),
+		Subdomain:   d.Get("subdomain").(string),
+	}
+}
+
+func flattenEmailSenderDNSRecords(records []*sdk.EmailSenderDNSRecord) []interface{} {
+	arr := make([]interface{}, len(records))
+	for i := range records {
+		arr[i] = map[string]interface{}{
+			"fqdn":        records[i].Fqdn,
+			"record_type": records[i].RecordType,
+			"value":       records[i].Value,
+		}
+	}
+	return arr
+}
@@ -0,0 +1,69 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaEmailSender_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := fmt.Sprintf("%s.test", emailSender)
+	mgr := newFixtureManager(emailSender)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(emailSender, doesEmailSenderExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, doesEmailSenderExist),
+					resource.TestCheckResourceAttr(resourceName, "from_name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "from_address", "no-reply@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "subdomain", "mail"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					resource.TestCheckResourceAttrSet(resourceName, "dns_records.0.fqdn"),
+					resource.TestCheckResourceAttrSet(resourceName, "dns_records.0.record_type"),
+					resource.TestCheckResourceAttrSet(resourceName, "dns_records.0.value"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// The DNS records of the test sender are never registered, so this only makes sure the failed verification is
+// surfaced and does not end up in the state.
+func TestAccOktaEmailSenderVerification_unregisteredDNS(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(emailSenderVerification)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(emailSender, doesEmailSenderExist),
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`failed to verify custom email sender|custom email sender .* was not verified`),
+			},
+		},
+	})
+}
+
+func doesEmailSenderExist(id string) (bool, error) {
+	_, response, err := getSupplementFromMetadata(testAccProvider.Meta()).GetEmailSender(context.Background(), id)
+	return doesResourceExist(response, err)
+}
@@ -0,0 +1,77 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const emailSenderVerified = "VERIFIED"
+
+func resourceEmailSenderVerification() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEmailSenderVerificationCreate,
+		ReadContext:   resourceEmailSenderVerificationRead,
+		DeleteContext: resourceEmailSenderVerificationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				_ = d.Set("sender_id", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Description: "Verifies a custom email sender once its DNS records are registered for the domain.",
+		Schema: map[string]*schema.Schema{
+			"sender_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the custom email sender to verify.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Verification status of the custom email sender.",
+			},
+		},
+	}
+}
+
+func resourceEmailSenderVerificationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	senderID := d.Get("sender_id").(string)
+	sender, _, err := getSupplementFromMetadata(m).VerifyEmailSender(ctx, senderID)
+	if err != nil {
+		return diag.Errorf("failed to verify custom email sender: %v", err)
+	}
+	if sender.Status != emailSenderVerified {
+		return diag.Errorf("custom email sender '%s' was not verified, status is '%s', make sure its DNS records are registered", senderID, sender.Status)
+	}
+	d.SetId(senderID)
+	return resourceEmailSenderVerificationRead(ctx, d, m)
+}
+
+func resourceEmailSenderVerificationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sender, resp, err := getSupplementFromMetadata(m).GetEmailSender(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get custom email sender: %v", err)
+	}
+	if sender == nil {
+		d.SetId("")
+		return nil
+	}
+	// Okta only sends from the custom domain while the sender is verified, so a sender in any other status is
+	// dropped from the state and the next apply runs the verification again
+	if sender.Status != emailSenderVerified {
+		logger(m).Info("custom email sender is no longer verified", "id", d.Id(), "status", sender.Status)
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("sender_id", sender.ID)
+	_ = d.Set("status", sender.Status)
+	return nil
+}
+
+// the verification can't be undone, the sender has to be disabled instead, so this only removes it from the state
+func resourceEmailSenderVerificationDelete(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return nil
+}
@@ -0,0 +1,122 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourceEmailSMTPServer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEmailSMTPServerCreate,
+		ReadContext:   resourceEmailSMTPServerRead,
+		UpdateContext: resourceEmailSMTPServerUpdate,
+		DeleteContext: resourceEmailSMTPServerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"alias": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Human-readable name of the SMTP server.",
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Host name of the SMTP server.",
+			},
+			"port": {
+				Type:             schema.TypeInt,
+				Required:         true,
+				ValidateDiagFunc: intBetween(1, 65535),
+				Description:      "Port of the SMTP server.",
+			},
+			"encryption": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "STARTTLS",
+				ValidateDiagFunc: elemInSlice([]string{"STARTTLS", "TLS"}),
+				Description:      "How the connection to the SMTP server is secured: upgraded with STARTTLS or TLS from the start.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Username used to authenticate with the SMTP server.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Password used to authenticate with the SMTP server. Okta never returns it, so its changes outside of Terraform are not detected.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the emails of the org are relayed through the SMTP server.",
+			},
+		},
+	}
+}
+
+func resourceEmailSMTPServerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	server, _, err := getSupplementFromMetadata(m).CreateEmailSMTPServer(ctx, buildEmailSMTPServer(d))
+	if err != nil {
+		return diag.Errorf("failed to create custom SMTP server: %v", err)
+	}
+	d.SetId(server.ID)
+	return resourceEmailSMTPServerRead(ctx, d, m)
+}
+
+func resourceEmailSMTPServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	server, resp, err := getSupplementFromMetadata(m).GetEmailSMTPServer(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get custom SMTP server: %v", err)
+	}
+	if server == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("alias", server.Alias)
+	_ = d.Set("host", server.Host)
+	_ = d.Set("port", server.Port)
+	if server.Encryption != "" {
+		_ = d.Set("encryption", server.Encryption)
+	}
+	_ = d.Set("username", server.Username)
+	if server.Enabled != nil {
+		_ = d.Set("enabled", *server.Enabled)
+	}
+	return nil
+}
+
+func resourceEmailSMTPServerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	_, _, err := getSupplementFromMetadata(m).UpdateEmailSMTPServer(ctx, d.Id(), buildEmailSMTPServer(d))
+	if err != nil {
+		return diag.Errorf("failed to update custom SMTP server: %v", err)
+	}
+	return resourceEmailSMTPServerRead(ctx, d, m)
+}
+
+func resourceEmailSMTPServerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	resp, err := getSupplementFromMetadata(m).DeleteEmailSMTPServer(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to delete custom SMTP server: %v", err)
+	}
+	return nil
+}
+
+func buildEmailSMTPServer(d *schema.ResourceData) sdk.EmailSMTPServer {
+	return sdk.EmailSMTPServer{
+		Alias:      d.Get("alias").(string),
+		Host:       d.Get("host").(string),
+		Port:       d.Get("port").(int),
+		Username:   d.Get("username").(string),
+		Password:   d.Get("password").(string),
+		Enabled:    boolPtr(d.Get("enabled").(bool)),
+		Encryption: d.Get("encryption").(string),
+	}
+}
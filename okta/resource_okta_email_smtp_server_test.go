@@ -0,0 +1,60 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaEmailSMTPServer_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := fmt.Sprintf("%s.test", emailSMTPServer)
+	mgr := newFixtureManager(emailSMTPServer)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(emailSMTPServer, doesEmailSMTPServerExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, doesEmailSMTPServerExist),
+					resource.TestCheckResourceAttr(resourceName, "alias", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "host", "smtp.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "port", "587"),
+					resource.TestCheckResourceAttr(resourceName, "encryption", "STARTTLS"),
+					resource.TestCheckResourceAttr(resourceName, "username", "okta"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, doesEmailSMTPServerExist),
+					resource.TestCheckResourceAttr(resourceName, "alias", fmt.Sprintf("testAccUpdated_%d", ri)),
+					resource.TestCheckResourceAttr(resourceName, "host", "relay.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "port", "465"),
+					resource.TestCheckResourceAttr(resourceName, "encryption", "TLS"),
+					resource.TestCheckResourceAttr(resourceName, "username", "okta-relay"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func doesEmailSMTPServerExist(id string) (bool, error) {
+	_, response, err := getSupplementFromMetadata(testAccProvider.Meta()).GetEmailSMTPServer(context.Background(), id)
+	return doesResourceExist(response, err)
+}
@@ -0,0 +1,75 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceEventHookVerification() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEventHookVerificationCreate,
+		ReadContext:   resourceEventHookVerificationRead,
+		DeleteContext: resourceEventHookVerificationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				_ = d.Set("event_hook_id", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Description: "Verifies an event hook once its receiving endpoint is able to answer the one-time verification request.",
+		Schema: map[string]*schema.Schema{
+			"event_hook_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the event hook to verify.",
+			},
+			"verification_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Verification status of the event hook.",
+			},
+		},
+	}
+}
+
+func resourceEventHookVerificationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	hookID := d.Get("event_hook_id").(string)
+	hook, _, err := getOktaClientFromMetadata(m).EventHook.VerifyEventHook(ctx, hookID)
+	if err != nil {
+		return diag.Errorf("failed to verify event hook: %v", err)
+	}
+	if hook.VerificationStatus != "VERIFIED" {
+		return diag.Errorf("event hook '%s' was not verified, verification status is '%s'", hookID, hook.VerificationStatus)
+	}
+	d.SetId(hookID)
+	return resourceEventHookVerificationRead(ctx, d, m)
+}
+
+func resourceEventHookVerificationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	hook, resp, err := getOktaClientFromMetadata(m).EventHook.GetEventHook(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get event hook: %v", err)
+	}
+	if hook == nil {
+		d.SetId("")
+		return nil
+	}
+	// a hook which is no longer verified (e.g. its channel was changed) is removed from the state,
+	// so the verification is triggered again on the next apply
+	if hook.VerificationStatus != "VERIFIED" {
+		logger(m).Info("event hook is no longer verified", "id", d.Id(), "verification_status", hook.VerificationStatus)
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("event_hook_id", hook.Id)
+	_ = d.Set("verification_status", hook.VerificationStatus)
+	return nil
+}
+
+// Okta has no way to "unverify" an event hook, so the resource is only removed from the state
+func resourceEventHookVerificationDelete(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return nil
+}
@@ -0,0 +1,29 @@
+package okta
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// The endpoint of the test hook does not answer the verification request, so this only makes sure the failed
+// verification is surfaced and does not end up in the state.
+func TestAccOktaEventHookVerification_unreachable(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(eventHookVerification)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(eventHook, eventHookExists),
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`failed to verify event hook`),
+			},
+		},
+	})
+}
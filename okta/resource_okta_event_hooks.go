@@ -7,8 +7,24 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/terraform-provider-okta/sdk"
 )
 
+var eventHookFilterSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"event": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Event type the condition applies to",
+		},
+		"expression": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Okta Expression Language condition",
+		},
+	},
+}
+
 func resourceEventHook() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceEventHookCreate,
@@ -34,6 +50,12 @@ func resourceEventHook() *schema.Resource {
 				Optional: true,
 				Elem:     headerSchema,
 			},
+			"filter": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Okta Expression Language conditions which the events have to match in order to be delivered to the hook",
+				Elem:        eventHookFilterSchema,
+			},
 			"auth": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -105,7 +127,7 @@ func resourceEventHook() *schema.Resource {
 func resourceEventHookCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := getOktaClientFromMetadata(m)
 	hook := buildEventHook(d)
-	newHook, _, err := client.EventHook.CreateEventHook(ctx, *hook)
+	newHook, _, err := getSupplementFromMetadata(m).CreateEventHook(ctx, *hook)
 	if err != nil {
 		return diag.Errorf("failed to create event hook: %v", err)
 	}
@@ -118,7 +140,7 @@ func resourceEventHookCreate(ctx context.Context, d *schema.ResourceData, m inte
 }
 
 func resourceEventHookRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	hook, resp, err := getOktaClientFromMetadata(m).EventHook.GetEventHook(ctx, d.Id())
+	hook, resp, err := getSupplementFromMetadata(m).GetEventHook(ctx, d.Id())
 	if err := suppressErrorOn404(resp, err); err != nil {
 		return diag.Errorf("failed to get event hook: %v", err)
 	}
@@ -128,7 +150,7 @@ func resourceEventHookRead(ctx context.Context, d *schema.ResourceData, m interf
 	}
 	_ = d.Set("name", hook.Name)
 	_ = d.Set("status", hook.Status)
-	_ = d.Set("events", eventSet(hook.Events))
+	_ = d.Set("events", eventSet(&hook.Events.EventSubscriptions))
 	err = setNonPrimitives(d, map[string]interface{}{
 		"channel": flattenEventHookChannel(hook.Channel),
 		"headers": flattenEventHookHeaders(hook.Channel),
@@ -137,13 +159,14 @@ func resourceEventHookRead(ctx context.Context, d *schema.ResourceData, m interf
 	if err != nil {
 		return diag.Errorf("failed to set event hook properties: %v", err)
 	}
+	_ = d.Set("filter", flattenEventHookFilter(hook.Events.Filter))
 	return nil
 }
 
 func resourceEventHookUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := getOktaClientFromMetadata(m)
 	hook := buildEventHook(d)
-	newHook, _, err := client.EventHook.UpdateEventHook(ctx, d.Id(), *hook)
+	newHook, _, err := getSupplementFromMetadata(m).UpdateEventHook(ctx, d.Id(), *hook)
 	if err != nil {
 		return diag.Errorf("failed to update auth event hook: %v", err)
 	}
@@ -168,17 +191,22 @@ func resourceEventHookDelete(ctx context.Context, d *schema.ResourceData, m inte
 	return nil
 }
 
-func buildEventHook(d *schema.ResourceData) *okta.EventHook {
+func buildEventHook(d *schema.ResourceData) *sdk.EventHook {
 	eventSet := d.Get("events").(*schema.Set).List()
 	events := make([]string, len(eventSet))
 	for i, v := range eventSet {
 		events[i] = v.(string)
 	}
-	return &okta.EventHook{
-		Name:    d.Get("name").(string),
-		Status:  d.Get("status").(string),
-		Events:  &okta.EventSubscriptions{Type: "EVENT_TYPE", Items: events},
-		Channel: buildEventChannel(d),
+	return &sdk.EventHook{
+		EventHook: okta.EventHook{
+			Name:    d.Get("name").(string),
+			Status:  d.Get("status").(string),
+			Channel: buildEventChannel(d),
+		},
+		Events: &sdk.EventSubscriptions{
+			EventSubscriptions: okta.EventSubscriptions{Type: "EVENT_TYPE", Items: events},
+			Filter:             buildEventHookFilter(d),
+		},
 	}
 }
 
@@ -221,6 +249,38 @@ func buildEventChannel(d *schema.ResourceData) *okta.EventHookChannel {
 	}
 }
 
+func buildEventHookFilter(d *schema.ResourceData) *sdk.EventHookFilter {
+	rawFilters := d.Get("filter").(*schema.Set).List()
+	if len(rawFilters) == 0 {
+		return nil
+	}
+	filter := &sdk.EventHookFilter{Type: "EXPRESSION_LANGUAGE"}
+	for _, raw := range rawFilters {
+		f := raw.(map[string]interface{})
+		filter.EventFilterMap = append(filter.EventFilterMap, &sdk.EventHookFilterMap{
+			Event:     f["event"].(string),
+			Condition: &sdk.EventHookFilterCondition{Expression: f["expression"].(string)},
+		})
+	}
+	return filter
+}
+
+func flattenEventHookFilter(filter *sdk.EventHookFilter) *schema.Set {
+	var filters []interface{}
+	if filter != nil {
+		for _, f := range filter.EventFilterMap {
+			if f.Condition == nil {
+				continue
+			}
+			filters = append(filters, map[string]interface{}{
+				"event":      f.Event,
+				"expression": f.Condition.Expression,
+			})
+		}
+	}
+	return schema.NewSet(schema.HashResource(eventHookFilterSchema), filters)
+}
+
 func flattenEventHookAuth(d *schema.ResourceData, c *okta.EventHookChannel) map[string]interface{} {
 	auth := map[string]interface{}{}
 	if c.Config.AuthScheme != nil {
@@ -112,6 +112,40 @@ func TestAccOktaEventHook_crud(t *testing.T) {
 	})
 }
 
+func TestAccOktaEventHook_filter(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := "okta_event_hook.test"
+	mgr := newFixtureManager(eventHook)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	filteredConfig := mgr.GetFixtures("filtered.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(eventHook, eventHookExists),
+		Steps: []resource.TestStep{
+			{
+				Config: filteredConfig,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, eventHookExists),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filter.*", map[string]string{
+						"event":      "group.user_membership.add",
+						"expression": "event.target.?[type eq 'UserGroup'].size()>0 && event.target.?[displayName eq 'Sales'].size()>0",
+					}),
+				),
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, eventHookExists),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func eventHookExists(id string) (bool, error) {
 	eh, resp, err := getOktaClientFromMetadata(testAccProvider.Meta()).EventHook.GetEventHook(context.Background(), id)
 	if err := suppressErrorOn404(resp, err); err != nil {
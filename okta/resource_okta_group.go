@@ -2,11 +2,17 @@ package okta
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+	"github.com/okta/terraform-provider-okta/sdk"
 )
 
 func resourceGroup() *schema.Resource {
@@ -17,6 +23,17 @@ func resourceGroup() *schema.Resource {
 		DeleteContext: resourceGroupDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				_, resp, err := getOktaClientFromMetadata(m).Group.GetGroup(ctx, d.Id())
+				if is404(resp) {
+					// the group is imported by name
+					id, err := getGroupIDByName(ctx, m, d.Id())
+					if err != nil {
+						return nil, err
+					}
+					d.SetId(id)
+				} else if err != nil {
+					return nil, fmt.Errorf("failed to get group: %v", err)
+				}
 				userIDList, err := listGroupUserIDs(ctx, m, d.Id())
 				if err != nil {
 					return nil, err
@@ -25,6 +42,7 @@ func resourceGroup() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
+		CustomizeDiff: validateGroupNameIsUnique,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -36,6 +54,15 @@ func resourceGroup() *schema.Resource {
 				Optional:    true,
 				Description: "Group description",
 			},
+			// the attributes are kept as they are when they are not in the config
+			"custom_profile_attributes": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: stringIsJSON,
+				StateFunc:        normalizeDataJSON,
+				Description:      "JSON formatted custom attributes for a group. It must be JSON due to various types Okta allows.",
+			},
 			"users": {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -49,12 +76,15 @@ func resourceGroup() *schema.Resource {
 
 func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	logger(m).Info("creating group", "name", d.Get("name").(string))
-	group := buildGroup(d)
-	responseGroup, _, err := getOktaClientFromMetadata(m).Group.CreateGroup(ctx, *group)
+	group, err := buildGroupWithSchemaTypes(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	responseGroup, _, err := getSupplementFromMetadata(m).CreateGroup(ctx, *group)
 	if err != nil {
 		return diag.Errorf("failed to create group: %v", err)
 	}
-	d.SetId(responseGroup.Id)
+	d.SetId(responseGroup.ID)
 	err = updateGroupUsers(ctx, d, m)
 	if err != nil {
 		return diag.Errorf("failed to update group users on group create: %v", err)
@@ -64,7 +94,7 @@ func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, m interfac
 
 func resourceGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	logger(m).Info("reading group", "id", d.Id(), "name", d.Get("name").(string))
-	g, resp, err := getOktaClientFromMetadata(m).Group.GetGroup(ctx, d.Id())
+	g, resp, err := getSupplementFromMetadata(m).GetGroup(ctx, d.Id())
 	if err := suppressErrorOn404(resp, err); err != nil {
 		return diag.Errorf("failed to get group: %v", err)
 	}
@@ -72,25 +102,56 @@ func resourceGroupRead(ctx context.Context, d *schema.ResourceData, m interface{
 		d.SetId("")
 		return nil
 	}
-	_ = d.Set("name", g.Profile.Name)
-	_ = d.Set("description", g.Profile.Description)
-	err = syncGroupUsers(ctx, d, m)
-	if err != nil {
-		return diag.Errorf("failed to get group users: %v", err)
+	// the numbers and booleans configured as strings are kept as strings, so they don't show up as changes
+	var configured map[string]interface{}
+	_ = json.Unmarshal([]byte(d.Get("custom_profile_attributes").(string)), &configured)
+	customAttributes := make(map[string]interface{})
+	for k, v := range g.Profile {
+		switch k {
+		case "name":
+			_ = d.Set("name", v)
+		case "description":
+			_ = d.Set("description", v)
+		default:
+			if v == nil {
+				continue
+			}
+			if str, ok := configured[k].(string); ok && str == fmt.Sprint(v) {
+				v = str
+			}
+			customAttributes[k] = v
+		}
+	}
+	data, _ := json.Marshal(customAttributes)
+	_ = d.Set("custom_profile_attributes", string(data))
+	// Only sync when it is outlined, so that the membership endpoints are not used needlessly
+	if _, exists := d.GetOk("users"); exists {
+		err = syncGroupUsers(ctx, d, m)
+		if err != nil {
+			return diag.Errorf("failed to get group users: %v", err)
+		}
 	}
 	return nil
 }
 
 func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	logger(m).Info("updating group", "id", d.Id(), "name", d.Get("name").(string))
-	group := buildGroup(d)
-	_, _, err := getOktaClientFromMetadata(m).Group.UpdateGroup(ctx, d.Id(), *group)
-	if err != nil {
-		return diag.Errorf("failed to update group: %v", err)
+	if d.HasChange("name") || d.HasChange("description") || d.HasChange("custom_profile_attributes") {
+		group, err := buildGroupWithSchemaTypes(ctx, d, m)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		_, _, err = getSupplementFromMetadata(m).UpdateGroup(ctx, d.Id(), *group)
+		if err != nil {
+			return diag.Errorf("failed to update group: %v", err)
+		}
 	}
-	err = updateGroupUsers(ctx, d, m)
-	if err != nil {
-		return diag.Errorf("failed to update group users on group update: %v", err)
+	// membership is not touched when only the profile of the group was changed
+	if d.HasChange("users") {
+		err := updateGroupUsers(ctx, d, m)
+		if err != nil {
+			return diag.Errorf("failed to update group users on group update: %v", err)
+		}
 	}
 	return resourceGroupRead(ctx, d, m)
 }
@@ -104,6 +165,56 @@ func resourceGroupDelete(ctx context.Context, d *schema.ResourceData, m interfac
 	return nil
 }
 
+// validateGroupNameIsUnique looks for other groups with the new name of the group during the plan. Okta rejects
+// the name of another Okta group, while the name collisions with app groups are only reported as warnings in the logs.
+func validateGroupNameIsUnique(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.HasChange("name") || !d.NewValueKnown("name") {
+		return nil
+	}
+	name := d.Get("name").(string)
+	groups, err := listGroupsByExactName(ctx, m, name, &query.Params{Q: name, Limit: defaultPaginationLimit})
+	if err != nil {
+		return fmt.Errorf("failed to look for groups named '%s': %v", name, err)
+	}
+	for _, group := range groups {
+		if group.Id == d.Id() {
+			continue
+		}
+		if group.Type == "OKTA_GROUP" {
+			return fmt.Errorf("group with name '%s' already exists (%s), Okta group names must be unique", name, group.Id)
+		}
+		logger(m).Warn("group with the same name already exists", "name", name, "id", group.Id, "type", group.Type)
+	}
+	return nil
+}
+
+// getGroupIDByName looks up the ID of the group with the given name, it fails if there are several groups
+// with the same name, e.g. app groups named after an Okta group.
+func getGroupIDByName(ctx context.Context, m interface{}, name string) (string, error) {
+	qp := &query.Params{
+		Search: fmt.Sprintf(`profile.name eq "%s"`, strings.ReplaceAll(name, `"`, `\"`)),
+		Limit:  defaultPaginationLimit,
+	}
+	groups, err := listGroups(ctx, getOktaClientFromMetadata(m), qp)
+	if err != nil {
+		return "", fmt.Errorf("failed to look for groups named '%s': %v", name, err)
+	}
+	var ids []string
+	for _, group := range groups {
+		if group.Profile.Name == name {
+			ids = append(ids, group.Id)
+		}
+	}
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("group with ID or name '%s' does not exist", name)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("there are %d groups named '%s' (%s), use the group ID instead", len(ids), name, strings.Join(ids, ", "))
+	}
+}
+
 func syncGroupUsers(ctx context.Context, d *schema.ResourceData, m interface{}) error {
 	userIDList, err := listGroupUserIDs(ctx, m, d.Id())
 	if err != nil {
@@ -162,11 +273,62 @@ func containsUser(users []*okta.User, id string) bool {
 	return false
 }
 
-func buildGroup(d *schema.ResourceData) *okta.Group {
-	return &okta.Group{
-		Profile: &okta.GroupProfile{
-			Name:        d.Get("name").(string),
-			Description: d.Get("description").(string),
-		},
+func buildGroup(d *schema.ResourceData) *sdk.Group {
+	profile := make(map[string]interface{})
+	if rawAttrs, ok := d.GetOk("custom_profile_attributes"); ok {
+		// We validate the JSON, no need to check error
+		_ = json.Unmarshal([]byte(rawAttrs.(string)), &profile)
+	}
+	profile["name"] = d.Get("name").(string)
+	if description, ok := d.GetOk("description"); ok {
+		profile["description"] = description.(string)
+	}
+	return &sdk.Group{Profile: profile}
+}
+
+// buildGroupWithSchemaTypes builds the group, converting the custom attributes which are set as strings to the
+// numbers and booleans they are defined as in the group schema, since Okta rejects the values of other types.
+// The schema is only fetched when there are such strings.
+func buildGroupWithSchemaTypes(ctx context.Context, d *schema.ResourceData, m interface{}) (*sdk.Group, error) {
+	group := buildGroup(d)
+	var strs []string
+	for k, v := range group.Profile {
+		if _, ok := v.(string); ok && k != "name" && k != "description" {
+			strs = append(strs, k)
+		}
+	}
+	if len(strs) == 0 {
+		return group, nil
+	}
+	groupSchema, _, err := getSupplementFromMetadata(m).GetGroupSchema(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group schema: %v", err)
+	}
+	if groupSchema.Definitions == nil || groupSchema.Definitions.Custom == nil {
+		return group, nil
+	}
+	for _, k := range strs {
+		attr, ok := groupSchema.Definitions.Custom.Properties[k]
+		if !ok {
+			continue
+		}
+		value, err := convertGroupProfileValue(attr.Type, group.Profile[k].(string))
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of '%s' custom profile attribute: %v", k, err)
+		}
+		group.Profile[k] = value
+	}
+	return group, nil
+}
+
+func convertGroupProfileValue(schemaType, value string) (interface{}, error) {
+	switch schemaType {
+	case "boolean":
+		return strconv.ParseBool(value)
+	case "integer":
+		return strconv.ParseInt(value, 10, 64)
+	case "number":
+		return strconv.ParseFloat(value, 64)
 	}
+	return value, nil
 }
@@ -18,8 +18,15 @@ func resourceGroupMemberships() *schema.Resource {
 		ReadContext:   resourceGroupMembershipsRead,
 		UpdateContext: resourceGroupMembershipsUpdate,
 		DeleteContext: resourceGroupMembershipsDelete,
-		Importer:      nil,
-		Description:   "Resource to manage a set of group memberships for a specific user.",
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				_ = d.Set("group_id", d.Id())
+				_ = d.Set("track_all_users", true)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		CustomizeDiff: summarizeGroupMembershipsDiff,
+		Description:   "Resource to manage a set of memberships for a specific group.",
 		Schema: map[string]*schema.Schema{
 			"group_id": {
 				Type:        schema.TypeString,
@@ -33,135 +40,219 @@ func resourceGroupMemberships() *schema.Resource {
 				Description: "The list of Okta user IDs which the group should have membership managed for.",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"track_all_users": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to manage the complete membership of the group, so that the users added outside of Terraform are removed.",
+			},
+			"users_added": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of users added to the group by the last apply.",
+			},
+			"users_removed": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of users removed from the group by the last apply.",
+			},
 		},
 	}
 }
 
 func resourceGroupMembershipsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	groupId := d.Get("group_id").(string)
+	groupID := d.Get("group_id").(string)
 	users := convertInterfaceToStringSetNullable(d.Get("users"))
 	client := getOktaClientFromMetadata(m)
-	err := addGroupMembers(ctx, client, groupId, users)
+	usersToAdd, usersToRemove, exists, err := diffGroupMembers(ctx, client, groupID, users, nil, d.Get("track_all_users").(bool))
+	if err != nil {
+		return diag.Errorf("failed to list members of group (%s): %v", groupID, err)
+	}
+	if !exists {
+		return diag.Errorf("group (%s) does not exist", groupID)
+	}
+	err = addGroupMembers(ctx, client, groupID, usersToAdd, getParallelismFromMetadata(m))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = removeGroupMembers(ctx, client, groupID, usersToRemove, getParallelismFromMetadata(m))
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	_ = d.Set("users_added", len(usersToAdd))
+	_ = d.Set("users_removed", len(usersToRemove))
 	bOff := backoff.NewExponentialBackOff()
 	bOff.MaxElapsedTime = time.Second * 10
 	bOff.InitialInterval = time.Second
 	err = backoff.Retry(func() error {
-		ok, err := checkIfGroupHasUsers(ctx, client, groupId, users)
+		ok, err := checkIfGroupHasUsers(ctx, client, groupID, users)
 		if err != nil {
 			return backoff.Permanent(err)
 		}
 		if ok {
 			return nil
 		}
-		return fmt.Errorf("group (%s) did not have expected user memberships after multiple checks", groupId)
+		return fmt.Errorf("group (%s) did not have expected user memberships after multiple checks", groupID)
 	}, bOff)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	d.SetId(groupId)
-	return nil
+	d.SetId(groupID)
+	return resourceGroupMembershipsRead(ctx, d, m)
 }
 
+// resourceGroupMembershipsRead only tracks the configured users, unless 'track_all_users' is set,
+// so that the memberships managed outside of this resource don't cause any diff.
 func resourceGroupMembershipsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	groupId := d.Get("group_id").(string)
-	users := convertInterfaceToStringSetNullable(d.Get("users"))
-	client := getOktaClientFromMetadata(m)
-	ok, err := checkIfGroupHasUsers(ctx, client, groupId, users)
+	groupID := d.Get("group_id").(string)
+	trackAll := d.Get("track_all_users").(bool)
+	tracked := make(map[string]bool)
+	for _, user := range convertInterfaceToStringSetNullable(d.Get("users")) {
+		tracked[user] = true
+	}
+	var users []string
+	exists, err := forEachGroupMember(ctx, getOktaClientFromMetadata(m), groupID, func(user string) {
+		if trackAll || tracked[user] {
+			users = append(users, user)
+		}
+	})
 	if err != nil {
-		return diag.Errorf("unable to complete group check for user: %v", err)
+		return diag.Errorf("failed to list members of group (%s): %v", groupID, err)
 	}
-	if ok {
-		return nil
-	} else {
+	if !exists {
+		logger(m).Info("group does not exist", "group_id", groupID)
 		d.SetId("")
-		logger(m).Info("group (%s) did not have expected memberships or did not exist", groupId)
 		return nil
 	}
+	_ = d.Set("users", convertStringSetToInterface(users))
+	return nil
 }
 
 func resourceGroupMembershipsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	groupId := d.Get("group_id").(string)
+	groupID := d.Get("group_id").(string)
 	users := convertInterfaceToStringSetNullable(d.Get("users"))
-	client := getOktaClientFromMetadata(m)
-	err := removeGroupMembers(ctx, client, groupId, users)
+	err := removeGroupMembers(ctx, getOktaClientFromMetadata(m), groupID, users, getParallelismFromMetadata(m))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	return nil
 }
 
+// resourceGroupMembershipsUpdate computes the users to add and remove against the current membership of the group
 func resourceGroupMembershipsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	groupId := d.Get("group_id").(string)
+	groupID := d.Get("group_id").(string)
 	client := getOktaClientFromMetadata(m)
-
-	old, new := d.GetChange("users")
-
-	oldSet := old.(*schema.Set)
-	newSet := new.(*schema.Set)
-
-	usersToAdd := convertInterfaceArrToStringArr(newSet.Difference(oldSet).List())
-	usersToRemove := convertInterfaceArrToStringArr(oldSet.Difference(newSet).List())
-
-	err := addGroupMembers(ctx, client, groupId, usersToAdd)
+	old, _ := d.GetChange("users")
+	users := convertInterfaceToStringSetNullable(d.Get("users"))
+	// only the users that were tracked before are removed, unless all the users are tracked
+	tracked := convertInterfaceToStringSetNullable(old)
+	usersToAdd, usersToRemove, exists, err := diffGroupMembers(ctx, client, groupID, users, tracked, d.Get("track_all_users").(bool))
 	if err != nil {
-		diag.FromErr(err)
+		return diag.Errorf("failed to list members of group (%s): %v", groupID, err)
 	}
-
-	err = removeGroupMembers(ctx, client, groupId, usersToRemove)
+	if !exists {
+		return diag.Errorf("group (%s) does not exist", groupID)
+	}
+	err = addGroupMembers(ctx, client, groupID, usersToAdd, getParallelismFromMetadata(m))
 	if err != nil {
-		diag.FromErr(err)
+		return diag.FromErr(err)
 	}
-
-	return nil
+	err = removeGroupMembers(ctx, client, groupID, usersToRemove, getParallelismFromMetadata(m))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_ = d.Set("users_added", len(usersToAdd))
+	_ = d.Set("users_removed", len(usersToRemove))
+	return resourceGroupMembershipsRead(ctx, d, m)
 }
 
-func checkIfGroupHasUsers(ctx context.Context, client *okta.Client, groupId string, users []string) (bool, error) {
-	groupUsers, resp, err := client.Group.ListGroupUsers(ctx, groupId, &query.Params{Limit: defaultPaginationLimit})
-	exists, err := doesResourceExist(resp, err)
-	if err != nil {
-		return false, fmt.Errorf("unable to return membership for group (%s) from API", groupId)
+// summarizeGroupMembershipsDiff marks the number of users to add and to remove as known after apply whenever the
+// membership changes, since the members added outside of Terraform are only known then. The counts of the configured
+// users are logged, so that the plan of large groups can be checked without reading the diff of the 'users' set.
+func summarizeGroupMembershipsDiff(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.HasChange("users") && !d.HasChange("track_all_users") {
+		return nil
 	}
+	if d.NewValueKnown("users") {
+		oldUsers, newUsers := d.GetChange("users")
+		oldSet, newSet := oldUsers.(*schema.Set), newUsers.(*schema.Set)
+		logger(m).Info("group memberships change", "group_id", d.Get("group_id"),
+			"configured_users_added", newSet.Difference(oldSet).Len(), "configured_users_removed", oldSet.Difference(newSet).Len())
+	}
+	if err := d.SetNewComputed("users_added"); err != nil {
+		return err
+	}
+	return d.SetNewComputed("users_removed")
+}
 
-	if !exists {
+// forEachGroupMember calls fn with the ID of every member of the group, one page at a time, so that the members
+// of large groups are never all held in memory. Returns false if the group does not exist.
+func forEachGroupMember(ctx context.Context, client *okta.Client, groupID string, fn func(userID string)) (bool, error) {
+	groupUsers, resp, err := client.Group.ListGroupUsers(ctx, groupID, &query.Params{Limit: defaultPaginationLimit})
+	if is404(resp) {
 		return false, nil
 	}
-	if resp.HasNextPage() {
-		for {
-			var additionalUsers []*okta.User
-			resp, err := resp.Next(ctx, additionalUsers)
-			if err != nil {
-				return false, fmt.Errorf("unable to return membership for group (%s) from API", groupId)
-			}
-			groupUsers = append(groupUsers, additionalUsers...)
-			if !resp.HasNextPage() {
-				break
-			}
+	if err != nil {
+		return false, err
+	}
+	for {
+		for _, user := range groupUsers {
+			fn(user.Id)
+		}
+		if !resp.HasNextPage() {
+			return true, nil
+		}
+		groupUsers = nil
+		resp, err = resp.Next(ctx, &groupUsers)
+		if err != nil {
+			return false, err
 		}
 	}
+}
 
-	// Create set of users
-	expectedUserSet := make(map[string]bool)
-
-	for _, user := range users {
-		expectedUserSet[user] = false
+// diffGroupMembers goes through the members of the group and returns the desired users which are not members yet,
+// and the members to remove: the members which are not desired, if all of them are tracked, or else the ones that
+// were previously tracked and are no longer desired.
+func diffGroupMembers(ctx context.Context, client *okta.Client, groupID string, desired, tracked []string, trackAll bool) (usersToAdd, usersToRemove []string, exists bool, err error) {
+	missing := make(map[string]bool, len(desired))
+	for _, user := range desired {
+		missing[user] = true
 	}
-
-	// Use users pulled from user and mark set if found
-	for _, user := range groupUsers {
-		if _, ok := expectedUserSet[user.Id]; ok {
-			expectedUserSet[user.Id] = true
+	previous := make(map[string]bool, len(tracked))
+	for _, user := range tracked {
+		previous[user] = true
+	}
+	exists, err = forEachGroupMember(ctx, client, groupID, func(user string) {
+		if _, ok := missing[user]; ok {
+			missing[user] = false
+		} else if trackAll || previous[user] {
+			usersToRemove = append(usersToRemove, user)
 		}
+	})
+	if err != nil || !exists {
+		return nil, nil, exists, err
 	}
-
-	// Check set for any missing values
-	for _, state := range expectedUserSet {
-		if !state {
-			return false, nil
+	for _, user := range desired {
+		if missing[user] {
+			usersToAdd = append(usersToAdd, user)
 		}
 	}
+	return usersToAdd, usersToRemove, true, nil
+}
 
-	return true, nil
+func checkIfGroupHasUsers(ctx context.Context, client *okta.Client, groupID string, users []string) (bool, error) {
+	expected := make(map[string]bool, len(users))
+	for _, user := range users {
+		expected[user] = true
+	}
+	found := 0
+	exists, err := forEachGroupMember(ctx, client, groupID, func(user string) {
+		if expected[user] {
+			found++
+		}
+	})
+	if err != nil {
+		return false, fmt.Errorf("unable to return membership for group (%s) from API: %v", groupID, err)
+	}
+	return exists && found == len(expected), nil
 }
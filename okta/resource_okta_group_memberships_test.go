@@ -1,10 +1,16 @@
 package okta
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
 	"testing"
 
+	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccOktaGroupMemberships_crud(t *testing.T) {
@@ -14,6 +20,8 @@ func TestAccOktaGroupMemberships_crud(t *testing.T) {
 	start := mgr.GetFixtures("basic.tf", ri, t)
 	update := mgr.GetFixtures("basic_update.tf", ri, t)
 	remove := mgr.GetFixtures("basic_removal.tf", ri, t)
+	trackAll := mgr.GetFixtures("track_all_users.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", oktaGroupMemberships)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
@@ -22,9 +30,32 @@ func TestAccOktaGroupMemberships_crud(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: start,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "users_added", "2"),
+					resource.TestCheckResourceAttr(resourceName, "users_removed", "0"),
+				),
 			},
 			{
 				Config: update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "users.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "users_added", "2"),
+					resource.TestCheckResourceAttr(resourceName, "users_removed", "1"),
+				),
+			},
+			{
+				Config: trackAll,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "track_all_users", "true"),
+					resource.TestCheckResourceAttr(resourceName, "users.#", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the counts of the last apply are not imported
+				ImportStateVerifyIgnore: []string{"users_added", "users_removed"},
 			},
 			{
 				Config: remove,
@@ -32,3 +63,91 @@ func TestAccOktaGroupMemberships_crud(t *testing.T) {
 		},
 	})
 }
+
+func TestDiffGroupMembers(t *testing.T) {
+	// the members of the group are returned in two pages: 00u1, 00u2 and then 00u3
+	m := newTestOktaClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/groups/00g1/users" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errorCode":"E0000007","errorSummary":"Not found"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("after") == "" {
+			w.Header().Set("Link", fmt.Sprintf(`<http://%s/api/v1/groups/00g1/users?after=00u2>; rel="next"`, r.Host))
+			_, _ = w.Write([]byte(`[{"id":"00u1"},{"id":"00u2"}]`))
+			return
+		}
+		_, _ = w.Write([]byte(`[{"id":"00u3"}]`))
+	}))
+	client := m.oktaClient
+	tests := []struct {
+		name     string
+		groupID  string
+		desired  []string
+		tracked  []string
+		trackAll bool
+		added    []string
+		removed  []string
+		exists   bool
+	}{
+		{"added", "00g1", []string{"00u1", "00u2", "00u3", "00u4"}, nil, false, []string{"00u4"}, nil, true},
+		{"removed tracked", "00g1", []string{"00u1"}, []string{"00u1", "00u3"}, false, nil, []string{"00u3"}, true},
+		{"removed all", "00g1", []string{"00u1"}, nil, true, nil, []string{"00u2", "00u3"}, true},
+		{"untracked kept", "00g1", []string{"00u1"}, []string{"00u1"}, false, nil, nil, true},
+		{"unchanged", "00g1", []string{"00u1", "00u2", "00u3"}, nil, true, nil, nil, true},
+		{"empty", "00g1", nil, nil, false, nil, nil, true},
+		{"empty all", "00g1", nil, nil, true, nil, []string{"00u1", "00u2", "00u3"}, true},
+		{"missing group", "00g2", []string{"00u1"}, nil, true, nil, nil, false},
+	}
+	for _, test := range tests {
+		added, removed, exists, err := diffGroupMembers(context.Background(), client, test.groupID, test.desired, test.tracked, test.trackAll)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if exists != test.exists {
+			t.Errorf("%s: expected exists to be %v, got %v", test.name, test.exists, exists)
+		}
+		if !reflect.DeepEqual(added, test.added) {
+			t.Errorf("%s: expected %v to be added, got %v", test.name, test.added, added)
+		}
+		if !reflect.DeepEqual(removed, test.removed) {
+			t.Errorf("%s: expected %v to be removed, got %v", test.name, test.removed, removed)
+		}
+	}
+}
+
+func TestSummarizeGroupMembershipsDiff(t *testing.T) {
+	r := resourceGroupMemberships()
+	prior := r.Data(nil)
+	prior.SetId("00g1")
+	_ = prior.Set("group_id", "00g1")
+	_ = prior.Set("users", convertStringSetToInterface([]string{"00u1"}))
+	_ = prior.Set("track_all_users", false)
+	_ = prior.Set("users_added", 1)
+	_ = prior.Set("users_removed", 0)
+	m := &Config{logger: hclog.NewNullLogger()}
+	tests := []struct {
+		name     string
+		config   map[string]interface{}
+		computed bool
+	}{
+		{"unchanged", map[string]interface{}{"group_id": "00g1", "users": []interface{}{"00u1"}}, false},
+		{"users changed", map[string]interface{}{"group_id": "00g1", "users": []interface{}{"00u1", "00u2"}}, true},
+		{"tracking changed", map[string]interface{}{"group_id": "00g1", "users": []interface{}{"00u1"}, "track_all_users": true}, true},
+	}
+	for _, test := range tests {
+		diff, err := r.Diff(context.Background(), prior.State(), terraform.NewResourceConfigRaw(test.config), m)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+		for _, k := range []string{"users_added", "users_removed"} {
+			computed := diff != nil && diff.Attributes[k] != nil && diff.Attributes[k].NewComputed
+			if computed != test.computed {
+				t.Errorf("%s: expected %s to be known after apply to be %v, got %v", test.name, k, test.computed, computed)
+			}
+		}
+	}
+}
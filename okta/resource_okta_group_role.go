@@ -22,6 +22,7 @@ func resourceGroupRole() *schema.Resource {
 		DeleteContext: resourceGroupRoleDelete,
 		Importer:      &schema.ResourceImporter{StateContext: resourceGroupRoleImporter},
 		CustomizeDiff: customdiff.All(
+			validateGroupRoleTargets,
 			customdiff.ForceNewIf("target_group_list", func(_ context.Context, d *schema.ResourceDiff, m interface{}) bool {
 				if d.HasChange("target_group_list") {
 					// to avoid exception when removing last group target from a role assignment,
@@ -69,13 +70,13 @@ func resourceGroupRole() *schema.Resource {
 				Type:        schema.TypeSet,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Optional:    true,
-				Description: "List of groups ids for the targets of the admin role.",
+				Description: "List of groups ids for the targets of the admin role. Only supported by the 'GROUP_MEMBERSHIP_ADMIN', 'HELP_DESK_ADMIN' and 'USER_ADMIN' roles.",
 			},
 			"target_app_list": {
 				Type:        schema.TypeSet,
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: stringIsAppTarget},
 				Optional:    true,
-				Description: "List of apps ids for the targets of the admin role.",
+				Description: "List of apps ids for the targets of the admin role. Only supported by the 'APP_ADMIN' role.",
 			},
 		},
 	}
@@ -146,7 +147,7 @@ func resourceGroupRoleRead(ctx context.Context, d *schema.ResourceData, m interf
 				}
 				_ = d.Set("target_group_list", groupIDs)
 			} else if rolesAssigned[i].Type == "APP_ADMIN" {
-				apps, err := listGroupAppsTargets(ctx, d, m)
+				apps, err := listGroupAppsTargets(ctx, m, groupID, d.Id())
 				if err != nil {
 					return diag.Errorf("unable to list app targets for role %s and group %s: %v", rolesAssigned[i].Id, groupID, err)
 				}
@@ -184,7 +185,7 @@ func resourceGroupRoleUpdate(ctx context.Context, d *schema.ResourceData, m inte
 	}
 	if d.HasChange("target_app_list") && roleType == "APP_ADMIN" {
 		expectedApps := convertInterfaceToStringSet(d.Get("target_app_list"))
-		existingApps, err := listGroupAppsTargets(ctx, d, m)
+		existingApps, err := listGroupAppsTargets(ctx, m, groupID, d.Id())
 		if err != nil {
 			return diag.Errorf("unable to list app targets for role %s and group %s: %v", d.Id(), groupID, err)
 		}
@@ -239,6 +240,12 @@ func resourceGroupRoleImporter(ctx context.Context, d *schema.ResourceData, m in
 				return nil, fmt.Errorf("unable to get admin assignment %s for group %s: %v", role.Id, groupID, err)
 			}
 			_ = d.Set("target_group_list", groupIDs)
+		} else if role.Type == "APP_ADMIN" {
+			apps, err := listGroupAppsTargets(ctx, m, groupID, role.Id)
+			if err != nil {
+				return nil, fmt.Errorf("unable to list app targets for role %s and group %s: %v", role.Id, groupID, err)
+			}
+			_ = d.Set("target_app_list", apps)
 		}
 		return []*schema.ResourceData{d}, nil
 
@@ -270,11 +277,11 @@ func listGroupTargetsIDs(ctx context.Context, m interface{}, groupID, roleID str
 	return resIDs, nil
 }
 
-func listGroupAppsTargets(ctx context.Context, d *schema.ResourceData, m interface{}) ([]string, error) {
+func listGroupAppsTargets(ctx context.Context, m interface{}, groupID, roleID string) ([]string, error) {
 	var resApps []string
 	apps, resp, err := getOktaClientFromMetadata(m).Group.
 		ListApplicationTargetsForApplicationAdministratorRoleForGroup(
-			ctx, d.Get("group_id").(string), d.Id(), &query.Params{Limit: defaultPaginationLimit, Status: "ACTIVE"})
+			ctx, groupID, roleID, &query.Params{Limit: defaultPaginationLimit, Status: "ACTIVE"})
 	if err != nil {
 		return nil, err
 	}
@@ -370,6 +377,26 @@ func removeGroupAppTargets(ctx context.Context, client *okta.Client, groupID, ro
 	return nil
 }
 
+// validateGroupRoleTargets rejects the targets that are not supported by the role type, otherwise those
+// would be silently ignored by the API and result in a perpetual diff.
+func validateGroupRoleTargets(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("role_type") {
+		return nil
+	}
+	return validateRoleTargets(d.Get("role_type").(string), d.Get("target_group_list").(*schema.Set), d.Get("target_app_list").(*schema.Set))
+}
+
+// validateRoleTargets checks that the role type supports the group and app targets set for it
+func validateRoleTargets(roleType string, groupTargets, appTargets *schema.Set) error {
+	if groupTargets.Len() > 0 && !supportsGroupTargets(roleType) {
+		return fmt.Errorf("'target_group_list' is only supported by the 'GROUP_MEMBERSHIP_ADMIN', 'HELP_DESK_ADMIN' and 'USER_ADMIN' roles, got '%s'", roleType)
+	}
+	if appTargets.Len() > 0 && roleType != "APP_ADMIN" {
+		return fmt.Errorf("'target_app_list' is only supported by the 'APP_ADMIN' role, got '%s'", roleType)
+	}
+	return nil
+}
+
 func supportsGroupTargets(roleType string) bool {
 	return contains([]string{"GROUP_MEMBERSHIP_ADMIN", "HELP_DESK_ADMIN", "USER_ADMIN"}, roleType)
 }
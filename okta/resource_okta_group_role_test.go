@@ -2,6 +2,7 @@ package okta
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -58,3 +59,30 @@ func TestAccOktaGroupAdminRole_crud(t *testing.T) {
 		},
 	})
 }
+
+func TestAccOktaGroupAdminRole_unsupportedTargets(t *testing.T) {
+	ri := acctest.RandInt()
+	config := fmt.Sprintf(`
+resource "okta_group" "test" {
+  name = "%[1]s"
+}
+
+resource "okta_group_role" "test" {
+  group_id          = okta_group.test.id
+  role_type         = "READ_ONLY_ADMIN"
+  target_group_list = [okta_group.test.id]
+}
+`, buildResourceName(ri))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(oktaGroup, doesGroupExist),
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile("'target_group_list' is only supported by"),
+			},
+		},
+	})
+}
@@ -3,6 +3,8 @@ package okta
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -11,12 +13,12 @@ import (
 
 func resourceGroupRoles() *schema.Resource {
 	return &schema.Resource{
-		DeprecationMessage: "This resource is deprecated and will be removed in favor of using \"okta_group_role\", please migrate as soon as possible.",
 		// No point in having an exist function, since only the group has to exist
 		CreateContext: resourceGroupRolesCreate,
 		ReadContext:   resourceGroupRolesRead,
 		UpdateContext: resourceGroupRolesUpdate,
 		DeleteContext: resourceGroupRolesDelete,
+		CustomizeDiff: validateGroupRolesTargets,
 		Importer: &schema.ResourceImporter{
 			StateContext: func(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				_ = d.Set("group_id", d.Id())
@@ -37,7 +39,37 @@ func resourceGroupRoles() *schema.Resource {
 					Type:             schema.TypeString,
 					ValidateDiagFunc: elemInSlice(validAdminRoles),
 				},
-				Description: "Admin roles associated with the group. This can also be done per user.",
+				Description:   "Admin roles associated with the group. This can also be done per user.",
+				Deprecated:    "Use 'role' blocks instead, which also support role targets",
+				ConflictsWith: []string{"role"},
+			},
+			"role": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Description:   "Admin roles associated with the group along with their targets",
+				ConflictsWith: []string{"admin_roles"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							Description:      "Type of Role to assign",
+							ValidateDiagFunc: elemInSlice(validAdminRoles),
+						},
+						"target_group_list": {
+							Type:        schema.TypeSet,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Optional:    true,
+							Description: "List of groups ids for the targets of the admin role.",
+						},
+						"target_app_list": {
+							Type:        schema.TypeSet,
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: stringIsAppTarget},
+							Optional:    true,
+							Description: "List of apps ids for the targets of the admin role.",
+						},
+					},
+				},
 			},
 		},
 	}
@@ -45,6 +77,16 @@ func resourceGroupRoles() *schema.Resource {
 
 func resourceGroupRolesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	groupID := d.Get("group_id").(string)
+	if roles, ok := d.GetOk("role"); ok {
+		for _, role := range buildGroupRoles(roles.(*schema.Set)) {
+			err := assignGroupRole(ctx, getOktaClientFromMetadata(m), groupID, role)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		d.SetId(getGroupRoleID(groupID))
+		return resourceGroupRolesRead(ctx, d, m)
+	}
 	adminRoles := convertInterfaceToStringSet(d.Get("admin_roles"))
 	for _, role := range adminRoles {
 		_, _, err := getOktaClientFromMetadata(m).Group.AssignRoleToGroup(ctx, groupID, okta.AssignRoleRequest{
@@ -64,11 +106,20 @@ func resourceGroupRolesRead(ctx context.Context, d *schema.ResourceData, m inter
 	if err := suppressErrorOn404(resp, err); err != nil {
 		return diag.Errorf("failed to get list of group assigned roles: %v", err)
 	}
-	adminRoles := make([]string, len(existingRoles))
-	for i, role := range existingRoles {
-		adminRoles[i] = role.Type
+	if _, ok := d.GetOk("admin_roles"); ok {
+		adminRoles := make([]string, len(existingRoles))
+		for i, role := range existingRoles {
+			adminRoles[i] = role.Type
+		}
+		_ = d.Set("admin_roles", convertStringSetToInterface(adminRoles))
+		return nil
+	}
+	// the roles are set to the role blocks unless the deprecated 'admin_roles' is used, so that they are imported too
+	roles, err := listGroupRoles(ctx, m, groupID, existingRoles)
+	if err != nil {
+		return diag.FromErr(err)
 	}
-	_ = d.Set("admin_roles", convertStringSetToInterface(adminRoles))
+	_ = d.Set("role", flattenGroupRoles(roles))
 	return nil
 }
 
@@ -79,6 +130,13 @@ func resourceGroupRolesUpdate(ctx context.Context, d *schema.ResourceData, m int
 	if err := suppressErrorOn404(resp, err); err != nil {
 		return diag.Errorf("failed to get list of group assigned roles: %v", err)
 	}
+	if roles, ok := d.GetOk("role"); ok {
+		err = updateGroupRoles(ctx, m, groupID, existingRoles, buildGroupRoles(roles.(*schema.Set)))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		return resourceGroupRolesRead(ctx, d, m)
+	}
 	adminRoles := convertInterfaceToStringSet(d.Get("admin_roles"))
 	rolesToAdd, rolesToRemove := splitRoles(existingRoles, adminRoles)
 	for _, role := range rolesToAdd {
@@ -139,6 +197,183 @@ func containsRole(roles []*okta.Role, roleName string) bool {
 	return false
 }
 
+// validateGroupRolesTargets rejects the role blocks with targets that are not supported by the role type
+func validateGroupRolesTargets(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("role") {
+		return nil
+	}
+	for _, role := range d.Get("role").(*schema.Set).List() {
+		r := role.(map[string]interface{})
+		if err := validateRoleTargets(r["type"].(string), r["target_group_list"].(*schema.Set), r["target_app_list"].(*schema.Set)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func getGroupRoleID(groupID string) string {
 	return fmt.Sprintf("%s.roles", groupID)
 }
+
+// groupRoleAssignment is an admin role assigned to a group along with its targets
+type groupRoleAssignment struct {
+	ID           string
+	Type         string
+	GroupTargets []string
+	AppTargets   []string
+}
+
+func buildGroupRoles(roles *schema.Set) []*groupRoleAssignment {
+	result := make([]*groupRoleAssignment, roles.Len())
+	for i, v := range roles.List() {
+		role := v.(map[string]interface{})
+		result[i] = &groupRoleAssignment{
+			Type:         role["type"].(string),
+			GroupTargets: convertInterfaceToStringSet(role["target_group_list"]),
+			AppTargets:   convertInterfaceToStringSet(role["target_app_list"]),
+		}
+	}
+	return result
+}
+
+func flattenGroupRoles(roles []*groupRoleAssignment) []interface{} {
+	result := make([]interface{}, len(roles))
+	for i, role := range roles {
+		result[i] = map[string]interface{}{
+			"type":              role.Type,
+			"target_group_list": convertStringSetToInterface(role.GroupTargets),
+			"target_app_list":   convertStringSetToInterface(role.AppTargets),
+		}
+	}
+	return result
+}
+
+// listGroupRoles builds the roles assigned to the group along with their targets. Targets are only fetched
+// for the role types that support them.
+func listGroupRoles(ctx context.Context, m interface{}, groupID string, existingRoles []*okta.Role) ([]*groupRoleAssignment, error) {
+	roles := make([]*groupRoleAssignment, len(existingRoles))
+	for i, existing := range existingRoles {
+		role := &groupRoleAssignment{ID: existing.Id, Type: existing.Type}
+		var err error
+		if supportsGroupTargets(existing.Type) {
+			role.GroupTargets, err = listGroupTargetsIDs(ctx, m, groupID, existing.Id)
+		} else if existing.Type == "APP_ADMIN" {
+			role.AppTargets, err = listGroupAppsTargets(ctx, m, groupID, existing.Id)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to list targets for role %s and group %s: %v", existing.Id, groupID, err)
+		}
+		roles[i] = role
+	}
+	return roles, nil
+}
+
+func assignGroupRole(ctx context.Context, client *okta.Client, groupID string, role *groupRoleAssignment) error {
+	assigned, _, err := client.Group.AssignRoleToGroup(ctx, groupID, okta.AssignRoleRequest{Type: role.Type}, nil)
+	if err != nil {
+		return fmt.Errorf("failed to assign role %s to group %s: %v", role.Type, groupID, err)
+	}
+	role.ID = assigned.Id
+	if len(role.GroupTargets) > 0 && supportsGroupTargets(role.Type) {
+		err = addGroupTargetsToRole(ctx, client, groupID, role.ID, role.GroupTargets)
+		if err != nil {
+			return fmt.Errorf("unable to add group target to role assignment %s for group %s: %v", role.ID, groupID, err)
+		}
+	}
+	if len(role.AppTargets) > 0 && role.Type == "APP_ADMIN" {
+		err = addGroupAppTargetsToRole(ctx, client, groupID, role.ID, role.AppTargets)
+		if err != nil {
+			return fmt.Errorf("unable to add app targets to role assignment %s for group %s: %v", role.ID, groupID, err)
+		}
+	}
+	return nil
+}
+
+// key identifies the role assignment by its type and targets, so that several assignments of the same type
+// with different targets can be told apart
+func (r *groupRoleAssignment) key() string {
+	groupTargets := append([]string(nil), r.GroupTargets...)
+	appTargets := append([]string(nil), r.AppTargets...)
+	sort.Strings(groupTargets)
+	sort.Strings(appTargets)
+	return fmt.Sprintf("%s/%s/%s", r.Type, strings.Join(groupTargets, ","), strings.Join(appTargets, ","))
+}
+
+// updateGroupRoles keeps the roles which are assigned with the expected targets, updates the targets of the other
+// assignments of the same type, assigns the missing roles and removes the ones that are not expected anymore.
+// When all the existing targets of the role should be removed, the role is reassigned, since removing the last
+// target of the role assignment either fails or removes the role from the group.
+func updateGroupRoles(ctx context.Context, m interface{}, groupID string, existingRoles []*okta.Role, expectedRoles []*groupRoleAssignment) error {
+	client := getOktaClientFromMetadata(m)
+	current, err := listGroupRoles(ctx, m, groupID, existingRoles)
+	if err != nil {
+		return err
+	}
+	currentByKey := make(map[string]*groupRoleAssignment, len(current))
+	for _, role := range current {
+		currentByKey[role.key()] = role
+	}
+	var changed []*groupRoleAssignment
+	for _, expected := range expectedRoles {
+		if _, ok := currentByKey[expected.key()]; ok {
+			delete(currentByKey, expected.key())
+			continue
+		}
+		changed = append(changed, expected)
+	}
+	for _, expected := range changed {
+		var existing *groupRoleAssignment
+		for key, role := range currentByKey {
+			if role.Type == expected.Type {
+				existing = role
+				delete(currentByKey, key)
+				break
+			}
+		}
+		if existing == nil {
+			if err := assignGroupRole(ctx, client, groupID, expected); err != nil {
+				return err
+			}
+			continue
+		}
+		if (len(existing.GroupTargets) > 0 && !containsOne(existing.GroupTargets, expected.GroupTargets...)) ||
+			(len(existing.AppTargets) > 0 && !containsOne(existing.AppTargets, expected.AppTargets...)) {
+			resp, err := client.Group.RemoveRoleFromGroup(ctx, groupID, existing.ID)
+			if err := suppressErrorOn404(resp, err); err != nil {
+				return fmt.Errorf("failed to remove role %s from group %s: %v", existing.ID, groupID, err)
+			}
+			if err := assignGroupRole(ctx, client, groupID, expected); err != nil {
+				return err
+			}
+			continue
+		}
+		if supportsGroupTargets(expected.Type) {
+			targetsToAdd, targetsToRemove := splitTargets(expected.GroupTargets, existing.GroupTargets)
+			err = addGroupTargetsToRole(ctx, client, groupID, existing.ID, targetsToAdd)
+			if err != nil {
+				return fmt.Errorf("failed to add group target to role assignment %s for group %s: %v", existing.ID, groupID, err)
+			}
+			err = removeGroupTargetsFromRole(ctx, client, groupID, existing.ID, targetsToRemove)
+			if err != nil {
+				return fmt.Errorf("failed to remove group target from admin role assignment %s of group %s: %v", existing.ID, groupID, err)
+			}
+		} else if expected.Type == "APP_ADMIN" {
+			targetsToAdd, targetsToRemove := splitTargets(expected.AppTargets, existing.AppTargets)
+			err = addGroupAppTargetsToRole(ctx, client, groupID, existing.ID, targetsToAdd)
+			if err != nil {
+				return fmt.Errorf("unable to add app target to role assignment %s for group %s: %v", existing.ID, groupID, err)
+			}
+			err = removeGroupAppTargets(ctx, client, groupID, existing.ID, targetsToRemove)
+			if err != nil {
+				return fmt.Errorf("failed to remove app target from admin role assignment %s of group %s: %v", existing.ID, groupID, err)
+			}
+		}
+	}
+	for _, role := range currentByKey {
+		resp, err := client.Group.RemoveRoleFromGroup(ctx, groupID, role.ID)
+		if err := suppressErrorOn404(resp, err); err != nil {
+			return fmt.Errorf("failed to remove role %s from group %s: %v", role.ID, groupID, err)
+		}
+	}
+	return nil
+}
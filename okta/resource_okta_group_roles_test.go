@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccOktaGroupAdminRoles_crud(t *testing.T) {
@@ -14,6 +15,7 @@ func TestAccOktaGroupAdminRoles_crud(t *testing.T) {
 	mgr := newFixtureManager(groupRoles)
 	config := mgr.GetFixtures("basic.tf", ri, t)
 	updatedConfig := mgr.GetFixtures("all_roles.tf", ri, t)
+	withTargets := mgr.GetFixtures("with_targets.tf", ri, t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
@@ -32,6 +34,47 @@ func TestAccOktaGroupAdminRoles_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "admin_roles.#", "10"),
 				),
 			},
+			{
+				Config: withTargets,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "role.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "role.*", map[string]string{
+						"type":                "HELP_DESK_ADMIN",
+						"target_group_list.#": "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "role.*", map[string]string{
+						"type":              "APP_ADMIN",
+						"target_app_list.#": "1",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("failed to find %s", resourceName)
+					}
+					return rs.Primary.Attributes["group_id"], nil
+				},
+			},
 		},
 	})
 }
+
+func TestGroupRoleAssignmentKey(t *testing.T) {
+	role := &groupRoleAssignment{Type: "GROUP_MEMBERSHIP_ADMIN", GroupTargets: []string{"00g2", "00g1"}}
+	sameTargets := &groupRoleAssignment{Type: "GROUP_MEMBERSHIP_ADMIN", GroupTargets: []string{"00g1", "00g2"}}
+	otherTargets := &groupRoleAssignment{Type: "GROUP_MEMBERSHIP_ADMIN", GroupTargets: []string{"00g1"}}
+	if role.key() != sameTargets.key() {
+		t.Errorf("expected the order of the targets to be ignored, got %s and %s", role.key(), sameTargets.key())
+	}
+	if role.key() == otherTargets.key() {
+		t.Errorf("expected the assignments with other targets to have another key, got %s", role.key())
+	}
+	if role.GroupTargets[0] != "00g2" {
+		t.Errorf("expected the targets of the role to be left as they are, got %v", role.GroupTargets)
+	}
+}
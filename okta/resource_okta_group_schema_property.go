@@ -0,0 +1,117 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGroupSchemaProperty() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGroupSchemaPropertyCreateOrUpdate,
+		ReadContext:   resourceGroupSchemaPropertyRead,
+		UpdateContext: resourceGroupSchemaPropertyCreateOrUpdate,
+		DeleteContext: resourceGroupSchemaPropertyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				_ = d.Set("index", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Schema: buildSchema(
+			userBaseSchemaSchema,
+			userSchemaSchema,
+			userPatternSchema,
+			map[string]*schema.Schema{
+				"scope": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Default:          "NONE",
+					ValidateDiagFunc: elemInSlice([]string{"SELF", "NONE", ""}),
+				},
+				"master": {
+					Type:     schema.TypeString,
+					Optional: true,
+					// Accepting an empty value to allow for zero value (when provisioning is off)
+					ValidateDiagFunc: elemInSlice([]string{"PROFILE_MASTER", "OKTA", "OVERRIDE", ""}),
+					Description:      "SubSchema profile manager, if not set it will inherit its setting.",
+					Default:          "PROFILE_MASTER",
+				},
+				"master_override_priority": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"type": {
+								Type:     schema.TypeString,
+								Optional: true,
+								Default:  "APP",
+							},
+							"value": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+		),
+	}
+}
+
+func resourceGroupSchemaPropertyCreateOrUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	err := validateUserSchema(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_, _, err = getSupplementFromMetadata(m).UpdateCustomGroupSchemaProperty(ctx, d.Get("index").(string), userSubSchema(d))
+	if err != nil {
+		return diag.Errorf("failed to create or update group schema property: %v", err)
+	}
+	d.SetId(d.Get("index").(string))
+	// the property is not always available right after it's created
+	bOff := backoff.NewExponentialBackOff()
+	bOff.MaxElapsedTime = time.Second * 10
+	bOff.InitialInterval = time.Second
+	err = backoff.Retry(func() error {
+		err := resourceGroupSchemaPropertyRead(ctx, d, m)
+		if err != nil {
+			return backoff.Permanent(fmt.Errorf("%s", err[0].Summary))
+		}
+		if d.Id() != "" {
+			return nil
+		}
+		d.SetId(d.Get("index").(string))
+		return fmt.Errorf("group schema property %s was not created", d.Get("index").(string))
+	}, bOff)
+	return diag.FromErr(err)
+}
+
+func resourceGroupSchemaPropertyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	s, _, err := getSupplementFromMetadata(m).GetGroupSchema(ctx)
+	if err != nil {
+		return diag.Errorf("failed to get group schema: %v", err)
+	}
+	subschema := getCustomProperty(s, d.Id())
+	if subschema == nil {
+		d.SetId("")
+		return nil
+	}
+	err = syncUserSchema(d, subschema)
+	if err != nil {
+		return diag.Errorf("failed to set group schema property: %v", err)
+	}
+	return nil
+}
+
+func resourceGroupSchemaPropertyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	_, err := getSupplementFromMetadata(m).DeleteGroupSchemaProperty(ctx, d.Id())
+	if err != nil {
+		return diag.Errorf("failed to delete group schema property: %v", err)
+	}
+	return nil
+}
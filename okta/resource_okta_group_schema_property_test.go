@@ -0,0 +1,119 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func sweepGroupSchemaProperties(client *testClient) error {
+	schema, _, err := client.apiSupplement.GetGroupSchema(context.Background())
+	if err != nil {
+		return err
+	}
+	var errorList []error
+	for key := range schema.Definitions.Custom.Properties {
+		if strings.HasPrefix(key, testResourcePrefix) {
+			if _, err := client.apiSupplement.DeleteGroupSchemaProperty(context.Background(), key); err != nil {
+				errorList = append(errorList, err)
+			}
+		}
+	}
+	return condenseError(errorList)
+}
+
+func TestAccOktaGroupSchemaProperty_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(groupSchemaProperty)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updated := mgr.GetFixtures("updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", groupSchemaProperty)
+	groupResourceName := fmt.Sprintf("%s.test", oktaGroup)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      checkOktaGroupSchemaPropertyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testOktaGroupSchemaPropertyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "index", "testAcc_"+strconv.Itoa(ri)),
+					resource.TestCheckResourceAttr(resourceName, "title", "terraform acceptance test"),
+					resource.TestCheckResourceAttr(resourceName, "type", "string"),
+					resource.TestCheckResourceAttr(resourceName, "description", "terraform acceptance test"),
+					resource.TestCheckResourceAttr(resourceName, "required", "false"),
+					resource.TestCheckResourceAttr(resourceName, "min_length", "1"),
+					resource.TestCheckResourceAttr(resourceName, "max_length", "50"),
+					resource.TestCheckResourceAttr(resourceName, "permissions", "READ_ONLY"),
+					resource.TestCheckResourceAttr(resourceName, "master", "PROFILE_MASTER"),
+					resource.TestCheckResourceAttr(resourceName, "enum.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "one_of.#", "4"),
+					resource.TestCheckResourceAttr(groupResourceName, "custom_profile_attributes", fmt.Sprintf("{\"testAcc_%d\":\"M\"}", ri)),
+				),
+			},
+			{
+				Config: updated,
+				Check: resource.ComposeTestCheckFunc(
+					testOktaGroupSchemaPropertyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "title", "terraform acceptance test updated"),
+					resource.TestCheckResourceAttr(resourceName, "description", "terraform acceptance test updated"),
+					resource.TestCheckResourceAttr(resourceName, "required", "true"),
+					resource.TestCheckResourceAttr(resourceName, "max_length", "70"),
+					resource.TestCheckResourceAttr(resourceName, "permissions", "READ_WRITE"),
+					resource.TestCheckResourceAttr(resourceName, "master", "OKTA"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func checkOktaGroupSchemaPropertyDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != groupSchemaProperty {
+			continue
+		}
+		exists, _ := testGroupSchemaPropertyExists(rs.Primary.ID)
+		if exists {
+			return fmt.Errorf("resource still exists, ID: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testOktaGroupSchemaPropertyExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+		exists, err := testGroupSchemaPropertyExists(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("failed to find: %v", err)
+		}
+		if !exists {
+			return fmt.Errorf("custom property %s does not exist in the group profile schema", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testGroupSchemaPropertyExists(index string) (bool, error) {
+	s, _, err := getSupplementFromMetadata(testAccProvider.Meta()).GetGroupSchema(context.Background())
+	if err != nil {
+		return false, fmt.Errorf("failed to get group schema: %v", err)
+	}
+	return getCustomProperty(s, index) != nil, nil
+}
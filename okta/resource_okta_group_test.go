@@ -1,12 +1,17 @@
 package okta
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/okta-sdk-golang/v2/okta/query"
 )
 
@@ -60,7 +65,89 @@ func TestAccOktaGroups_crud(t *testing.T) {
 	})
 }
 
+func TestAccOktaGroups_nameCollision(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager("okta_group")
+	config := mgr.GetFixtures("name_unique.tf", ri, t)
+	collisionConfig := mgr.GetFixtures("name_collision.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(oktaGroup, doesGroupExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				Config:      collisionConfig,
+				ExpectError: regexp.MustCompile(`already exists`),
+			},
+		},
+	})
+}
+
+func TestAccOktaGroups_importByName(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := fmt.Sprintf("%s.test", oktaGroup)
+	mgr := newFixtureManager("okta_group")
+	config := mgr.GetFixtures("name_unique.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(oktaGroup, doesGroupExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateId:           buildResourceName(ri),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"users"},
+			},
+		},
+	})
+}
+
 func doesGroupExist(id string) (bool, error) {
 	_, response, err := getOktaClientFromMetadata(testAccProvider.Meta()).Group.GetGroup(context.Background(), id)
 	return doesResourceExist(response, err)
 }
+
+func TestResourceGroupCreateConvertsCustomAttributes(t *testing.T) {
+	var body []byte
+	m := newTestOktaClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api/v1/meta/schemas/group/default":
+			_, _ = w.Write([]byte(`{"definitions":{"custom":{"properties":{` +
+				`"level":{"type":"integer"},"manager":{"type":"boolean"},"ratio":{"type":"number"},"code":{"type":"string"}}}}}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/groups":
+			raw, _ := ioutil.ReadAll(r.Body)
+			body = bytes.TrimSpace(raw)
+			_, _ = w.Write([]byte(`{"id":"00g1"}`))
+		case r.URL.Path == "/api/v1/groups/00g1":
+			_, _ = w.Write([]byte(`{"id":"00g1","profile":{"name":"Managers","level":3,"manager":true,"ratio":0.5,"code":"007"}}`))
+		default:
+			_, _ = w.Write([]byte(`[]`))
+		}
+	}))
+	attrs := `{"level":"3","manager":"true","ratio":0.5,"code":"007"}`
+	d := schema.TestResourceDataRaw(t, resourceGroup().Schema, map[string]interface{}{
+		"name":                      "Managers",
+		"custom_profile_attributes": attrs,
+	})
+	if diags := resourceGroupCreate(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("failed to create group: %v", diags)
+	}
+	expected := `{"profile":{"code":"007","level":3,"manager":true,"name":"Managers","ratio":0.5}}`
+	if string(body) != expected {
+		t.Errorf("expected request body %s, got %s", expected, body)
+	}
+	if actual := d.Get("custom_profile_attributes").(string); actual != normalizeDataJSON(attrs) {
+		t.Errorf("expected the attributes to be read as configured, got %s", actual)
+	}
+}
@@ -18,6 +18,7 @@ func resourceIdpOidc() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: validateIdpGroupsProvisioning,
 		// Note the base schema
 		Schema: buildIdpSchema(map[string]*schema.Schema{
 			"type": {
@@ -100,12 +101,15 @@ func resourceIdpRead(ctx context.Context, d *schema.ResourceData, m interface{})
 	}
 	_ = d.Set("name", idp.Name)
 	_ = d.Set("type", idp.Type)
+	_ = d.Set("status", idp.Status)
+	_ = d.Set("protocol_type", idp.Protocol.Type)
 	_ = d.Set("max_clock_skew", idp.Policy.MaxClockSkew)
 	_ = d.Set("provisioning_action", idp.Policy.Provisioning.Action)
 	_ = d.Set("deprovisioned_action", idp.Policy.Provisioning.Conditions.Deprovisioned.Action)
 	_ = d.Set("suspended_action", idp.Policy.Provisioning.Conditions.Suspended.Action)
 	_ = d.Set("profile_master", idp.Policy.Provisioning.ProfileMaster)
 	_ = d.Set("subject_match_type", idp.Policy.Subject.MatchType)
+	_ = d.Set("subject_match_attribute", idp.Policy.Subject.MatchAttribute)
 	_ = d.Set("username_template", idp.Policy.Subject.UserNameTemplate.Template)
 	_ = d.Set("issuer_url", idp.Protocol.Issuer.Url)
 	_ = d.Set("client_secret", idp.Protocol.Credentials.Client.ClientSecret)
@@ -127,8 +131,10 @@ func resourceIdpRead(ctx context.Context, d *schema.ResourceData, m interface{})
 	}
 	if idp.Policy.AccountLink != nil {
 		_ = d.Set("account_link_action", idp.Policy.AccountLink.Action)
-		if idp.Policy.AccountLink.Filter != nil {
+		if idp.Policy.AccountLink.Filter != nil && idp.Policy.AccountLink.Filter.Groups != nil {
 			setMap["account_link_group_include"] = convertStringSetToInterface(idp.Policy.AccountLink.Filter.Groups.Include)
+		} else {
+			setMap["account_link_group_include"] = convertStringSetToInterface([]string{})
 		}
 	}
 	err = setNonPrimitives(d, setMap)
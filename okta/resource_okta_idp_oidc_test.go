@@ -36,6 +36,8 @@ func TestAccOktaIdpOidc_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "client_secret", "efg456"),
 					resource.TestCheckResourceAttr(resourceName, "issuer_url", "https://id.example.com"),
 					resource.TestCheckResourceAttr(resourceName, "username_template", "idpuser.email"),
+					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
+					resource.TestCheckResourceAttr(resourceName, "protocol_type", "OIDC"),
 				),
 			},
 			{
@@ -54,8 +56,18 @@ func TestAccOktaIdpOidc_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "client_secret", "efg456"),
 					resource.TestCheckResourceAttr(resourceName, "issuer_url", "https://id.example.com"),
 					resource.TestCheckResourceAttr(resourceName, "username_template", "idpuser.email"),
+					resource.TestCheckResourceAttr(resourceName, "groups_action", "SYNC"),
+					resource.TestCheckResourceAttr(resourceName, "groups_attribute", "groups"),
+					resource.TestCheckResourceAttr(resourceName, "groups_filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status", statusInactive),
+					resource.TestCheckResourceAttr(resourceName, "protocol_type", "OIDC"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -18,6 +18,7 @@ func resourceIdpSaml() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: validateIdpGroupsProvisioning,
 		Schema: buildIdpSchema(map[string]*schema.Schema{
 			"type": {
 				Type:     schema.TypeString,
@@ -115,7 +116,17 @@ func resourceIdpSamlRead(ctx context.Context, d *schema.ResourceData, m interfac
 		return nil
 	}
 	_ = d.Set("name", idp.Name)
+	_ = d.Set("type", idp.Type)
+	_ = d.Set("status", idp.Status)
 	_ = d.Set("acs_type", idp.Protocol.Endpoints.Acs.Type)
+	if idp.Protocol.Endpoints.Sso != nil {
+		_ = d.Set("sso_url", idp.Protocol.Endpoints.Sso.Url)
+		_ = d.Set("sso_binding", idp.Protocol.Endpoints.Sso.Binding)
+		_ = d.Set("sso_destination", idp.Protocol.Endpoints.Sso.Destination)
+	}
+	if idp.Protocol.Settings != nil && idp.Protocol.Settings.NameFormat != "" {
+		_ = d.Set("name_format", idp.Protocol.Settings.NameFormat)
+	}
 	_ = d.Set("max_clock_skew", idp.Policy.MaxClockSkew)
 	_ = d.Set("provisioning_action", idp.Policy.Provisioning.Action)
 	_ = d.Set("deprovisioned_action", idp.Policy.Provisioning.Conditions.Deprovisioned.Action)
@@ -141,8 +152,10 @@ func resourceIdpSamlRead(ctx context.Context, d *schema.ResourceData, m interfac
 	}
 	if idp.Policy.AccountLink != nil {
 		_ = d.Set("account_link_action", idp.Policy.AccountLink.Action)
-		if idp.Policy.AccountLink.Filter != nil {
+		if idp.Policy.AccountLink.Filter != nil && idp.Policy.AccountLink.Filter.Groups != nil {
 			setMap["account_link_group_include"] = convertStringSetToInterface(idp.Policy.AccountLink.Filter.Groups.Include)
+		} else {
+			setMap["account_link_group_include"] = convertStringSetToInterface([]string{})
 		}
 	}
 	err = setNonPrimitives(d, setMap)
@@ -206,6 +219,9 @@ func buildIdPSaml(d *schema.ResourceData) (okta.IdentityProvider, error) {
 					Url:         d.Get("sso_url").(string),
 				},
 			},
+			Settings: &okta.ProtocolSettings{
+				NameFormat: d.Get("name_format").(string),
+			},
 			Type: saml2Idp,
 			Credentials: &okta.IdentityProviderCredentials{
 				Trust: &okta.IdentityProviderCredentialsTrust{
@@ -74,8 +74,12 @@ func resourceIdpSigningKeyRead(ctx context.Context, d *schema.ResourceData, m in
 		d.SetId("")
 		return nil
 	}
-	_ = d.Set("created", key.Created.UTC().String())
-	_ = d.Set("expires_at", key.ExpiresAt.UTC().String())
+	if key.Created != nil {
+		_ = d.Set("created", key.Created.UTC().String())
+	}
+	if key.ExpiresAt != nil {
+		_ = d.Set("expires_at", key.ExpiresAt.UTC().String())
+	}
 	_ = d.Set("kid", key.Kid)
 	_ = d.Set("kty", key.Kty)
 	_ = d.Set("use", key.Use)
@@ -90,8 +94,8 @@ func resourceIdpSigningKeyRead(ctx context.Context, d *schema.ResourceData, m in
 }
 
 func resourceIdpSigningKeyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	_, err := getOktaClientFromMetadata(m).IdentityProvider.DeleteIdentityProviderKey(ctx, d.Id())
-	if err != nil {
+	resp, err := getOktaClientFromMetadata(m).IdentityProvider.DeleteIdentityProviderKey(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
 		return diag.Errorf("failed to delete identity provider signing key: %v", err)
 	}
 	return nil
@@ -0,0 +1,75 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccOktaIdpSamlKey_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(idpSamlKey)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", idpSamlKey)
+	var kid string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(idpSamlKey, doesIdpSamlKeyExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, doesIdpSamlKeyExist),
+					resource.TestCheckResourceAttr(resourceName, "x5c.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "kty", "RSA"),
+					resource.TestCheckResourceAttr(resourceName, "use", "sig"),
+					resource.TestCheckResourceAttrSet(resourceName, "x5t_s256"),
+					resource.TestCheckResourceAttrSet(resourceName, "expires_at"),
+					resource.TestCheckResourceAttrPair(resourceName, "id", fmt.Sprintf("%s.test", idpSaml), "kid"),
+					func(s *terraform.State) error {
+						kid = s.RootModule().Resources[resourceName].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, doesIdpSamlKeyExist),
+					resource.TestCheckResourceAttrPair(resourceName, "id", fmt.Sprintf("%s.test", idpSaml), "kid"),
+					func(s *terraform.State) error {
+						if s.RootModule().Resources[resourceName].Primary.ID == kid {
+							return fmt.Errorf("signing key was not rotated, still using '%s'", kid)
+						}
+						exists, err := doesIdpSamlKeyExist(kid)
+						if err != nil {
+							return err
+						}
+						if exists {
+							return fmt.Errorf("rotated signing key '%s' still exists", kid)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func doesIdpSamlKeyExist(id string) (bool, error) {
+	client := getOktaClientFromMetadata(testAccProvider.Meta())
+	_, resp, err := client.IdentityProvider.GetIdentityProviderKey(context.Background(), id)
+	return doesResourceExist(resp, err)
+}
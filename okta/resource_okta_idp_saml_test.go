@@ -36,6 +36,8 @@ func TestAccOktaIdpSaml_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "request_signature_scope", "REQUEST"),
 					resource.TestCheckResourceAttr(resourceName, "response_signature_scope", "ANY"),
 					resource.TestCheckResourceAttrSet(resourceName, "kid"),
+					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
+					resource.TestCheckResourceAttr(resourceName, "type", "SAML2"),
 				),
 			},
 			{
@@ -55,8 +57,20 @@ func TestAccOktaIdpSaml_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "request_signature_scope", "REQUEST"),
 					resource.TestCheckResourceAttr(resourceName, "response_signature_scope", "RESPONSE"),
 					resource.TestCheckResourceAttrSet(resourceName, "kid"),
+					resource.TestCheckResourceAttr(resourceName, "status", statusInactive),
+					resource.TestCheckResourceAttr(resourceName, "name_format", "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"),
+					resource.TestCheckResourceAttr(resourceName, "subject_match_type", "EMAIL"),
+					resource.TestCheckResourceAttr(resourceName, "groups_action", "ASSIGN"),
+					resource.TestCheckResourceAttr(resourceName, "groups_assignment.#", "1"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Not returned by the API
+				ImportStateVerifyIgnore: []string{"acs_binding"},
+			},
 		},
 	})
 }
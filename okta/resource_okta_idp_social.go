@@ -2,10 +2,15 @@ package okta
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/terraform-provider-okta/sdk"
 )
 
 func resourceIdpSocial() *schema.Resource {
@@ -17,6 +22,10 @@ func resourceIdpSocial() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customdiff.All(
+			validateIdpGroupsProvisioning,
+			validateIdpSocialApple,
+		),
 		// Note the base schema
 		Schema: buildIdpSchema(map[string]*schema.Schema{
 			"authorization_url":     optURLSchema,
@@ -26,6 +35,7 @@ func resourceIdpSocial() *schema.Resource {
 			"type": {
 				Type:             schema.TypeString,
 				Required:         true,
+				ForceNew:         true,
 				ValidateDiagFunc: elemInSlice([]string{"FACEBOOK", "LINKEDIN", "MICROSOFT", "GOOGLE", "APPLE"}),
 			},
 			"scopes": {
@@ -54,14 +64,38 @@ func resourceIdpSocial() *schema.Resource {
 				Deprecated: "This property was incorrectly added to this resource, you should use \"subject_match_attribute\"",
 			},
 			"client_secret": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Sensitive: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressOmittedIdpSecretDiff,
+				Description:      "Client secret issued by AS for the Okta IdP instance. This will be in plain text in your statefile unless you set omit_secret.",
+			},
+			"omit_secret": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "This tells the provider to persist a SHA-256 hash of the client secret to state instead of the secret itself.",
 			},
 			"max_clock_skew": {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"apple_kid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The Key ID that you obtained from Apple when you created the private key for the client",
+			},
+			"apple_private_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The PKCS #8 encoded private key that you created for the client and downloaded from Apple",
+			},
+			"apple_team_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The Team ID associated with your Apple developer account",
+			},
 			"issuer_mode": {
 				Type:             schema.TypeString,
 				Description:      "Indicates whether Okta uses the original Okta org domain URL, or a custom domain URL",
@@ -75,7 +109,7 @@ func resourceIdpSocial() *schema.Resource {
 
 func resourceIdpSocialCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	idp := buildIdPSocial(d)
-	respIdp, _, err := getOktaClientFromMetadata(m).IdentityProvider.CreateIdentityProvider(ctx, idp)
+	respIdp, _, err := getSupplementFromMetadata(m).CreateIdentityProvider(ctx, idp)
 	if err != nil {
 		return diag.Errorf("failed to create social identity provider: %v", err)
 	}
@@ -88,15 +122,18 @@ func resourceIdpSocialCreate(ctx context.Context, d *schema.ResourceData, m inte
 }
 
 func resourceIdpSocialRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	idp, resp, err := getOktaClientFromMetadata(m).IdentityProvider.GetIdentityProvider(ctx, d.Id())
+	idp, resp, err := getSupplementFromMetadata(m).GetIdentityProvider(ctx, d.Id())
 	if err := suppressErrorOn404(resp, err); err != nil {
-		return diag.Errorf("failed to get SAML identity provider: %v", err)
+		return diag.Errorf("failed to get social identity provider: %v", err)
 	}
 	if idp == nil {
 		d.SetId("")
 		return nil
 	}
 	_ = d.Set("name", idp.Name)
+	_ = d.Set("type", idp.Type)
+	_ = d.Set("status", idp.Status)
+	_ = d.Set("protocol_type", idp.Protocol.Type)
 	_ = d.Set("max_clock_skew", idp.Policy.MaxClockSkew)
 	_ = d.Set("provisioning_action", idp.Policy.Provisioning.Action)
 	_ = d.Set("deprovisioned_action", idp.Policy.Provisioning.Conditions.Deprovisioned.Action)
@@ -106,7 +143,16 @@ func resourceIdpSocialRead(ctx context.Context, d *schema.ResourceData, m interf
 	_ = d.Set("subject_match_attribute", idp.Policy.Subject.MatchAttribute)
 	_ = d.Set("username_template", idp.Policy.Subject.UserNameTemplate.Template)
 	_ = d.Set("client_id", idp.Protocol.Credentials.Client.ClientId)
-	_ = d.Set("client_secret", idp.Protocol.Credentials.Client.ClientSecret)
+	syncIdpSocialSecret(d, idp.Protocol.Credentials.Client.ClientSecret)
+	if idp.Protocol.Endpoints != nil {
+		syncEndpoint("authorization", idp.Protocol.Endpoints.Authorization, d)
+		syncEndpoint("token", idp.Protocol.Endpoints.Token, d)
+	}
+	// the private key is never returned by the API, so the one in the state is kept
+	if idp.Protocol.Credentials.Signing != nil {
+		_ = d.Set("apple_kid", idp.Protocol.Credentials.Signing.Kid)
+		_ = d.Set("apple_team_id", idp.Protocol.Credentials.Signing.TeamId)
+	}
 
 	err = syncGroupActions(d, idp.Policy.Provisioning.Groups)
 	if err != nil {
@@ -124,8 +170,10 @@ func resourceIdpSocialRead(ctx context.Context, d *schema.ResourceData, m interf
 	if idp.Policy.AccountLink != nil {
 		_ = d.Set("account_link_action", idp.Policy.AccountLink.Action)
 
-		if idp.Policy.AccountLink.Filter != nil {
+		if idp.Policy.AccountLink.Filter != nil && idp.Policy.AccountLink.Filter.Groups != nil {
 			setMap["account_link_group_include"] = convertStringSetToInterface(idp.Policy.AccountLink.Filter.Groups.Include)
+		} else {
+			setMap["account_link_group_include"] = convertStringSetToInterface([]string{})
 		}
 	}
 	err = setNonPrimitives(d, setMap)
@@ -137,7 +185,15 @@ func resourceIdpSocialRead(ctx context.Context, d *schema.ResourceData, m interf
 
 func resourceIdpSocialUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	idp := buildIdPSocial(d)
-	_, _, err := getOktaClientFromMetadata(m).IdentityProvider.UpdateIdentityProvider(ctx, d.Id(), idp)
+	// the state only has the hash of the secret when it has not changed, so the secret is sent back as it is
+	if isIdpSecretHash(idp.Protocol.Credentials.Client.ClientSecret) {
+		current, _, err := getSupplementFromMetadata(m).GetIdentityProvider(ctx, d.Id())
+		if err != nil {
+			return diag.Errorf("failed to get social identity provider: %v", err)
+		}
+		idp.Protocol.Credentials.Client.ClientSecret = current.Protocol.Credentials.Client.ClientSecret
+	}
+	_, _, err := getSupplementFromMetadata(m).UpdateIdentityProvider(ctx, d.Id(), idp)
 	if err != nil {
 		return diag.Errorf("failed to update social identity provider: %v", err)
 	}
@@ -148,27 +204,31 @@ func resourceIdpSocialUpdate(ctx context.Context, d *schema.ResourceData, m inte
 	return resourceIdpSocialRead(ctx, d, m)
 }
 
-func buildIdPSocial(d *schema.ResourceData) okta.IdentityProvider {
-	return okta.IdentityProvider{
-		Name:       d.Get("name").(string),
-		Type:       d.Get("type").(string),
-		IssuerMode: d.Get("issuer_mode").(string),
-		Policy: &okta.IdentityProviderPolicy{
-			AccountLink:  buildPolicyAccountLink(d),
-			MaxClockSkew: int64(d.Get("max_clock_skew").(int)),
-			Provisioning: buildIdPProvisioning(d),
-			Subject: &okta.PolicySubject{
-				MatchType:      d.Get("subject_match_type").(string),
-				MatchAttribute: d.Get("subject_match_attribute").(string),
-				UserNameTemplate: &okta.PolicyUserNameTemplate{
-					Template: d.Get("username_template").(string),
+func buildIdPSocial(d *schema.ResourceData) sdk.IdentityProvider {
+	idp := sdk.IdentityProvider{
+		IdentityProvider: okta.IdentityProvider{
+			Name:       d.Get("name").(string),
+			Type:       d.Get("type").(string),
+			IssuerMode: d.Get("issuer_mode").(string),
+			Policy: &okta.IdentityProviderPolicy{
+				AccountLink:  buildPolicyAccountLink(d),
+				MaxClockSkew: int64(d.Get("max_clock_skew").(int)),
+				Provisioning: buildIdPProvisioning(d),
+				Subject: &okta.PolicySubject{
+					MatchType:      d.Get("subject_match_type").(string),
+					MatchAttribute: d.Get("subject_match_attribute").(string),
+					UserNameTemplate: &okta.PolicyUserNameTemplate{
+						Template: d.Get("username_template").(string),
+					},
 				},
 			},
 		},
-		Protocol: &okta.Protocol{
-			Scopes: convertInterfaceToStringSet(d.Get("scopes")),
-			Type:   d.Get("protocol_type").(string),
-			Credentials: &okta.IdentityProviderCredentials{
+		Protocol: &sdk.Protocol{
+			Protocol: okta.Protocol{
+				Scopes: convertInterfaceToStringSet(d.Get("scopes")),
+				Type:   d.Get("protocol_type").(string),
+			},
+			Credentials: &sdk.IdentityProviderCredentials{
 				Client: &okta.IdentityProviderCredentialsClient{
 					ClientId:     d.Get("client_id").(string),
 					ClientSecret: d.Get("client_secret").(string),
@@ -176,4 +236,59 @@ func buildIdPSocial(d *schema.ResourceData) okta.IdentityProvider {
 			},
 		},
 	}
+	if d.Get("type").(string) == "APPLE" {
+		idp.Protocol.Credentials.Signing = &sdk.IdentityProviderCredentialsSigning{
+			Kid:        d.Get("apple_kid").(string),
+			PrivateKey: d.Get("apple_private_key").(string),
+			TeamId:     d.Get("apple_team_id").(string),
+		}
+	}
+	return idp
+}
+
+// validateIdpSocialApple ensures that the Apple specific signing parameters are only used for the Apple IdP.
+func validateIdpSocialApple(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	isApple := d.Get("type").(string) == "APPLE"
+	for _, key := range []string{"apple_kid", "apple_private_key", "apple_team_id"} {
+		if !d.NewValueKnown(key) {
+			continue
+		}
+		set := d.Get(key).(string) != ""
+		if isApple && !set {
+			return fmt.Errorf("'%s' is required when 'type' is 'APPLE'", key)
+		}
+		if !isApple && set {
+			return fmt.Errorf("'%s' can only be set when 'type' is 'APPLE'", key)
+		}
+	}
+	return nil
+}
+
+// syncIdpSocialSecret sets the client secret returned by the API, or its hash when the secret is omitted from the
+// state. The secret that is already in the state is kept when the API does not return it.
+func syncIdpSocialSecret(d *schema.ResourceData, secret string) {
+	if secret == "" {
+		secret = d.Get("client_secret").(string)
+	}
+	if d.Get("omit_secret").(bool) && secret != "" && !isIdpSecretHash(secret) {
+		secret = hashIdpSecret(secret)
+	}
+	_ = d.Set("client_secret", secret)
+}
+
+// suppressOmittedIdpSecretDiff compares the configured client secret to the hash in the state when the secret is
+// omitted from the state, so that the changed secrets are still updated.
+func suppressOmittedIdpSecretDiff(_, old, new string, d *schema.ResourceData) bool {
+	return d.Get("omit_secret").(bool) && old != "" && old == hashIdpSecret(new)
+}
+
+const idpSecretHashPrefix = "sha256:"
+
+func hashIdpSecret(secret string) string {
+	h := sha256.Sum256([]byte(secret))
+	return idpSecretHashPrefix + hex.EncodeToString(h[:])
+}
+
+func isIdpSecretHash(secret string) bool {
+	return len(secret) == len(idpSecretHashPrefix)+2*sha256.Size && secret[:len(idpSecretHashPrefix)] == idpSecretHashPrefix
 }
@@ -13,6 +13,7 @@ func TestAccOktaIdpSocial_crud(t *testing.T) {
 	mgr := newFixtureManager(idpSocial)
 	config := mgr.GetFixtures("basic.tf", ri, t)
 	disabledConf := mgr.GetFixtures("auto_provision_disabled.tf", ri, t)
+	updatedConf := mgr.GetFixtures("basic_updated.tf", ri, t)
 	fbName := fmt.Sprintf("%s.facebook", idpSocial)
 	microName := fmt.Sprintf("%s.microsoft", idpSocial)
 	googleName := fmt.Sprintf("%s.google", idpSocial)
@@ -61,6 +62,68 @@ func TestAccOktaIdpSocial_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(googleName, "provisioning_action", "DISABLED"),
 				),
 			},
+			{
+				Config: updatedConf,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(googleName, "name", fmt.Sprintf("testAcc_google_%d", ri)),
+					resource.TestCheckResourceAttr(googleName, "client_id", "efgh456"),
+					resource.TestCheckResourceAttr(googleName, "client_secret", hashIdpSecret("efgh456")),
+					resource.TestCheckResourceAttr(googleName, "omit_secret", "true"),
+					resource.TestCheckResourceAttr(googleName, "scopes.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOktaIdpSocial_apple(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(idpSocial)
+	config := mgr.GetFixtures("apple.tf", ri, t)
+	appleName := fmt.Sprintf("%s.apple", idpSocial)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(idpSocial, createDoesIdpExist()),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(appleName, "type", "APPLE"),
+					resource.TestCheckResourceAttr(appleName, "protocol_type", "OIDC"),
+					resource.TestCheckResourceAttr(appleName, "name", fmt.Sprintf("testAcc_apple_%d", ri)),
+					resource.TestCheckResourceAttr(appleName, "client_id", fmt.Sprintf("com.example.%d", ri)),
+					resource.TestCheckResourceAttr(appleName, "apple_kid", "TEST123456"),
+					resource.TestCheckResourceAttr(appleName, "apple_team_id", "TEAM123456"),
+					resource.TestCheckResourceAttrSet(appleName, "apple_private_key"),
+					resource.TestCheckResourceAttr(appleName, "scopes.#", "3"),
+				),
+			},
 		},
 	})
 }
+
+func TestSuppressOmittedIdpSecretDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		omit     bool
+		old      string
+		new      string
+		expected bool
+	}{
+		{"not omitted", false, hashIdpSecret("abcd123"), "abcd123", false},
+		{"same secret", true, hashIdpSecret("abcd123"), "abcd123", true},
+		{"rotated secret", true, hashIdpSecret("abcd123"), "efgh456", false},
+		{"secret not in state", true, "", "", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d := resourceIdpSocial().TestResourceData()
+			_ = d.Set("omit_secret", test.omit)
+			if got := suppressOmittedIdpSecretDiff("client_secret", test.old, test.new, d); got != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
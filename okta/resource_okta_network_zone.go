@@ -20,6 +20,12 @@ func resourceNetworkZone() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
+			"asns": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Array of Autonomous System Numbers of the dynamic zone",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"dynamic_locations": {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -49,6 +55,13 @@ func resourceNetworkZone() *schema.Resource {
 				Description: "Array of values in CIDR/range form depending on the way it's been declared (i.e. CIDR will contain /suffix). Please check API docs for examples",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          statusActive,
+				ValidateDiagFunc: elemInSlice([]string{statusActive, statusInactive}),
+				Description:      "Network Zone Status: ACTIVE or INACTIVE",
+			},
 			"type": {
 				Type:             schema.TypeString,
 				Required:         true,
@@ -77,6 +90,12 @@ func resourceNetworkZoneCreate(ctx context.Context, d *schema.ResourceData, m in
 		return diag.Errorf("failed to create network zone: %v", err)
 	}
 	d.SetId(networkZone.ID)
+	if networkZone.Status != d.Get("status").(string) {
+		err = setNetworkZoneStatus(ctx, d, m)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	return resourceNetworkZoneRead(ctx, d, m)
 }
 
@@ -93,7 +112,9 @@ func resourceNetworkZoneRead(ctx context.Context, d *schema.ResourceData, m inte
 	_ = d.Set("type", zone.Type)
 	_ = d.Set("usage", zone.Usage)
 	_ = d.Set("dynamic_proxy_type", zone.ProxyType)
+	_ = d.Set("status", zone.Status)
 	err = setNonPrimitives(d, map[string]interface{}{
+		"asns":              convertStringSetToInterface(zone.Asns),
 		"gateways":          flattenAddresses(zone.Gateways),
 		"proxies":           flattenAddresses(zone.Proxies),
 		"dynamic_locations": flattenDynamicLocations(zone.Locations),
@@ -114,10 +135,22 @@ func resourceNetworkZoneUpdate(ctx context.Context, d *schema.ResourceData, m in
 	if err != nil {
 		return diag.Errorf("failed to update network zone: %v", err)
 	}
+	if d.HasChange("status") {
+		err = setNetworkZoneStatus(ctx, d, m)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	return resourceNetworkZoneRead(ctx, d, m)
 }
 
 func resourceNetworkZoneDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.Get("status").(string) == statusActive {
+		resp, err := getSupplementFromMetadata(m).DeactivateNetworkZone(ctx, d.Id())
+		if err := suppressErrorOn404(resp, err); err != nil {
+			return diag.Errorf("failed to deactivate network zone: %v", err)
+		}
+	}
 	resp, err := getSupplementFromMetadata(m).DeleteNetworkZone(ctx, d.Id())
 	if err := suppressErrorOn404(resp, err); err != nil {
 		return diag.Errorf("failed to delete network zone: %v", err)
@@ -129,6 +162,7 @@ func buildNetworkZone(d *schema.ResourceData) *sdk.NetworkZone {
 	var gatewaysList []*sdk.AddressObj
 	var proxiesList []*sdk.AddressObj
 	var locationsList []*sdk.Location
+	var asns []string
 	zoneType := d.Get("type").(string)
 	proxyType := d.Get("dynamic_proxy_type").(string)
 
@@ -139,17 +173,21 @@ func buildNetworkZone(d *schema.ResourceData) *sdk.NetworkZone {
 		if values, ok := d.GetOk("proxies"); ok {
 			proxiesList = buildAddressObjList(values.(*schema.Set))
 		}
-	} else if values, ok := d.GetOk("dynamic_locations"); ok {
-		for _, value := range values.(*schema.Set).List() {
-			if strings.Contains(value.(string), "-") {
-				locationsList = append(locationsList, &sdk.Location{Country: strings.Split(value.(string), "-")[0], Region: value.(string)})
-			} else {
-				locationsList = append(locationsList, &sdk.Location{Country: value.(string)})
+	} else {
+		if values, ok := d.GetOk("dynamic_locations"); ok {
+			for _, value := range values.(*schema.Set).List() {
+				if strings.Contains(value.(string), "-") {
+					locationsList = append(locationsList, &sdk.Location{Country: strings.Split(value.(string), "-")[0], Region: value.(string)})
+				} else {
+					locationsList = append(locationsList, &sdk.Location{Country: value.(string)})
+				}
 			}
 		}
+		asns = convertInterfaceToStringSetNullable(d.Get("asns"))
 	}
 
 	return &sdk.NetworkZone{
+		Asns:      asns,
 		Name:      d.Get("name").(string),
 		Type:      zoneType,
 		Gateways:  gatewaysList,
@@ -194,10 +232,27 @@ func flattenDynamicLocations(locations []*sdk.Location) interface{} {
 	return schema.NewSet(schema.HashString, arr)
 }
 
+func setNetworkZoneStatus(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	var err error
+	if d.Get("status").(string) == statusActive {
+		_, err = getSupplementFromMetadata(m).ActivateNetworkZone(ctx, d.Id())
+	} else {
+		_, err = getSupplementFromMetadata(m).DeactivateNetworkZone(ctx, d.Id())
+	}
+	if err != nil {
+		return fmt.Errorf("failed to change network zone status: %v", err)
+	}
+	return nil
+}
+
 func validateNetworkZone(d *schema.ResourceData) error {
 	proxies, ok := d.GetOk("proxies")
 	if d.Get("usage").(string) != "POLICY" && ok && proxies.(*schema.Set).Len() != 0 {
 		return fmt.Errorf(`zones with usage = "BLOCKLIST" cannot have trusted proxies`)
 	}
+	asns, ok := d.GetOk("asns")
+	if d.Get("type").(string) != "DYNAMIC" && ok && asns.(*schema.Set).Len() != 0 {
+		return fmt.Errorf(`only zones with type = "DYNAMIC" can have ASNs`)
+	}
 	return nil
 }
@@ -50,6 +50,9 @@ func TestAccOktaNetworkZone_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(dynamicResourceName, "name", fmt.Sprintf("testAcc_%d Dynamic", ri)),
 					resource.TestCheckResourceAttr(dynamicResourceName, "type", "DYNAMIC"),
 					resource.TestCheckResourceAttr(dynamicResourceName, "dynamic_locations.#", "2"),
+					resource.TestCheckResourceAttr(dynamicResourceName, "asns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
+					resource.TestCheckResourceAttr(fmt.Sprintf("%s.dynamic_proxy_example", networkZone), "status", statusInactive),
 				),
 			},
 			{
@@ -63,6 +66,8 @@ func TestAccOktaNetworkZone_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(dynamicResourceName, "name", fmt.Sprintf("testAcc_%d Dynamic Updated", ri)),
 					resource.TestCheckResourceAttr(dynamicResourceName, "type", "DYNAMIC"),
 					resource.TestCheckResourceAttr(dynamicResourceName, "dynamic_locations.#", "3"),
+					resource.TestCheckResourceAttr(dynamicResourceName, "asns.#", "2"),
+					resource.TestCheckResourceAttr(fmt.Sprintf("%s.dynamic_proxy_example", networkZone), "status", statusActive),
 				),
 			},
 		},
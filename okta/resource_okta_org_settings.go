@@ -0,0 +1,119 @@
+package okta
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceOrgSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceOrgSettingsCreateOrUpdate,
+		ReadContext:   resourceOrgSettingsRead,
+		UpdateContext: resourceOrgSettingsCreateOrUpdate,
+		DeleteContext: resourceOrgSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				id, _, err := getSupplementFromMetadata(m).GetOrgID(ctx)
+				if err != nil {
+					return nil, err
+				}
+				d.SetId(id)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"show_end_user_footer": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Show footer on the end-user dashboard",
+			},
+			"opt_out_communication_emails": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Opt out the users of the org from Okta communication emails",
+			},
+			"okta_support_access": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Grant Okta Support temporary access to the org as an administrator. The access is granted once, it is not granted again after it expires",
+			},
+			"okta_support_access_expiration": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiration of the Okta Support access to the org",
+			},
+		},
+	}
+}
+
+func resourceOrgSettingsCreateOrUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	supplement := getSupplementFromMetadata(m)
+	id := d.Id()
+	if id == "" {
+		orgID, _, err := supplement.GetOrgID(ctx)
+		if err != nil {
+			return diag.Errorf("failed to get org: %v", err)
+		}
+		id = orgID
+	}
+	if d.IsNewResource() || d.HasChange("show_end_user_footer") {
+		_, err := supplement.SetOrgEndUserFooter(ctx, d.Get("show_end_user_footer").(bool))
+		if err != nil {
+			return diag.Errorf("failed to set end-user footer visibility: %v", err)
+		}
+	}
+	if d.IsNewResource() || d.HasChange("opt_out_communication_emails") {
+		_, err := supplement.SetOktaCommunicationOptOut(ctx, d.Get("opt_out_communication_emails").(bool))
+		if err != nil {
+			return diag.Errorf("failed to set Okta communication emails settings: %v", err)
+		}
+	}
+	if d.IsNewResource() || d.HasChange("okta_support_access") {
+		_, err := supplement.SetOktaSupportAccess(ctx, d.Get("okta_support_access").(bool))
+		if err != nil {
+			return diag.Errorf("failed to set Okta Support access: %v", err)
+		}
+	}
+	d.SetId(id)
+	return resourceOrgSettingsRead(ctx, d, m)
+}
+
+func resourceOrgSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	supplement := getSupplementFromMetadata(m)
+	preferences, _, err := supplement.GetOrgPreferences(ctx)
+	if err != nil {
+		return diag.Errorf("failed to get org preferences: %v", err)
+	}
+	_ = d.Set("show_end_user_footer", preferences.ShowEndUserFooter)
+	communication, _, err := supplement.GetOktaCommunicationSettings(ctx)
+	if err != nil {
+		return diag.Errorf("failed to get Okta communication emails settings: %v", err)
+	}
+	_ = d.Set("opt_out_communication_emails", communication.OptOutEmailUsers)
+	support, _, err := supplement.GetOktaSupportSettings(ctx)
+	if err != nil {
+		return diag.Errorf("failed to get Okta Support access settings: %v", err)
+	}
+	// Okta Support access expires upstream, so the disabled access is not reported as a drift, otherwise every apply
+	// would grant the access again. The granted access still shows up when it is not wanted anymore.
+	if support.Support == "ENABLED" {
+		_ = d.Set("okta_support_access", true)
+	}
+	if support.Expiration != nil {
+		_ = d.Set("okta_support_access_expiration", support.Expiration.Format(time.RFC3339))
+	} else {
+		_ = d.Set("okta_support_access_expiration", "")
+	}
+	return nil
+}
+
+// Org settings can not be removed, they are left as is
+func resourceOrgSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return nil
+}
@@ -0,0 +1,74 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaOrgSettings(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(orgSettings)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", orgSettings)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "show_end_user_footer", "true"),
+					resource.TestCheckResourceAttr(resourceName, "opt_out_communication_emails", "false"),
+					resource.TestCheckResourceAttr(resourceName, "okta_support_access", "false"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "show_end_user_footer", "false"),
+					resource.TestCheckResourceAttr(resourceName, "opt_out_communication_emails", "true"),
+				),
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "show_end_user_footer", "true"),
+					resource.TestCheckResourceAttr(resourceName, "opt_out_communication_emails", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceOrgSettingsReadExpiredSupportAccess(t *testing.T) {
+	m := newTestOktaClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/org/privacy/oktaSupport":
+			_, _ = w.Write([]byte(`{"support":"DISABLED","expiration":null}`))
+		default:
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}))
+	d := resourceOrgSettings().Data(nil)
+	d.SetId("00o1")
+	_ = d.Set("okta_support_access", true)
+	if diags := resourceOrgSettingsRead(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("failed to read org settings: %v", diags)
+	}
+	if !d.Get("okta_support_access").(bool) {
+		t.Error("expired Okta Support access should not be reported as a drift to avoid granting it on every apply")
+	}
+	if d.Get("okta_support_access_expiration").(string) != "" {
+		t.Errorf("expected empty expiration, got %s", d.Get("okta_support_access_expiration"))
+	}
+}
@@ -0,0 +1,68 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePolicyDeviceAssuranceAndroid() *schema.Resource {
+	return resourcePolicyDeviceAssurance(deviceAssurancePlatformAndroid, "Android",
+		deviceAssuranceDiskEncryptionSchema("FULL", "USER"), deviceAssuranceJailbreakSchema, deviceAssuranceSecureHardwareSchema)
+}
+
+func resourcePolicyDeviceAssuranceIOS() *schema.Resource {
+	return resourcePolicyDeviceAssurance(deviceAssurancePlatformIOS, "iOS", deviceAssuranceJailbreakSchema)
+}
+
+func resourcePolicyDeviceAssuranceMacOS() *schema.Resource {
+	return resourcePolicyDeviceAssurance(deviceAssurancePlatformMacOS, "macOS",
+		deviceAssuranceDiskEncryptionSchema("ALL_INTERNAL_VOLUMES"), deviceAssuranceSecureHardwareSchema)
+}
+
+func resourcePolicyDeviceAssuranceWindows() *schema.Resource {
+	return resourcePolicyDeviceAssurance(deviceAssurancePlatformWindows, "Windows",
+		deviceAssuranceDiskEncryptionSchema("ALL_INTERNAL_VOLUMES"), deviceAssuranceSecureHardwareSchema)
+}
+
+// resourcePolicyDeviceAssurance builds the device assurance policy resource of the platform, the name is the one
+// of the platform used in the descriptions and errors
+func resourcePolicyDeviceAssurance(platform, name string, platformSchemas ...map[string]*schema.Schema) *schema.Resource {
+	read := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		err := readDeviceAssurance(ctx, d, m, platform)
+		if err != nil {
+			return diag.Errorf("failed to get %s device assurance policy: %v", name, err)
+		}
+		return nil
+	}
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			err := createDeviceAssurance(ctx, d, m, platform)
+			if err != nil {
+				return diag.Errorf("failed to create %s device assurance policy: %v", name, err)
+			}
+			return read(ctx, d, m)
+		},
+		ReadContext: read,
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			err := updateDeviceAssurance(ctx, d, m, platform)
+			if err != nil {
+				return diag.Errorf("failed to update %s device assurance policy: %v", name, err)
+			}
+			return read(ctx, d, m)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			err := deleteDeviceAssurance(ctx, d, m)
+			if err != nil {
+				return diag.Errorf("failed to delete %s device assurance policy: %v", name, err)
+			}
+			return nil
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Description: "Manages the device assurance policy for the " + name + " devices.",
+		Schema:      buildSchema(append([]map[string]*schema.Schema{deviceAssuranceSchema}, platformSchemas...)...),
+	}
+}
@@ -0,0 +1,216 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func sweepDeviceAssurances(client *testClient) error {
+	var errorList []error
+	deviceAssurances, _, err := client.apiSupplement.ListDeviceAssurances(context.Background())
+	if err != nil {
+		return err
+	}
+	for _, deviceAssurance := range deviceAssurances {
+		if strings.HasPrefix(deviceAssurance.Name, testResourcePrefix) {
+			if _, err := client.apiSupplement.DeleteDeviceAssurance(context.Background(), deviceAssurance.ID); err != nil {
+				errorList = append(errorList, err)
+			}
+		}
+	}
+	return condenseError(errorList)
+}
+
+func TestAccOktaPolicyDeviceAssuranceAndroid_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(policyDeviceAssuranceAndroid)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", policyDeviceAssuranceAndroid)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(policyDeviceAssuranceAndroid, doesDeviceAssuranceExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "os_version", "12"),
+					resource.TestCheckResourceAttr(resourceName, "disk_encryption_type.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "screenlock_type.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "jailbreak", "false"),
+					resource.TestCheckResourceAttr(resourceName, "secure_hardware_present", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_date"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("%s_updated", buildResourceName(ri))),
+					resource.TestCheckResourceAttr(resourceName, "os_version", "13"),
+					resource.TestCheckResourceAttr(resourceName, "disk_encryption_type.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "screenlock_type.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccOktaPolicyDeviceAssuranceIOS_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(policyDeviceAssuranceIOS)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", policyDeviceAssuranceIOS)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(policyDeviceAssuranceIOS, doesDeviceAssuranceExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "os_version", "12.4.5"),
+					resource.TestCheckResourceAttr(resourceName, "jailbreak", "false"),
+					resource.TestCheckResourceAttr(resourceName, "screenlock_type.#", "1"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("%s_updated", buildResourceName(ri))),
+					resource.TestCheckResourceAttr(resourceName, "os_version", "15.0"),
+					resource.TestCheckResourceAttr(resourceName, "jailbreak", ""),
+					resource.TestCheckResourceAttr(resourceName, "screenlock_type.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccOktaPolicyDeviceAssuranceMacOS_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(policyDeviceAssuranceMacOS)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", policyDeviceAssuranceMacOS)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(policyDeviceAssuranceMacOS, doesDeviceAssuranceExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "os_version", "12.4.5"),
+					resource.TestCheckResourceAttr(resourceName, "disk_encryption_type.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "screenlock_type.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "secure_hardware_present", "true"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("%s_updated", buildResourceName(ri))),
+					resource.TestCheckResourceAttr(resourceName, "os_version", "13.0"),
+					resource.TestCheckResourceAttr(resourceName, "screenlock_type.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccOktaPolicyDeviceAssuranceWindows_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(policyDeviceAssuranceWindows)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", policyDeviceAssuranceWindows)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(policyDeviceAssuranceWindows, doesDeviceAssuranceExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "os_version", "10.0.19041"),
+					resource.TestCheckResourceAttr(resourceName, "disk_encryption_type.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "screenlock_type.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "secure_hardware_present", "true"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("%s_updated", buildResourceName(ri))),
+					resource.TestCheckResourceAttr(resourceName, "os_version", "10.0.19041.1110"),
+					resource.TestCheckResourceAttr(resourceName, "screenlock_type.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func doesDeviceAssuranceExist(id string) (bool, error) {
+	_, response, err := getSupplementFromMetadata(testAccProvider.Meta()).GetDeviceAssurance(context.Background(), id)
+	return doesResourceExist(response, err)
+}
+
+func TestBuildDeviceAssuranceBooleans(t *testing.T) {
+	r := resourcePolicyDeviceAssuranceAndroid()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"name": "test", "jailbreak": "false"})
+	deviceAssurance := buildDeviceAssurance(d, deviceAssurancePlatformAndroid)
+	if deviceAssurance.Jailbreak == nil || *deviceAssurance.Jailbreak {
+		t.Errorf("expected jailbreak set to false to be sent, got %v", deviceAssurance.Jailbreak)
+	}
+	if deviceAssurance.SecureHardwarePresent != nil {
+		t.Errorf("expected unset secure_hardware_present not to be sent, got %v", *deviceAssurance.SecureHardwarePresent)
+	}
+
+	deviceAssurance.Jailbreak = nil
+	deviceAssurance.SecureHardwarePresent = boolPtr(false)
+	if err := syncDeviceAssurance(d, deviceAssurancePlatformAndroid, &deviceAssurance); err != nil {
+		t.Fatal(err)
+	}
+	if actual := d.Get("jailbreak").(string); actual != "" {
+		t.Errorf("expected jailbreak not set by Okta to be read as unset, got %q", actual)
+	}
+	if actual := d.Get("secure_hardware_present").(string); actual != "false" {
+		t.Errorf("expected secure_hardware_present to be read as false, got %q", actual)
+	}
+}
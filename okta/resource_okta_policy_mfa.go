@@ -20,7 +20,8 @@ func resourcePolicyMfa() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: buildPolicySchema(buildFactorProviders()),
+		CustomizeDiff: markIncludedGroupIDsComputed,
+		Schema:        buildPolicySchema(buildFactorProviders()),
 	}
 }
 
@@ -56,7 +57,7 @@ func resourcePolicyMfaRead(ctx context.Context, d *schema.ResourceData, m interf
 	syncFactor(d, sdk.SymantecVipFactor, policy.Settings.Factors.SymantecVip)
 	syncFactor(d, sdk.YubikeyTokenFactor, policy.Settings.Factors.YubikeyToken)
 	syncFactor(d, sdk.HotpFactor, policy.Settings.Factors.Hotp)
-	err = syncPolicyFromUpstream(d, policy)
+	err = syncPolicyFromUpstream(ctx, d, m, policy)
 	if err != nil {
 		return diag.Errorf("failed to sync policy: %v", err)
 	}
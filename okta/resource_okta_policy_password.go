@@ -18,6 +18,7 @@ func resourcePolicyPassword() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: markIncludedGroupIDsComputed,
 		Schema: buildPolicySchema(map[string]*schema.Schema{
 			"auth_provider": {
 				Type:             schema.TypeString,
@@ -215,9 +216,7 @@ func resourcePolicyPasswordRead(ctx context.Context, d *schema.ResourceData, m i
 		_ = d.Set("password_min_number", policy.Settings.Password.Complexity.MinNumber)
 		_ = d.Set("password_min_symbol", policy.Settings.Password.Complexity.MinSymbol)
 		_ = d.Set("password_exclude_username", policy.Settings.Password.Complexity.ExcludeUsername)
-		if policy.Settings.Password.Complexity.Dictionary != nil && policy.Settings.Password.Complexity.Dictionary.Common != nil {
-			_ = d.Set("password_dictionary_lookup", policy.Settings.Password.Complexity.Dictionary.Common.Exclude)
-		}
+		_ = d.Set("password_dictionary_lookup", isCommonPasswordExcluded(policy.Settings.Password.Complexity.Dictionary))
 		_ = d.Set("password_max_age_days", policy.Settings.Password.Age.MaxAgeDays)
 		_ = d.Set("password_expire_warn_days", policy.Settings.Password.Age.ExpireWarnDays)
 		_ = d.Set("password_min_age_minutes", policy.Settings.Password.Age.MinAgeMinutes)
@@ -233,19 +232,12 @@ func resourcePolicyPasswordRead(ctx context.Context, d *schema.ResourceData, m i
 		_ = d.Set("call_recovery", policy.Settings.Recovery.Factors.OktaCall.Status)
 		_ = d.Set("skip_unlock", policy.Settings.Delegation.Options.SkipUnlock)
 
+		// both are set, so that the attributes no longer excluded in Okta are detected as drift
 		excludedAttrs := policy.Settings.Password.Complexity.ExcludeAttributes
-		if len(excludedAttrs) > 0 {
-			for _, v := range excludedAttrs {
-				switch v {
-				case "firstName":
-					_ = d.Set("password_exclude_first_name", true)
-				case "lastName":
-					_ = d.Set("password_exclude_last_name", true)
-				}
-			}
-		}
+		_ = d.Set("password_exclude_first_name", contains(excludedAttrs, "firstName"))
+		_ = d.Set("password_exclude_last_name", contains(excludedAttrs, "lastName"))
 	}
-	err = syncPolicyFromUpstream(d, policy)
+	err = syncPolicyFromUpstream(ctx, d, m, policy)
 	if err != nil {
 		return diag.Errorf("failed to set password policy: %v", err)
 	}
@@ -362,3 +354,11 @@ func getExcludedAttrs(excludeFirstName, excludeLastName bool) []string {
 	}
 	return excludedAttrs
 }
+
+// isCommonPasswordExcluded Okta omits the dictionary settings when the common password check is disabled
+func isCommonPasswordExcluded(dictionary *okta.PasswordDictionary) bool {
+	if dictionary == nil || dictionary.Common == nil || dictionary.Common.Exclude == nil {
+		return false
+	}
+	return *dictionary.Common.Exclude
+}
@@ -214,9 +214,7 @@ func resourcePolicyPasswordDefaultRead(ctx context.Context, d *schema.ResourceDa
 	if err != nil {
 		return diag.Errorf("error setting notification channels for resource %s: %v", d.Id(), err)
 	}
-	if policy.Settings.Password.Complexity.Dictionary != nil && policy.Settings.Password.Complexity.Dictionary.Common != nil {
-		_ = d.Set("password_dictionary_lookup", policy.Settings.Password.Complexity.Dictionary.Common.Exclude)
-	}
+	_ = d.Set("password_dictionary_lookup", isCommonPasswordExcluded(policy.Settings.Password.Complexity.Dictionary))
 	_ = d.Set("password_min_length", policy.Settings.Password.Complexity.MinLength)
 	_ = d.Set("password_min_lowercase", policy.Settings.Password.Complexity.MinLowerCase)
 	_ = d.Set("password_min_uppercase", policy.Settings.Password.Complexity.MinUpperCase)
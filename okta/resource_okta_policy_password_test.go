@@ -71,6 +71,7 @@ func TestAccOktaPolicyPassword_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "password_exclude_username", "false"),
 					resource.TestCheckResourceAttr(resourceName, "password_exclude_first_name", "true"),
 					resource.TestCheckResourceAttr(resourceName, "password_exclude_last_name", "true"),
+					resource.TestCheckResourceAttr(resourceName, "password_dictionary_lookup", "true"),
 					resource.TestCheckResourceAttr(resourceName, "password_max_age_days", "60"),
 					resource.TestCheckResourceAttr(resourceName, "password_expire_warn_days", "15"),
 					resource.TestCheckResourceAttr(resourceName, "password_min_age_minutes", "60"),
@@ -85,6 +86,20 @@ func TestAccOktaPolicyPassword_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "call_recovery", statusActive),
 				),
 			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensurePolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
+					resource.TestCheckResourceAttr(resourceName, "password_exclude_first_name", "false"),
+					resource.TestCheckResourceAttr(resourceName, "password_exclude_last_name", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
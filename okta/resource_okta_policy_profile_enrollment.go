@@ -0,0 +1,91 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourcePolicyProfileEnrollment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePolicyProfileEnrollmentCreate,
+		ReadContext:   resourcePolicyProfileEnrollmentRead,
+		UpdateContext: resourcePolicyProfileEnrollmentUpdate,
+		DeleteContext: resourcePolicyProfileEnrollmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Policy Name",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Policy Description",
+			},
+			"status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          statusActive,
+				ValidateDiagFunc: elemInSlice([]string{statusActive, statusInactive}),
+				Description:      "Policy Status: ACTIVE or INACTIVE.",
+			},
+		},
+	}
+}
+
+func resourcePolicyProfileEnrollmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	template := buildPolicyProfileEnrollment(d)
+	err := createPolicy(ctx, d, m, template)
+	if err != nil {
+		return diag.Errorf("failed to create profile enrollment policy: %v", err)
+	}
+	return resourcePolicyProfileEnrollmentRead(ctx, d, m)
+}
+
+func resourcePolicyProfileEnrollmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	policy, err := getPolicy(ctx, d, m)
+	if err != nil {
+		return diag.Errorf("failed to get profile enrollment policy: %v", err)
+	}
+	if policy == nil {
+		return nil
+	}
+	_ = d.Set("name", policy.Name)
+	_ = d.Set("description", policy.Description)
+	_ = d.Set("status", policy.Status)
+	return nil
+}
+
+func resourcePolicyProfileEnrollmentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	template := buildPolicyProfileEnrollment(d)
+	err := updatePolicy(ctx, d, m, template)
+	if err != nil {
+		return diag.Errorf("failed to update profile enrollment policy: %v", err)
+	}
+	return resourcePolicyProfileEnrollmentRead(ctx, d, m)
+}
+
+func resourcePolicyProfileEnrollmentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	err := deletePolicy(ctx, d, m)
+	if err != nil {
+		return diag.Errorf("failed to delete profile enrollment policy: %v", err)
+	}
+	return nil
+}
+
+// create or update a profile enrollment policy
+func buildPolicyProfileEnrollment(d *schema.ResourceData) sdk.Policy {
+	template := sdk.ProfileEnrollmentPolicy()
+	template.Name = d.Get("name").(string)
+	template.Status = d.Get("status").(string)
+	if description, ok := d.GetOk("description"); ok {
+		template.Description = description.(string)
+	}
+	return template
+}
@@ -18,13 +18,15 @@ func resourcePolicyRuleIdpDiscovery() *schema.Resource {
 		Importer:      createPolicyRuleImporter(),
 		Schema: buildBaseRuleSchema(map[string]*schema.Schema{
 			"idp_id": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The identifier for the Idp the rule should route to if all conditions are met",
 			},
 			"idp_type": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "OKTA",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "OKTA",
+				Description: "Type of the Idp the rule should route to, e.g. 'OKTA' or 'SAML2'",
 			},
 			"app_include": {
 				Type:        schema.TypeSet,
@@ -107,17 +109,31 @@ func resourcePolicyRuleIdpDiscoveryRead(ctx context.Context, d *schema.ResourceD
 	_ = d.Set("name", rule.Name)
 	_ = d.Set("status", rule.Status)
 	_ = d.Set("priority", rule.Priority)
-	_ = d.Set("user_identifier_attribute", rule.Conditions.UserIdentifier.Attribute)
-	_ = d.Set("user_identifier_type", rule.Conditions.UserIdentifier.Type)
-	_ = d.Set("network_connection", rule.Conditions.Network.Connection)
-	err = setNonPrimitives(d, map[string]interface{}{
-		"network_includes":         convertStringArrToInterface(rule.Conditions.Network.Include),
-		"network_excludes":         convertStringArrToInterface(rule.Conditions.Network.Exclude),
-		"platform_include":         flattenPlatformInclude(rule.Conditions.Platform),
-		"user_identifier_patterns": flattenUserIDPatterns(rule.Conditions.UserIdentifier.Patterns),
-		"app_include":              flattenAppInclude(rule.Conditions.App),
-		"app_exclude":              flattenAppExclude(rule.Conditions.App),
-	})
+	// the target IdP is set, so that the routing changed in Okta is detected
+	if rule.Actions != nil && rule.Actions.IDP != nil && len(rule.Actions.IDP.Providers) > 0 {
+		_ = d.Set("idp_id", rule.Actions.IDP.Providers[0].ID)
+		_ = d.Set("idp_type", rule.Actions.IDP.Providers[0].Type)
+	}
+	attrs := map[string]interface{}{
+		"platform_include": flattenPlatformInclude(rule.Conditions.Platform),
+		"app_include":      flattenAppInclude(rule.Conditions.App),
+		"app_exclude":      flattenAppExclude(rule.Conditions.App),
+	}
+	if rule.Conditions.UserIdentifier != nil {
+		_ = d.Set("user_identifier_attribute", rule.Conditions.UserIdentifier.Attribute)
+		_ = d.Set("user_identifier_type", rule.Conditions.UserIdentifier.Type)
+		attrs["user_identifier_patterns"] = flattenUserIDPatterns(rule.Conditions.UserIdentifier.Patterns)
+	} else {
+		_ = d.Set("user_identifier_attribute", "")
+		_ = d.Set("user_identifier_type", "")
+		attrs["user_identifier_patterns"] = flattenUserIDPatterns(nil)
+	}
+	if rule.Conditions.Network != nil {
+		_ = d.Set("network_connection", rule.Conditions.Network.Connection)
+		attrs["network_includes"] = convertStringArrToInterface(rule.Conditions.Network.Include)
+		attrs["network_excludes"] = convertStringArrToInterface(rule.Conditions.Network.Exclude)
+	}
+	err = setNonPrimitives(d, attrs)
 	if err != nil {
 		return diag.Errorf("failed to set IDP discovery policy rule properties: %v", err)
 	}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/okta/terraform-provider-okta/sdk"
 )
 
@@ -22,6 +23,7 @@ func TestAccOktaPolicyRuleIdpDiscovery_crud(t *testing.T) {
 	ri2 := acctest.RandInt()
 	appIncludeConfig := mgr.GetFixtures("app_include.tf", ri2, t)
 	appExcludeConfig := mgr.GetFixtures("app_exclude_platform.tf", ri2, t)
+	samlIdpConfig := mgr.GetFixtures("saml_idp.tf", ri2, t)
 	resourceName := fmt.Sprintf("%s.test", policyRuleIdpDiscovery)
 
 	resource.Test(t, resource.TestCase{
@@ -80,6 +82,32 @@ func TestAccOktaPolicyRuleIdpDiscovery_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "platform_include.#", "1"),
 				),
 			},
+			{
+				Config: samlIdpConfig,
+				Check: resource.ComposeTestCheckFunc(
+					ensureRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "idp_type", "SAML2"),
+					resource.TestCheckResourceAttrPair(resourceName, "idp_id", fmt.Sprintf("%s.test", idpSaml), "id"),
+					resource.TestCheckResourceAttr(resourceName, "network_connection", "ZONE"),
+					resource.TestCheckResourceAttr(resourceName, "network_includes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "app_include.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "platform_include.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "user_identifier_patterns.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("failed to find %s", resourceName)
+					}
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["policy_id"], rs.Primary.ID), nil
+				},
+				ImportStateVerifyIgnore: []string{"policyid"},
+			},
 		},
 	})
 }
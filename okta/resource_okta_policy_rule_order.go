@@ -0,0 +1,150 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourcePolicyRuleOrder() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePolicyRuleOrderCreate,
+		ReadContext:   resourcePolicyRuleOrderRead,
+		UpdateContext: resourcePolicyRuleOrderUpdate,
+		DeleteContext: resourcePolicyRuleOrderDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				_ = d.Set("policy_id", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Description: "Resource to keep the rules of a policy in the declared order.",
+		Schema: map[string]*schema.Schema{
+			"policy_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the policy the rules belong to.",
+			},
+			"rule_ids": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "IDs of the policy rules in the order of their priority, the first rule gets the priority 1.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourcePolicyRuleOrderCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	err := setPolicyRuleOrder(ctx, m, d.Get("policy_id").(string), convertInterfaceToStringArr(d.Get("rule_ids")))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(d.Get("policy_id").(string))
+	return resourcePolicyRuleOrderRead(ctx, d, m)
+}
+
+// resourcePolicyRuleOrderRead sorts the tracked rules by their current priorities, so that any rule moved
+// outside of this resource causes a diff. On import all the rules of the policy except the default one are tracked.
+func resourcePolicyRuleOrderRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	policyID := d.Get("policy_id").(string)
+	policy, resp, err := getSupplementFromMetadata(m).GetPolicy(ctx, policyID)
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get policy: %v", err)
+	}
+	if policy == nil {
+		d.SetId("")
+		return nil
+	}
+	rules, _, err := getSupplementFromMetadata(m).ListPolicyRules(ctx, policyID)
+	if err != nil {
+		return diag.Errorf("failed to list policy rules: %v", err)
+	}
+	ruleIDs := orderedPolicyRuleIDs(rules, convertInterfaceToStringArr(d.Get("rule_ids")))
+	_ = d.Set("rule_ids", convertStringArrToInterface(ruleIDs))
+	return nil
+}
+
+func resourcePolicyRuleOrderUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	err := setPolicyRuleOrder(ctx, m, d.Get("policy_id").(string), convertInterfaceToStringArr(d.Get("rule_ids")))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return resourcePolicyRuleOrderRead(ctx, d, m)
+}
+
+// resourcePolicyRuleOrderDelete leaves the rules as they are, since there is no order to restore
+func resourcePolicyRuleOrderDelete(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return nil
+}
+
+// setPolicyRuleOrder moves the rules to the priorities from 1 to N one by one. Okta shifts down the rules
+// having the same or lower priority, so the rules placed earlier stay in place.
+func setPolicyRuleOrder(ctx context.Context, m interface{}, policyID string, ruleIDs []string) error {
+	client := getSupplementFromMetadata(m)
+	rules, _, err := client.ListPolicyRules(ctx, policyID)
+	if err != nil {
+		return fmt.Errorf("failed to list policy rules: %v", err)
+	}
+	priorities := make(map[string]int64, len(rules))
+	for i := range rules {
+		if rules[i].System != nil && *rules[i].System {
+			continue
+		}
+		priorities[rules[i].Id] = rules[i].Priority
+	}
+	seen := make(map[string]bool, len(ruleIDs))
+	for _, id := range ruleIDs {
+		if _, ok := priorities[id]; !ok {
+			return fmt.Errorf("rule '%s' does not exist in policy '%s' or it's the default rule which is always the last one", id, policyID)
+		}
+		if seen[id] {
+			return fmt.Errorf("rule '%s' is listed more than once", id)
+		}
+		seen[id] = true
+	}
+	// the rules which are already in place are skipped, once a rule is moved the priorities of the rules
+	// below it change, so all the following rules are moved too
+	current := orderedPolicyRuleIDs(rules, ruleIDs)
+	inPlace := true
+	for i, id := range ruleIDs {
+		inPlace = inPlace && current[i] == id && priorities[id] == int64(i+1)
+		if inPlace {
+			continue
+		}
+		logger(m).Info("setting policy rule priority", "policy_id", policyID, "rule_id", id, "priority", i+1)
+		_, err := client.SetPolicyRulePriority(ctx, policyID, id, int64(i+1))
+		if err != nil {
+			return fmt.Errorf("failed to set priority of policy rule '%s': %v", id, err)
+		}
+	}
+	return nil
+}
+
+// orderedPolicyRuleIDs returns the IDs of the tracked rules sorted by their priorities, all the non-default
+// rules are returned if none are tracked
+func orderedPolicyRuleIDs(rules []sdk.PolicyRule, tracked []string) []string {
+	trackedIDs := make(map[string]bool, len(tracked))
+	for _, id := range tracked {
+		trackedIDs[id] = true
+	}
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i].Priority < rules[j].Priority
+	})
+	var ruleIDs []string
+	for i := range rules {
+		if rules[i].System != nil && *rules[i].System {
+			continue
+		}
+		if len(tracked) == 0 || trackedIDs[rules[i].Id] {
+			ruleIDs = append(ruleIDs, rules[i].Id)
+		}
+	}
+	return ruleIDs
+}
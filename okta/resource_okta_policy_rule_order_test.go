@@ -0,0 +1,72 @@
+package okta
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func TestAccOktaPolicyRuleOrder_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(policyRuleOrder)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", policyRuleOrder)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createPolicyCheckDestroy(policySignOn),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rule_ids.#", "3"),
+					resource.TestCheckResourceAttrPair(resourceName, "rule_ids.0", fmt.Sprintf("%s.test_1", policyRuleSignOn), "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "rule_ids.2", fmt.Sprintf("%s.test_3", policyRuleSignOn), "id"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rule_ids.#", "3"),
+					resource.TestCheckResourceAttrPair(resourceName, "rule_ids.0", fmt.Sprintf("%s.test_3", policyRuleSignOn), "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "rule_ids.1", fmt.Sprintf("%s.test_1", policyRuleSignOn), "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "rule_ids.2", fmt.Sprintf("%s.test_2", policyRuleSignOn), "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestOrderedPolicyRuleIDs(t *testing.T) {
+	rules := []sdk.PolicyRule{
+		{Id: "default", Priority: 4, System: boolPtr(true)},
+		{Id: "c", Priority: 3},
+		{Id: "a", Priority: 1},
+		{Id: "b", Priority: 2},
+	}
+	tests := []struct {
+		tracked  []string
+		expected []string
+	}{
+		{nil, []string{"a", "b", "c"}},
+		{[]string{"c", "a"}, []string{"a", "c"}},
+		{[]string{"b", "missing"}, []string{"b"}},
+	}
+	for _, test := range tests {
+		got := orderedPolicyRuleIDs(rules, test.tracked)
+		if !reflect.DeepEqual(got, test.expected) {
+			t.Errorf("expected %v for tracked rules %v, got %v", test.expected, test.tracked, got)
+		}
+	}
+}
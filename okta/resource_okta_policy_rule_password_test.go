@@ -74,6 +74,18 @@ func TestAccOktaPolicyRulePassword_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "password_unlock", "ALLOW"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("failed to find %s", resourceName)
+					}
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["policy_id"], rs.Primary.ID), nil
+				},
+			},
 		},
 	})
 }
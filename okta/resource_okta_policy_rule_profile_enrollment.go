@@ -0,0 +1,209 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourcePolicyProfileEnrollmentRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePolicyProfileEnrollmentRuleCreate,
+		ReadContext:   resourcePolicyProfileEnrollmentRuleRead,
+		UpdateContext: resourcePolicyProfileEnrollmentRuleUpdate,
+		DeleteContext: resourcePolicyProfileEnrollmentRuleDelete,
+		Importer:      createPolicyRuleImporter(),
+		Schema: map[string]*schema.Schema{
+			"policy_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the profile enrollment policy",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the rule",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the rule",
+			},
+			"access": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "ALLOW",
+				ValidateDiagFunc: elemInSlice([]string{"ALLOW", "DENY"}),
+				Description:      "Allow or deny access based on the rule conditions: ALLOW or DENY",
+			},
+			"unknown_user_action": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: elemInSlice([]string{"DENY", "REGISTER"}),
+				Description:      "Which action should be taken if this user is new: DENY or REGISTER",
+			},
+			"email_verification": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Indicates whether email verification should occur before access is granted",
+			},
+			"target_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the group the self-registered users are added to",
+			},
+			"inline_hook_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the registration inline hook",
+			},
+			"profile_attributes": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "A list of the user profile attributes collected during the progressive profiling",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of a user profile attribute",
+						},
+						"label": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The label of the attribute shown in the form",
+						},
+						"required": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Indicates if this attribute is required",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourcePolicyProfileEnrollmentRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	policyID := d.Get("policy_id").(string)
+	logger(m).Info("creating profile enrollment policy rule", "policy_id", policyID)
+	// the policy has a single rule, which is created along with it, so the rule is updated instead
+	rules, _, err := getSupplementFromMetadata(m).ListPolicyRules(ctx, policyID)
+	if err != nil {
+		return diag.Errorf("failed to list profile enrollment policy rules: %v", err)
+	}
+	if len(rules) == 0 {
+		return diag.Errorf("profile enrollment policy %s does not have a rule", policyID)
+	}
+	d.SetId(rules[0].Id)
+	return resourcePolicyProfileEnrollmentRuleUpdate(ctx, d, m)
+}
+
+func resourcePolicyProfileEnrollmentRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	logger(m).Info("reading profile enrollment policy rule", "id", d.Id())
+	rule, resp, err := getSupplementFromMetadata(m).GetProfileEnrollmentPolicyRule(ctx, d.Get("policy_id").(string), d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get profile enrollment policy rule: %v", err)
+	}
+	if rule == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", rule.Name)
+	_ = d.Set("status", rule.Status)
+	action := rule.Actions.ProfileEnrollment
+	if action == nil {
+		return nil
+	}
+	_ = d.Set("access", action.Access)
+	_ = d.Set("unknown_user_action", action.UnknownUserAction)
+	if action.ActivationRequirements != nil && action.ActivationRequirements.EmailVerification != nil {
+		_ = d.Set("email_verification", *action.ActivationRequirements.EmailVerification)
+	}
+	if len(action.TargetGroupIds) > 0 {
+		_ = d.Set("target_group_id", action.TargetGroupIds[0])
+	} else {
+		_ = d.Set("target_group_id", "")
+	}
+	if len(action.PreRegistrationInlineHooks) > 0 {
+		_ = d.Set("inline_hook_id", action.PreRegistrationInlineHooks[0].InlineHookId)
+	} else {
+		_ = d.Set("inline_hook_id", "")
+	}
+	attributes := make([]map[string]interface{}, len(action.ProfileAttributes))
+	for i, attr := range action.ProfileAttributes {
+		attributes[i] = map[string]interface{}{
+			"name":     attr.Name,
+			"label":    attr.Label,
+			"required": attr.Required,
+		}
+	}
+	err = setNonPrimitives(d, map[string]interface{}{"profile_attributes": attributes})
+	if err != nil {
+		return diag.Errorf("failed to set profile enrollment policy rule properties: %v", err)
+	}
+	return nil
+}
+
+func resourcePolicyProfileEnrollmentRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	logger(m).Info("updating profile enrollment policy rule", "id", d.Id())
+	client := getSupplementFromMetadata(m)
+	policyID := d.Get("policy_id").(string)
+	rule, _, err := client.GetProfileEnrollmentPolicyRule(ctx, policyID, d.Id())
+	if err != nil {
+		return diag.Errorf("failed to get profile enrollment policy rule: %v", err)
+	}
+	_, _, err = client.UpdateProfileEnrollmentPolicyRule(ctx, policyID, d.Id(), buildPolicyProfileEnrollmentRule(d, rule))
+	if err != nil {
+		return diag.Errorf("failed to update profile enrollment policy rule: %v", err)
+	}
+	return resourcePolicyProfileEnrollmentRuleRead(ctx, d, m)
+}
+
+// The rule can't be deleted, it is deleted along with the policy, so it is only removed from the state.
+func resourcePolicyProfileEnrollmentRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	logger(m).Info("profile enrollment policy rule is deleted along with its policy", "id", d.Id())
+	return nil
+}
+
+// Build profile enrollment policy rule from resource data, the name and the status of the existing rule are kept
+func buildPolicyProfileEnrollmentRule(d *schema.ResourceData, rule *sdk.ProfileEnrollmentPolicyRule) sdk.ProfileEnrollmentPolicyRule {
+	action := &sdk.ProfileEnrollmentPolicyRuleAction{
+		Access:            d.Get("access").(string),
+		UnknownUserAction: d.Get("unknown_user_action").(string),
+		ActivationRequirements: &sdk.ProfileEnrollmentPolicyRuleActivation{
+			EmailVerification: boolPtr(d.Get("email_verification").(bool)),
+		},
+		TargetGroupIds: []string{},
+	}
+	if groupID, ok := d.GetOk("target_group_id"); ok {
+		action.TargetGroupIds = []string{groupID.(string)}
+	}
+	if hookID, ok := d.GetOk("inline_hook_id"); ok {
+		action.PreRegistrationInlineHooks = []*sdk.PreRegistrationInlineHook{{InlineHookId: hookID.(string)}}
+	}
+	attributes := d.Get("profile_attributes").([]interface{})
+	for i := range attributes {
+		action.ProfileAttributes = append(action.ProfileAttributes, &sdk.ProfileEnrollmentPolicyRuleProfileAttribute{
+			Name:     d.Get(fmt.Sprintf("profile_attributes.%d.name", i)).(string),
+			Label:    d.Get(fmt.Sprintf("profile_attributes.%d.label", i)).(string),
+			Required: d.Get(fmt.Sprintf("profile_attributes.%d.required", i)).(bool),
+		})
+	}
+	return sdk.ProfileEnrollmentPolicyRule{
+		Name:     rule.Name,
+		Type:     sdk.ProfileEnrollmentPolicyType,
+		Status:   rule.Status,
+		Priority: rule.Priority,
+		Actions: sdk.ProfileEnrollmentPolicyRuleActions{
+			ProfileEnrollment: action,
+		},
+	}
+}
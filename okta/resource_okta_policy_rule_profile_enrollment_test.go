@@ -0,0 +1,61 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccOktaPolicyRuleProfileEnrollment_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(policyRuleProfileEnrollment)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", policyRuleProfileEnrollment)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createPolicyCheckDestroy(policyProfileEnrollment),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "access", "ALLOW"),
+					resource.TestCheckResourceAttr(resourceName, "unknown_user_action", "DENY"),
+					resource.TestCheckResourceAttr(resourceName, "email_verification", "true"),
+					resource.TestCheckResourceAttr(resourceName, "profile_attributes.#", "0"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "unknown_user_action", "REGISTER"),
+					resource.TestCheckResourceAttr(resourceName, "email_verification", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_group_id", fmt.Sprintf("%s.test", oktaGroup), "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "inline_hook_id", fmt.Sprintf("%s.test", inlineHook), "id"),
+					resource.TestCheckResourceAttr(resourceName, "profile_attributes.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "profile_attributes.0.name", "email"),
+					resource.TestCheckResourceAttr(resourceName, "profile_attributes.0.required", "true"),
+					resource.TestCheckResourceAttr(resourceName, "profile_attributes.2.name", "lastName"),
+					resource.TestCheckResourceAttr(resourceName, "profile_attributes.2.required", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("failed to find %s", resourceName)
+					}
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["policy_id"], rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
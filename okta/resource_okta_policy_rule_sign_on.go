@@ -87,6 +87,25 @@ func resourcePolicySignOnRule() *schema.Resource {
 				Description: "List of behavior IDs",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"identity_provider": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: elemInSlice([]string{"ANY", "OKTA", "SPECIFIC_IDP"}),
+				Description:      "Apply rule based on the IdP used: ANY, OKTA or SPECIFIC_IDP.",
+				Default:          "ANY",
+			},
+			"identity_provider_ids": { // identity_provider must be SPECIFIC_IDP
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "When identity_provider is SPECIFIC_IDP then this is the list of IdP IDs to apply the rule on",
+			},
+			"require_phishing_resistant_factor": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Description:   "Require a phishing resistant factor (WebAuthn) for the sign-on, expands to the 'CHALLENGE' access with a WebAuthn factor sequence.",
+				ConflictsWith: []string{"access", "factor_sequence"},
+			},
 			"factor_sequence": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -150,24 +169,45 @@ func resourcePolicySignOnRuleRead(ctx context.Context, d *schema.ResourceData, m
 	}
 	// Update with upstream state to prevent stale state
 	_ = d.Set("authtype", rule.Conditions.AuthContext.AuthType)
-	_ = d.Set("access", rule.Actions.SignOn.Access)
+	phishingResistant := isPhishingResistantSignOn(rule.Actions.SignOn)
+	if d.Get("require_phishing_resistant_factor").(bool) || phishingResistant {
+		_ = d.Set("require_phishing_resistant_factor", phishingResistant)
+	}
+	if !d.Get("require_phishing_resistant_factor").(bool) {
+		_ = d.Set("access", rule.Actions.SignOn.Access)
+	}
 	_ = d.Set("mfa_required", rule.Actions.SignOn.RequireFactor)
 	_ = d.Set("mfa_remember_device", rule.Actions.SignOn.RememberDeviceByDefault)
 	_ = d.Set("mfa_lifetime", rule.Actions.SignOn.FactorLifetime)
-	_ = d.Set("session_idle", rule.Actions.SignOn.Session.MaxSessionIdleMinutes)
-	_ = d.Set("session_lifetime", rule.Actions.SignOn.Session.MaxSessionLifetimeMinutes)
-	_ = d.Set("session_persistent", rule.Actions.SignOn.Session.UsePersistentCookie)
+	if rule.Actions.SignOn.Session != nil {
+		_ = d.Set("session_idle", rule.Actions.SignOn.Session.MaxSessionIdleMinutes)
+		_ = d.Set("session_lifetime", rule.Actions.SignOn.Session.MaxSessionLifetimeMinutes)
+		_ = d.Set("session_persistent", rule.Actions.SignOn.Session.UsePersistentCookie)
+	}
 	if rule.Actions.SignOn.FactorPromptMode != "" {
 		_ = d.Set("mfa_prompt", rule.Actions.SignOn.FactorPromptMode)
 	}
-	_ = d.Set("risc_level", rule.Conditions.RiskScore.Level)
+	if rule.Conditions.RiskScore != nil {
+		_ = d.Set("risc_level", rule.Conditions.RiskScore.Level)
+	}
+	var behaviors, idpIDs []string
+	if rule.Conditions.Risk != nil {
+		behaviors = rule.Conditions.Risk.Behaviors
+	}
+	if rule.Conditions.IdentityProvider != nil {
+		_ = d.Set("identity_provider", rule.Conditions.IdentityProvider.Provider)
+		idpIDs = rule.Conditions.IdentityProvider.IdpIds
+	} else {
+		_ = d.Set("identity_provider", "ANY")
+	}
 	err = setNonPrimitives(d, map[string]interface{}{
-		"behaviors": convertStringSetToInterface(rule.Conditions.Risk.Behaviors),
+		"behaviors":             convertStringSetToInterface(behaviors),
+		"identity_provider_ids": convertStringArrToInterface(idpIDs),
 	})
 	if err != nil {
-		return diag.Errorf("failed to set sign-on policy rule behaviors: %v", err)
+		return diag.Errorf("failed to set sign-on policy rule behaviors and identity providers: %v", err)
 	}
-	if rule.Actions.SignOn.Access == "CHALLENGE" {
+	if rule.Actions.SignOn.Access == "CHALLENGE" && !d.Get("require_phishing_resistant_factor").(bool) {
 		chain := rule.Actions.SignOn.Challenge.Chain
 		arr := make([]map[string]interface{}, len(chain))
 		for i, c := range chain {
@@ -246,6 +286,13 @@ func buildSignOnPolicyRule(d *schema.ResourceData) sdk.PolicyRule {
 			Level: ri.(string),
 		}
 	}
+	// the condition is omitted when any IdP is allowed, since it is only supported by the orgs with IdP discovery
+	if provider := d.Get("identity_provider").(string); provider != "ANY" {
+		template.Conditions.IdentityProvider = &okta.IdentityProviderPolicyRuleCondition{
+			Provider: provider,
+			IdpIds:   convertInterfaceToStringArrNullable(d.Get("identity_provider_ids")),
+		}
+	}
 	template.Actions = sdk.PolicyRuleActions{
 		SignOn: &sdk.SignOnPolicyRuleSignOnActions{
 			Access:                  d.Get("access").(string),
@@ -260,6 +307,17 @@ func buildSignOnPolicyRule(d *schema.ResourceData) sdk.PolicyRule {
 			},
 		},
 	}
+	if d.Get("require_phishing_resistant_factor").(bool) {
+		template.Actions.SignOn.Access = "CHALLENGE"
+		template.Actions.SignOn.Challenge = &sdk.SignOnPolicyRuleSignOnActionsChallenge{
+			Chain: []sdk.SignOnPolicyRuleSignOnActionsChallengeChain{
+				{
+					Criteria: []sdk.SignOnPolicyRuleSignOnActionsChallengeChainCriteria{phishingResistantCriteria},
+				},
+			},
+		}
+		return template
+	}
 	factorSeq := d.Get("factor_sequence").([]interface{})
 	if len(factorSeq) == 0 {
 		return template
@@ -291,12 +349,33 @@ func buildSignOnPolicyRule(d *schema.ResourceData) sdk.PolicyRule {
 	return template
 }
 
+// phishingResistantCriteria is the factor sequence criteria of the WebAuthn factor, which is the only
+// phishing resistant factor of the Classic Engine.
+var phishingResistantCriteria = sdk.SignOnPolicyRuleSignOnActionsChallengeChainCriteria{
+	Provider:   "FIDO",
+	FactorType: "webauthn",
+}
+
+// isPhishingResistantSignOn returns whether the sign-on actions are the expansion of 'require_phishing_resistant_factor'
+func isPhishingResistantSignOn(signOn *sdk.SignOnPolicyRuleSignOnActions) bool {
+	if signOn == nil || signOn.Access != "CHALLENGE" || signOn.Challenge == nil || len(signOn.Challenge.Chain) != 1 {
+		return false
+	}
+	chain := signOn.Challenge.Chain[0]
+	return len(chain.Criteria) == 1 && chain.Criteria[0] == phishingResistantCriteria && len(chain.Next) == 0
+}
+
 func validateSignOnPolicyRule(d *schema.ResourceData) error {
 	_, ok := d.GetOk("factor_sequence")
 	isChallenge := d.Get("access").(string) == "CHALLENGE"
-	if (!ok && isChallenge) || (ok && !isChallenge) {
+	if !d.Get("require_phishing_resistant_factor").(bool) && ((!ok && isChallenge) || (ok && !isChallenge)) {
 		return errors.New("'factor_sequence' can only be set when access is 'CHALLENGE' and vice versa")
 	}
+	_, ok = d.GetOk("identity_provider_ids")
+	isSpecificIdp := d.Get("identity_provider").(string) == "SPECIFIC_IDP"
+	if (!ok && isSpecificIdp) || (ok && !isSpecificIdp) {
+		return errors.New("'identity_provider_ids' can only be set when identity_provider is 'SPECIFIC_IDP' and vice versa")
+	}
 	prompt, ok := d.GetOk("mfa_prompt")
 	if !ok {
 		return nil
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/okta/terraform-provider-okta/sdk"
 )
 
@@ -37,6 +38,8 @@ func TestAccOktaPolicyRuleSignon_crud(t *testing.T) {
 	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
 	excludedNetwork := mgr.GetFixtures("excluded_network.tf", ri, t)
 	factorSequence := mgr.GetFixtures("factor_sequence.tf", ri, t)
+	phishingResistant := mgr.GetFixtures("phishing_resistant.tf", ri, t)
+	identityProvider := mgr.GetFixtures("identity_provider.tf", ri, t)
 	resourceName := fmt.Sprintf("%s.test", policyRuleSignOn)
 
 	resource.Test(t, resource.TestCase{
@@ -95,6 +98,47 @@ func TestAccOktaPolicyRuleSignon_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "factor_sequence.1.primary_criteria_provider", "CUSTOM"),
 				),
 			},
+			{
+				Config: phishingResistant,
+				Check: resource.ComposeTestCheckFunc(
+					ensureRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "require_phishing_resistant_factor", "true"),
+					resource.TestCheckResourceAttr(resourceName, "factor_sequence.#", "0"),
+				),
+			},
+			{
+				Config: identityProvider,
+				Check: resource.ComposeTestCheckFunc(
+					ensureRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "priority", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access", "ALLOW"),
+					resource.TestCheckResourceAttr(resourceName, "network_connection", "ZONE"),
+					resource.TestCheckResourceAttr(resourceName, "network_includes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "identity_provider", "SPECIFIC_IDP"),
+					resource.TestCheckResourceAttrPair(resourceName, "identity_provider_ids.0", fmt.Sprintf("%s.test", idpSocial), "id"),
+					resource.TestCheckResourceAttr(resourceName, "behaviors.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "risc_level", "HIGH"),
+					resource.TestCheckResourceAttr(resourceName, "mfa_required", "true"),
+					resource.TestCheckResourceAttr(resourceName, "mfa_prompt", "DEVICE"),
+					resource.TestCheckResourceAttr(resourceName, "mfa_remember_device", "true"),
+					resource.TestCheckResourceAttr(resourceName, "session_idle", "60"),
+					resource.TestCheckResourceAttr(resourceName, "session_lifetime", "480"),
+					resource.TestCheckResourceAttr(resourceName, "session_persistent", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("failed to find %s", resourceName)
+					}
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["policy_id"], rs.Primary.ID), nil
+				},
+				ImportStateVerifyIgnore: []string{"policyid"},
+			},
 		},
 	})
 }
@@ -18,7 +18,8 @@ func resourcePolicySignOn() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: basePolicySchema,
+		CustomizeDiff: markIncludedGroupIDsComputed,
+		Schema:        basePolicySchema,
 	}
 }
 
@@ -39,7 +40,7 @@ func resourcePolicySignOnRead(ctx context.Context, d *schema.ResourceData, m int
 	if policy == nil {
 		return nil
 	}
-	err = syncPolicyFromUpstream(d, policy)
+	err = syncPolicyFromUpstream(ctx, d, m, policy)
 	if err != nil {
 		return diag.Errorf("failed to set sign-on policy: %v", err)
 	}
@@ -1,12 +1,14 @@
 package okta
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/okta/terraform-provider-okta/sdk"
 )
 
@@ -71,6 +73,11 @@ func TestAccOktaPolicySignOn_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "description", "Terraform Acceptance Test SignOn Policy"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -86,3 +93,56 @@ resource "%s" "%s" {
 }
 `, policySignOn, name)
 }
+
+func TestAccOktaPolicySignOn_groupNames(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(policySignOn)
+	config := mgr.GetFixtures("group_names.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", policySignOn)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createPolicyCheckDestroy(policySignOn),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensurePolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "groups_included.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "groups_included.*", "Everyone"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "groups_included.*", buildResourceName(ri)),
+				),
+			},
+		},
+	})
+}
+
+func TestMarkIncludedGroupIDsComputed(t *testing.T) {
+	r := resourcePolicySignOn()
+	prior := r.Data(nil)
+	prior.SetId("00p1")
+	_ = prior.Set("name", "test")
+	_ = prior.Set("status", statusActive)
+	_ = prior.Set("groups_included", convertStringSetToInterface([]string{"Everyone"}))
+	_ = prior.Set("groups_included_ids", map[string]interface{}{"Everyone": "00g1"})
+	tests := []struct {
+		name     string
+		groups   []interface{}
+		computed bool
+	}{
+		{"unchanged", []interface{}{"Everyone"}, false},
+		{"changed", []interface{}{"Everyone", "Admins"}, true},
+	}
+	for _, test := range tests {
+		config := map[string]interface{}{"name": "test", "groups_included": test.groups}
+		diff, err := r.Diff(context.Background(), prior.State(), terraform.NewResourceConfigRaw(config), nil)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+		computed := diff != nil && diff.Attributes["groups_included_ids.%"] != nil && diff.Attributes["groups_included_ids.%"].NewComputed
+		if computed != test.computed {
+			t.Errorf("%s: expected groups_included_ids to be known after apply to be %v, got %v", test.name, test.computed, computed)
+		}
+	}
+}
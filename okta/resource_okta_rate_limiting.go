@@ -0,0 +1,163 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+var rateLimitModes = []string{"ENFORCE_AND_LOG", "PREVIEW", "DISABLE"}
+
+func resourceRateLimiting() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRateLimitingCreateOrUpdate,
+		ReadContext:   resourceRateLimitingRead,
+		UpdateContext: resourceRateLimitingCreateOrUpdate,
+		DeleteContext: resourceRateLimitingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				id, _, err := getSupplementFromMetadata(m).GetOrgID(ctx)
+				if err != nil {
+					return nil, err
+				}
+				d.SetId(id)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"default_mode": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: elemInSlice(rateLimitModes),
+				Description:      "Default mode of the per-client rate limits: ENFORCE_AND_LOG, PREVIEW or DISABLE",
+			},
+			"login": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: elemInSlice(rateLimitModes),
+				Description:      "Mode of the per-client rate limits of the login page: ENFORCE_AND_LOG, PREVIEW or DISABLE",
+			},
+			"authorize": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: elemInSlice(rateLimitModes),
+				Description:      "Mode of the per-client rate limits of the OAuth 2.0 '/authorize' endpoint: ENFORCE_AND_LOG, PREVIEW or DISABLE",
+			},
+			"communications_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the admins are notified when the org hits the rate limits",
+			},
+			"warning_threshold": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: intBetween(30, 90),
+				Description:      "Percentage of the rate limit the warning notifications are sent at, between 30 and 90",
+			},
+		},
+	}
+}
+
+func resourceRateLimitingCreateOrUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	supplement := getSupplementFromMetadata(m)
+	id := d.Id()
+	if id == "" {
+		orgID, _, err := supplement.GetOrgID(ctx)
+		if err != nil {
+			return diag.Errorf("failed to get org: %v", err)
+		}
+		id = orgID
+	}
+	if d.HasChanges("default_mode", "login", "authorize") {
+		settings, _, err := supplement.GetPerClientRateLimitSettings(ctx)
+		if err != nil {
+			return diag.Errorf("failed to get per-client rate limit settings: %v", err)
+		}
+		_, _, err = supplement.UpdatePerClientRateLimitSettings(ctx, buildPerClientRateLimitSettings(d, settings))
+		if err != nil {
+			return diag.Errorf("failed to update per-client rate limit settings: %v", err)
+		}
+	}
+	if d.IsNewResource() || d.HasChange("communications_enabled") {
+		_, _, err := supplement.UpdateRateLimitAdminNotifications(ctx, sdk.RateLimitAdminNotifications{
+			NotificationsEnabled: d.Get("communications_enabled").(bool),
+		})
+		if err != nil {
+			return diag.Errorf("failed to update rate limit notification settings: %v", err)
+		}
+	}
+	if threshold, ok := d.GetOk("warning_threshold"); ok && d.HasChange("warning_threshold") {
+		_, _, err := supplement.UpdateRateLimitWarningThreshold(ctx, sdk.RateLimitWarningThreshold{
+			WarningThreshold: threshold.(int),
+		})
+		if err != nil {
+			return diag.Errorf("failed to update rate limit warning threshold: %v", err)
+		}
+	}
+	d.SetId(id)
+	return resourceRateLimitingRead(ctx, d, m)
+}
+
+func resourceRateLimitingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	supplement := getSupplementFromMetadata(m)
+	settings, _, err := supplement.GetPerClientRateLimitSettings(ctx)
+	if err != nil {
+		return diag.Errorf("failed to get per-client rate limit settings: %v", err)
+	}
+	_ = d.Set("default_mode", settings.DefaultMode)
+	_ = d.Set("login", perClientRateLimitMode(settings, "LOGIN_PAGE"))
+	_ = d.Set("authorize", perClientRateLimitMode(settings, "OAUTH2_AUTHORIZE"))
+	notifications, _, err := supplement.GetRateLimitAdminNotifications(ctx)
+	if err != nil {
+		return diag.Errorf("failed to get rate limit notification settings: %v", err)
+	}
+	_ = d.Set("communications_enabled", notifications.NotificationsEnabled)
+	threshold, _, err := supplement.GetRateLimitWarningThreshold(ctx)
+	if err != nil {
+		return diag.Errorf("failed to get rate limit warning threshold: %v", err)
+	}
+	_ = d.Set("warning_threshold", threshold.WarningThreshold)
+	return nil
+}
+
+// Rate limit settings can not be removed, they are left as is
+func resourceRateLimitingDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return nil
+}
+
+// buildPerClientRateLimitSettings applies the configured modes to the current settings, since the settings
+// are replaced as a whole
+func buildPerClientRateLimitSettings(d *schema.ResourceData, settings *sdk.PerClientRateLimitSettings) sdk.PerClientRateLimitSettings {
+	result := sdk.PerClientRateLimitSettings{
+		DefaultMode:          settings.DefaultMode,
+		UseCaseModeOverrides: make(map[string]string),
+	}
+	for useCase, mode := range settings.UseCaseModeOverrides {
+		result.UseCaseModeOverrides[useCase] = mode
+	}
+	if mode, ok := d.GetOk("default_mode"); ok {
+		result.DefaultMode = mode.(string)
+	}
+	if mode, ok := d.GetOk("login"); ok {
+		result.UseCaseModeOverrides["LOGIN_PAGE"] = mode.(string)
+	}
+	if mode, ok := d.GetOk("authorize"); ok {
+		result.UseCaseModeOverrides["OAUTH2_AUTHORIZE"] = mode.(string)
+	}
+	return result
+}
+
+// perClientRateLimitMode returns the mode of the use case, which falls back to the default mode if not overridden
+func perClientRateLimitMode(settings *sdk.PerClientRateLimitSettings, useCase string) string {
+	if mode, ok := settings.UseCaseModeOverrides[useCase]; ok && mode != "" {
+		return mode
+	}
+	return settings.DefaultMode
+}
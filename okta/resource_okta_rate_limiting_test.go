@@ -0,0 +1,64 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func TestAccOktaRateLimiting(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(rateLimiting)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", rateLimiting)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "default_mode", "ENFORCE_AND_LOG"),
+					resource.TestCheckResourceAttr(resourceName, "login", "PREVIEW"),
+					resource.TestCheckResourceAttr(resourceName, "authorize", "ENFORCE_AND_LOG"),
+					resource.TestCheckResourceAttr(resourceName, "communications_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "warning_threshold", "90"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "login", "ENFORCE_AND_LOG"),
+					resource.TestCheckResourceAttr(resourceName, "authorize", "PREVIEW"),
+					resource.TestCheckResourceAttr(resourceName, "communications_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "warning_threshold", "60"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestPerClientRateLimitMode(t *testing.T) {
+	settings := &sdk.PerClientRateLimitSettings{
+		DefaultMode:          "ENFORCE_AND_LOG",
+		UseCaseModeOverrides: map[string]string{"LOGIN_PAGE": "PREVIEW"},
+	}
+	if mode := perClientRateLimitMode(settings, "LOGIN_PAGE"); mode != "PREVIEW" {
+		t.Errorf("expected the overridden mode PREVIEW, got %s", mode)
+	}
+	if mode := perClientRateLimitMode(settings, "OAUTH2_AUTHORIZE"); mode != "ENFORCE_AND_LOG" {
+		t.Errorf("expected the default mode ENFORCE_AND_LOG, got %s", mode)
+	}
+}
@@ -0,0 +1,96 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+var validRoleSubscriptionNotificationTypes = []string{
+	"CONNECTOR_AGENT", "USER_LOCKED_OUT", "APP_IMPORT", "LDAP_AGENT", "AD_AGENT", "OKTA_ANNOUNCEMENT", "OKTA_ISSUE",
+	"OKTA_UPDATE", "IWA_AGENT", "USER_DEPROVISION", "REPORT_SUSPICIOUS_ACTIVITY", "RATELIMIT_NOTIFICATION",
+	"AGENT_AUTO_UPDATE_NOTIFICATION",
+}
+
+func resourceRoleSubscription() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRoleSubscriptionCreate,
+		ReadContext:   resourceRoleSubscriptionRead,
+		UpdateContext: resourceRoleSubscriptionUpdate,
+		DeleteContext: resourceRoleSubscriptionDelete,
+		Importer:      createNestedResourceImporter([]string{"role_type", "notification_type"}),
+		Schema: map[string]*schema.Schema{
+			"role_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: elemInSlice(validAdminRoles),
+				Description:      "Type of the role",
+			},
+			"notification_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: elemInSlice(validRoleSubscriptionNotificationTypes),
+				Description:      "Type of the notification",
+			},
+			"status": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: elemInSlice([]string{"subscribed", "unsubscribed"}),
+				Description:      "Status of subscription",
+			},
+		},
+	}
+}
+
+func resourceRoleSubscriptionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	roleType := d.Get("role_type").(string)
+	notificationType := d.Get("notification_type").(string)
+	err := setRoleSubscriptionStatus(ctx, getSupplementFromMetadata(m), roleType, notificationType, d.Get("status").(string))
+	if err != nil {
+		return diag.Errorf("failed to change role subscription: %v", err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", roleType, notificationType))
+	return resourceRoleSubscriptionRead(ctx, d, m)
+}
+
+func resourceRoleSubscriptionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	subscription, resp, err := getSupplementFromMetadata(m).GetRoleSubscription(ctx, d.Get("role_type").(string), d.Get("notification_type").(string))
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get role subscription: %v", err)
+	}
+	if subscription == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("status", subscription.Status)
+	return nil
+}
+
+func resourceRoleSubscriptionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	err := setRoleSubscriptionStatus(ctx, getSupplementFromMetadata(m), d.Get("role_type").(string),
+		d.Get("notification_type").(string), d.Get("status").(string))
+	if err != nil {
+		return diag.Errorf("failed to change role subscription: %v", err)
+	}
+	return resourceRoleSubscriptionRead(ctx, d, m)
+}
+
+// subscriptions can't be deleted, so the status they were left with is kept
+func resourceRoleSubscriptionDelete(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return nil
+}
+
+func setRoleSubscriptionStatus(ctx context.Context, client *sdk.ApiSupplement, roleType, notificationType, status string) error {
+	var err error
+	if status == "subscribed" {
+		_, err = client.SubscribeRoleSubscription(ctx, roleType, notificationType)
+	} else {
+		_, err = client.UnsubscribeRoleSubscription(ctx, roleType, notificationType)
+	}
+	return err
+}
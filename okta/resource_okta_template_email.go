@@ -2,6 +2,7 @@ package okta
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -77,9 +78,12 @@ func resourceTemplateEmail() *schema.Resource {
 }
 
 func resourceTemplateEmailCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	temp := buildEmailTemplate(d)
+	temp, err := buildEmailTemplate(d)
+	if err != nil {
+		return diag.Errorf("failed to create email template: %v", err)
+	}
 	id := d.Get("type").(string)
-	_, _, err := getSupplementFromMetadata(m).CreateEmailTemplate(ctx, *temp, nil)
+	_, _, err = getSupplementFromMetadata(m).CreateEmailTemplate(ctx, *temp, nil)
 	if err != nil {
 		return diag.Errorf("failed to create email template: %v", err)
 	}
@@ -96,14 +100,18 @@ func resourceTemplateEmailRead(ctx context.Context, d *schema.ResourceData, m in
 		d.SetId("")
 		return nil
 	}
+	_ = d.Set("type", d.Id())
 	_ = d.Set("translations", flattenEmailTranslations(temp.Translations))
 	_ = d.Set("default_language", temp.DefaultLanguage)
 	return nil
 }
 
 func resourceTemplateEmailUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	temp := buildEmailTemplate(d)
-	_, _, err := getSupplementFromMetadata(m).UpdateEmailTemplate(ctx, d.Id(), *temp, nil)
+	temp, err := buildEmailTemplate(d)
+	if err != nil {
+		return diag.Errorf("failed to update email template: %v", err)
+	}
+	_, _, err = getSupplementFromMetadata(m).UpdateEmailTemplate(ctx, d.Id(), *temp, nil)
 	if err != nil {
 		return diag.Errorf("failed to update email template: %v", err)
 	}
@@ -118,7 +126,7 @@ func resourceTemplateEmailDelete(ctx context.Context, d *schema.ResourceData, m
 	return nil
 }
 
-func buildEmailTemplate(d *schema.ResourceData) *sdk.EmailTemplate {
+func buildEmailTemplate(d *schema.ResourceData) (*sdk.EmailTemplate, error) {
 	trans := map[string]*sdk.EmailTranslation{}
 	rawTransList := d.Get("translations").(*schema.Set)
 
@@ -130,7 +138,9 @@ func buildEmailTemplate(d *schema.ResourceData) *sdk.EmailTemplate {
 		}
 	}
 	defaultLang := d.Get("default_language").(string)
-
+	if _, ok := trans[defaultLang]; !ok {
+		return nil, fmt.Errorf("'translations' must contain a translation for the default language '%s'", defaultLang)
+	}
 	return &sdk.EmailTemplate{
 		DefaultLanguage: defaultLang,
 		Name:            "Custom",
@@ -138,7 +148,7 @@ func buildEmailTemplate(d *schema.ResourceData) *sdk.EmailTemplate {
 		Translations:    trans,
 		Subject:         trans[defaultLang].Subject,
 		Template:        trans[defaultLang].Template,
-	}
+	}, nil
 }
 
 func flattenEmailTranslations(temp map[string]*sdk.EmailTranslation) *schema.Set {
@@ -14,6 +14,7 @@ func TestAccOktaEmailTemplate_crud(t *testing.T) {
 	resourceName := fmt.Sprintf("%s.test", templateEmail)
 	mgr := newFixtureManager(templateEmail)
 	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
@@ -24,8 +25,23 @@ func TestAccOktaEmailTemplate_crud(t *testing.T) {
 				Config: config,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "type", "email.forgotPassword"),
+					resource.TestCheckResourceAttr(resourceName, "default_language", "en"),
+					resource.TestCheckResourceAttr(resourceName, "translations.#", "2"),
 				),
 			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "email.forgotPassword"),
+					resource.TestCheckResourceAttr(resourceName, "default_language", "fr"),
+					resource.TestCheckResourceAttr(resourceName, "translations.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -2,7 +2,9 @@ package okta
 
 import (
 	"context"
+	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/terraform-provider-okta/sdk"
@@ -17,11 +19,22 @@ var translationSmsResource = &schema.Resource{
 		"template": {
 			Type:             schema.TypeString,
 			Required:         true,
-			ValidateDiagFunc: stringLenBetween(1, 161),
+			ValidateDiagFunc: validateSmsTemplate,
 		},
 	},
 }
 
+// Okta rejects SMS templates which are longer than 161 characters or do not contain the verification code.
+func validateSmsTemplate(i interface{}, k cty.Path) diag.Diagnostics {
+	if d := stringLenBetween(1, 161)(i, k); d.HasError() {
+		return d
+	}
+	if !strings.Contains(i.(string), "${code}") {
+		return diag.Errorf("expected %s to contain the '${code}' placeholder", k)
+	}
+	return nil
+}
+
 func resourceTemplateSms() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceTemplateSmsCreate,
@@ -33,15 +46,17 @@ func resourceTemplateSms() *schema.Resource {
 		},
 		Schema: map[string]*schema.Schema{
 			"type": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "SMS template type",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "SMS template type",
+				ForceNew:         true,
+				ValidateDiagFunc: elemInSlice([]string{"SMS_VERIFY_CODE"}),
 			},
 			"template": {
 				Type:             schema.TypeString,
 				Required:         true,
 				Description:      "SMS default template",
-				ValidateDiagFunc: stringLenBetween(1, 161),
+				ValidateDiagFunc: validateSmsTemplate,
 			},
 			"translations": {
 				Type:     schema.TypeSet,
@@ -71,6 +86,8 @@ func resourceTemplateSmsRead(ctx context.Context, d *schema.ResourceData, m inte
 		d.SetId("")
 		return nil
 	}
+	_ = d.Set("type", temp.Type)
+	_ = d.Set("template", temp.Template)
 	_ = d.Set("translations", flattenSmsTranslations(temp.Translations))
 	return nil
 }
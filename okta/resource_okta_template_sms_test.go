@@ -3,8 +3,10 @@ package okta
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -33,12 +35,42 @@ func TestAccOktaSmsTemplate_crud(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "type", "SMS_VERIFY_CODE"),
 					resource.TestCheckResourceAttr(resourceName, "template", "Your ${org.name} updated code is: ${code}"),
+					resource.TestCheckResourceAttr(resourceName, "translations.#", "3"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
+func TestValidateSmsTemplate(t *testing.T) {
+	tests := []struct {
+		template string
+		expected string
+	}{
+		{"Your ${org.name} code is: ${code}", ""},
+		{"", "expected length"},
+		{strings.Repeat("a", 155) + "${code}", "expected length"},
+		{"Your ${org.name} code is here", "'${code}' placeholder"},
+	}
+	for _, test := range tests {
+		diags := validateSmsTemplate(test.template, cty.GetAttrPath("template"))
+		if test.expected == "" {
+			if diags.HasError() {
+				t.Errorf("template '%s' is expected to be valid, got: %v", test.template, diags[0].Summary)
+			}
+			continue
+		}
+		if !diags.HasError() || !strings.Contains(diags[0].Summary, test.expected) {
+			t.Errorf("template '%s' is expected to fail with '%s', got: %v", test.template, test.expected, diags)
+		}
+	}
+}
+
 func doesSmsTemplateExist(id string) (bool, error) {
 	_, response, err := getOktaClientFromMetadata(testAccProvider.Meta()).SmsTemplate.GetSmsTemplate(context.Background(), id)
 
@@ -14,6 +14,7 @@ import (
 // request or before erring due to an update on a user that is DEPROVISIONED. Since we have core user props coupled
 // with group/user membership a few change requests go out in the Update function.
 var profileKeys = []string{
+	"address",
 	"city",
 	"cost_center",
 	"country_code",
@@ -46,17 +47,20 @@ var profileKeys = []string{
 	"title",
 	"user_type",
 	"zip_code",
-	"password",
-	"recovery_question",
-	"recovery_answer",
 }
 
+// userStepKeys are the user attributes applied by the requests following the user create, along with the profile,
+// which is applied by a request of its own on update, see resourceSteps
+var userStepKeys = append([]string{"status", "admin_roles", "group_memberships", "password", "recovery_question",
+	"recovery_answer"}, profileKeys...)
+
 func resourceUser() *schema.Resource {
-	return &schema.Resource{
+	return withResumableSteps(&schema.Resource{
 		CreateContext: resourceUserCreate,
 		ReadContext:   resourceUserRead,
 		UpdateContext: resourceUserUpdate,
 		DeleteContext: resourceUserDelete,
+		CustomizeDiff: validateUserPasswordDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 				// Supporting id and email based imports
@@ -70,6 +74,42 @@ func resourceUser() *schema.Resource {
 			},
 		},
 		Schema: map[string]*schema.Schema{
+			"address": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				Description:   "User address, maps to the flat address properties of the user profile and its postal address",
+				ConflictsWith: []string{"street_address", "city", "state", "zip_code", "country_code", "postal_address"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"street": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Street address",
+						},
+						"city": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "City",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "State or region",
+						},
+						"zip": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Zipcode or postal code",
+						},
+						"country": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Country code",
+						},
+					},
+				},
+			},
 			"admin_roles": {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -135,6 +175,11 @@ func resourceUser() *schema.Resource {
 				Required:    true,
 				Description: "User first name",
 			},
+			"full_profile_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "JSON document containing the entire profile of the user as returned by Okta, including the attributes which are not managed by the resource",
+			},
 			"group_memberships": {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -266,6 +311,20 @@ func resourceUser() *schema.Resource {
 				Optional:    true,
 				Description: "User employee type",
 			},
+			"wait_for_status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateDiagFunc: elemInSlice([]string{statusActive, userStatusProvisioned, userStatusStaged, userStatusSuspended,
+					userStatusDeprovisioned, userStatusRecovery, userStatusPasswordExpired, userStatusLockedOut}),
+				Description: "Raw status of the user to wait for after the user is created or updated, e.g. 'ACTIVE' for the users that are 'PROVISIONED' until they activate their account",
+			},
+			"wait_for_status_timeout": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          300,
+				ValidateDiagFunc: intBetween(1, 3600),
+				Description:      "Number of seconds to wait for the user to reach the 'wait_for_status'",
+			},
 			"zip_code": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -290,7 +349,7 @@ func resourceUser() *schema.Resource {
 				Description:      "User Password Recovery Answer",
 			},
 		},
-	}
+	}, userStepKeys...)
 }
 
 func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -322,7 +381,18 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface
 		Credentials: uc,
 	}
 	client := getOktaClientFromMetadata(m)
-	user, _, err := client.User.CreateUser(ctx, userBody, qp)
+	steps := stepsFrom(ctx)
+	status := d.Get("status").(string)
+	createKeys := append([]string{"password", "recovery_question", "recovery_answer"}, profileKeys...)
+	if status != userStatusSuspended && status != userStatusDeprovisioned {
+		createKeys = append(createKeys, "status")
+	}
+	var user *okta.User
+	err := steps.runMain(func() error {
+		var err error
+		user, _, err = client.User.CreateUser(ctx, userBody, qp)
+		return err
+	}, createKeys...)
 	if err != nil {
 		return diag.Errorf("failed to create user: %v", err)
 	}
@@ -332,7 +402,9 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface
 	// role assigning can only happen after the user is created so order matters here
 	roles := convertInterfaceToStringSetNullable(d.Get("admin_roles"))
 	if roles != nil {
-		err = assignAdminRolesToUser(ctx, user.Id, roles, client)
+		err = steps.run([]string{"admin_roles"}, func() error {
+			return assignAdminRolesToUser(ctx, user.Id, roles, client)
+		})
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -341,7 +413,9 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface
 	// Only sync when there is opt in, consumers can chose which route they want to take
 	if _, exists := d.GetOk("group_memberships"); exists {
 		groups := convertInterfaceToStringSetNullable(d.Get("group_memberships"))
-		err = assignGroupsToUser(ctx, user.Id, groups, client)
+		err = steps.run([]string{"group_memberships"}, func() error {
+			return assignGroupsToUser(ctx, user.Id, groups, client)
+		})
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -349,12 +423,23 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface
 
 	// status changing can only happen after user is created as well
 	if d.Get("status").(string) == userStatusSuspended || d.Get("status").(string) == userStatusDeprovisioned {
-		err := updateUserStatus(ctx, user.Id, d.Get("status").(string), client)
+		err = steps.run([]string{"status"}, func() error {
+			return updateUserStatus(ctx, user.Id, d.Get("status").(string), client)
+		})
 		if err != nil {
 			return diag.Errorf("failed to update user status: %v", err)
 		}
 	}
-	return resourceUserRead(ctx, d, m)
+	// the user is created by now, so it is kept without being tainted if it does not reach the status in time
+	var diags diag.Diagnostics
+	if err := waitForUserStatus(ctx, d, client); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "User was created, but did not reach the 'wait_for_status'",
+			Detail:   err.Error(),
+		})
+	}
+	return append(diags, resourceUserRead(ctx, d, m)...)
 }
 
 func resourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -370,6 +455,10 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}
 	}
 	_ = d.Set("raw_status", user.Status)
 	rawMap := flattenUser(user)
+	// the address block replaces the flat address properties in the config, so they have to be read into the block instead
+	if _, ok := d.GetOk("address"); ok {
+		rawMap["address"] = flattenUserAddress(rawMap)
+	}
 	err = setNonPrimitives(d, rawMap)
 	if err != nil {
 		return diag.Errorf("failed to set user's properties: %v", err)
@@ -397,7 +486,8 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface
 		return diag.Errorf("Okta will not allow a user to be updated to STAGED. Can set to STAGED on user creation only")
 	}
 
-	// There are a few requests here so just making sure the state gets updated per successful downstream change
+	// There are a few requests here, the attributes of the ones that complete are kept in case a later one fails,
+	// so they are not made again by the next apply, see resourceSteps.
 	roleChange := d.HasChange("admin_roles")
 	groupChange := d.HasChange("group_memberships")
 	userChange := hasProfileChange(d)
@@ -408,46 +498,52 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface
 	// run the update status func first so a user that was previously deprovisioned
 	// can be updated further if it's status changed in it's terraform configs
 	client := getOktaClientFromMetadata(m)
+	steps := stepsFrom(ctx)
 	if statusChange {
-		err := updateUserStatus(ctx, d.Id(), status, client)
+		err := steps.run([]string{"status"}, func() error {
+			return updateUserStatus(ctx, d.Id(), status, client)
+		})
 		if err != nil {
 			return diag.Errorf("failed to update user status: %v", err)
 		}
-		_ = d.Set("status", status)
 	}
 
-	if status == userStatusDeprovisioned && userChange {
+	if status == userStatusDeprovisioned &&
+		(userChange || roleChange || groupChange || passwordChange || recoveryQuestionChange || recoveryAnswerChange) {
 		return diag.Errorf("Only the status of a DEPROVISIONED user can be updated, we detected other change")
 	}
 
 	if userChange {
-		profile := populateUserProfile(d)
-		userBody := okta.User{Profile: profile}
-		_, _, err := client.User.UpdateUser(ctx, d.Id(), userBody, nil)
+		err := steps.run(profileKeys, func() error {
+			_, _, err := client.User.UpdateUser(ctx, d.Id(), okta.User{Profile: populateUserProfile(d)}, nil)
+			return err
+		})
 		if err != nil {
 			return diag.Errorf("failed to update user: %v", err)
 		}
 	}
 
 	if roleChange {
-		roles := convertInterfaceToStringSet(d.Get("admin_roles"))
-		if err := updateAdminRolesOnUser(ctx, d.Id(), roles, client); err != nil {
+		err := steps.run([]string{"admin_roles"}, func() error {
+			return updateAdminRolesOnUser(ctx, d.Id(), convertInterfaceToStringSet(d.Get("admin_roles")), client)
+		})
+		if err != nil {
 			return diag.Errorf("failed to update user: %v", err)
 		}
-		_ = d.Set("admin_roles", roles)
 	}
 
 	if groupChange {
-		oldGM, newGM := d.GetChange("group_memberships")
-		oldSet := oldGM.(*schema.Set)
-		newSet := newGM.(*schema.Set)
-		groupsToAdd := convertInterfaceArrToStringArr(newSet.Difference(oldSet).List())
-		groupsToRemove := convertInterfaceArrToStringArr(oldSet.Difference(newSet).List())
-		err := addUserToGroups(ctx, client, d.Id(), groupsToAdd)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		err = removeUserFromGroups(ctx, client, d.Id(), groupsToRemove)
+		err := steps.run([]string{"group_memberships"}, func() error {
+			oldGM, newGM := d.GetChange("group_memberships")
+			oldSet := oldGM.(*schema.Set)
+			newSet := newGM.(*schema.Set)
+			groupsToAdd := convertInterfaceArrToStringArr(newSet.Difference(oldSet).List())
+			groupsToRemove := convertInterfaceArrToStringArr(oldSet.Difference(newSet).List())
+			if err := addUserToGroups(ctx, client, d.Id(), groupsToAdd); err != nil {
+				return err
+			}
+			return removeUserFromGroups(ctx, client, d.Id(), groupsToRemove)
+		})
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -465,7 +561,10 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface
 			OldPassword: op,
 			NewPassword: np,
 		}
-		_, _, err := client.User.ChangePassword(ctx, d.Id(), *npr, nil)
+		err := steps.run([]string{"password"}, func() error {
+			_, _, err := client.User.ChangePassword(ctx, d.Id(), *npr, nil)
+			return err
+		})
 		if err != nil {
 			return diag.Errorf("failed to update user's password: %v", err)
 		}
@@ -481,11 +580,20 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface
 				Answer:   d.Get("recovery_answer").(string),
 			},
 		}
-		_, _, err := client.User.ChangeRecoveryQuestion(ctx, d.Id(), *nuc)
+		err := steps.run([]string{"recovery_question", "recovery_answer"}, func() error {
+			_, _, err := client.User.ChangeRecoveryQuestion(ctx, d.Id(), *nuc)
+			return err
+		})
 		if err != nil {
 			return diag.Errorf("failed to change user's password recovery question: %v", err)
 		}
 	}
+
+	if statusChange || d.HasChange("wait_for_status") {
+		if err := waitForUserStatus(ctx, d, client); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	return resourceUserRead(ctx, d, m)
 }
 
@@ -511,19 +619,33 @@ func resourceUserDelete(ctx context.Context, d *schema.ResourceData, m interface
 }
 
 func ensureUserDelete(ctx context.Context, id, status string, client *okta.Client) error {
-	// only deprovisioned users can be deleted fully from okta
-	// make two passes on the user if they aren't deprovisioned already to deprovision them first
-	passes := 2
-	if status == userStatusDeprovisioned {
-		passes = 1
-	}
-	for i := 0; i < passes; i++ {
-		_, err := client.User.DeactivateOrDeleteUser(ctx, id, nil)
+	// only deprovisioned users can be deleted fully from okta, so the user is deprovisioned first if it isn't already
+	if status != userStatusDeprovisioned {
+		resp, err := client.User.DeactivateOrDeleteUser(ctx, id, nil)
+		if is404(resp) {
+			return nil
+		}
 		if err != nil {
-			return fmt.Errorf("failed to deprovision or delete user from Okta: %v", err)
+			return fmt.Errorf("failed to deprovision user from Okta: %v", err)
+		}
+		// the deactivation may still be propagating, in which case the second call would not delete the user
+		deleted, err := waitForUserDeprovisioned(ctx, id, client)
+		if err != nil || deleted {
+			return err
 		}
 	}
-	return nil
+	resp, err := client.User.DeactivateOrDeleteUser(ctx, id, nil)
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return fmt.Errorf("failed to delete user from Okta: %v", err)
+	}
+	_, resp, err = client.User.GetUser(ctx, id)
+	if is404(resp) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to verify that user was deleted from Okta: %v", err)
+	}
+	return fmt.Errorf("user %s was not deleted from Okta", id)
 }
 
 func mapStatus(currentStatus string) string {
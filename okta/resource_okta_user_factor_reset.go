@@ -0,0 +1,30 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceUserFactorReset() *schema.Resource {
+	return buildActionResource(
+		"Resets all the factors enrolled by a user.",
+		map[string]*schema.Schema{
+			"user_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the user.",
+			},
+		},
+		func(ctx context.Context, d *schema.ResourceData, m interface{}) (string, error) {
+			userID := d.Get("user_id").(string)
+			logger(m).Info("resetting user factors", "user_id", userID)
+			_, err := getOktaClientFromMetadata(m).User.ResetFactors(ctx, userID)
+			if err != nil {
+				return "", fmt.Errorf("failed to reset factors of user (%s): %v", userID, err)
+			}
+			return userID, nil
+		},
+	)
+}
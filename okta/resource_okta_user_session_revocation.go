@@ -0,0 +1,38 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+func resourceUserSessionRevocation() *schema.Resource {
+	return buildActionResource(
+		"Revokes all the sessions of a user.",
+		map[string]*schema.Schema{
+			"user_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the user.",
+			},
+			"oauth_tokens": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to also revoke the OpenID Connect and OAuth refresh and access tokens issued to the user.",
+			},
+		},
+		func(ctx context.Context, d *schema.ResourceData, m interface{}) (string, error) {
+			userID := d.Get("user_id").(string)
+			logger(m).Info("revoking user sessions", "user_id", userID)
+			qp := query.NewQueryParams(query.WithOauthTokens(d.Get("oauth_tokens").(bool)))
+			_, err := getOktaClientFromMetadata(m).User.ClearUserSessions(ctx, userID, qp)
+			if err != nil {
+				return "", fmt.Errorf("failed to revoke sessions of user (%s): %v", userID, err)
+			}
+			return userID, nil
+		},
+	)
+}
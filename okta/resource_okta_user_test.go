@@ -8,12 +8,17 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/okta/okta-sdk-golang/v2/okta"
 	"github.com/okta/okta-sdk-golang/v2/okta/query"
+	"github.com/okta/terraform-provider-okta/sdk"
 )
 
 func sweepUsers(client *testClient) error {
@@ -54,6 +59,7 @@ func TestAccOktaUser_customProfileAttributes(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "last_name", "Smith"),
 					resource.TestCheckResourceAttr(resourceName, "login", email),
 					resource.TestCheckResourceAttr(resourceName, "email", email),
+					resource.TestCheckResourceAttrSet(resourceName, "full_profile_json"),
 					resource.TestCheckResourceAttr(resourceName, "custom_profile_attributes", "{\"customAttribute123\":\"testing-custom-attribute\"}"),
 				),
 			},
@@ -427,3 +433,439 @@ resource okta_user "test" {
 }
 `, r)
 }
+
+func TestAccOktaUser_waitForStatus(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(oktaUser)
+	config := mgr.GetFixtures("wait_for_status.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", oktaUser)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "wait_for_status", statusActive),
+					resource.TestCheckResourceAttr(resourceName, "raw_status", statusActive),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOktaUser_address(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(oktaUser)
+	config := mgr.GetFixtures("address.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", oktaUser)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "address.0.street", "1234 Testing St."),
+					resource.TestCheckResourceAttr(resourceName, "address.0.city", "New York"),
+					resource.TestCheckResourceAttr(resourceName, "address.0.state", "NY"),
+					resource.TestCheckResourceAttr(resourceName, "address.0.zip", "11111"),
+					resource.TestCheckResourceAttr(resourceName, "address.0.country", "US"),
+					resource.TestCheckResourceAttr(resourceName, "city", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestEnsureUserDelete(t *testing.T) {
+	var deletes, gets int32
+	m := newTestOktaClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodDelete:
+			atomic.AddInt32(&deletes, 1)
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodGet:
+			switch atomic.AddInt32(&gets, 1) {
+			case 1:
+				// the deactivation is still propagating
+				_, _ = w.Write([]byte(`{"id":"00u1","status":"ACTIVE"}`))
+			case 2:
+				_, _ = w.Write([]byte(`{"id":"00u1","status":"DEPROVISIONED"}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"errorCode":"E0000007","errorSummary":"Not found"}`))
+			}
+		}
+	}))
+	if err := ensureUserDelete(context.Background(), "00u1", statusActive, m.oktaClient); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deletes != 2 {
+		t.Errorf("expected 2 delete requests, got %d", deletes)
+	}
+	if gets != 3 {
+		t.Errorf("expected 3 get requests, got %d", gets)
+	}
+}
+
+func TestValidatePasswordComplexity(t *testing.T) {
+	complexity := &sdk.PasswordPolicyPasswordSettingsComplexity{
+		ExcludeUsername: boolPtr(true),
+		MinLength:       8,
+		MinLowerCase:    1,
+		MinNumber:       1,
+		MinSymbol:       1,
+		MinUpperCase:    1,
+	}
+	tests := []struct {
+		password string
+		login    string
+		expected string
+	}{
+		{"Abcd123!", "john.doe@example.com", ""},
+		{"Ab1!", "john.doe@example.com", "at least 8 characters"},
+		{"abcd123!", "john.doe@example.com", "at least 1 uppercase letters"},
+		{"ABCD123!", "john.doe@example.com", "at least 1 lowercase letters"},
+		{"Abcdefg!", "john.doe@example.com", "at least 1 numbers"},
+		{"Abcd1234", "john.doe@example.com", "at least 1 symbols"},
+		{"John.Doe123!", "john.doe@example.com", "no parts of the username"},
+		{"John.Doe123!", "", ""},
+	}
+	for _, test := range tests {
+		err := validatePasswordComplexity(test.password, test.login, complexity)
+		if test.expected == "" {
+			if err != nil {
+				t.Errorf("password '%s' is expected to be valid, got: %v", test.password, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), test.expected) {
+			t.Errorf("password '%s' is expected to require %s, got: %v", test.password, test.expected, err)
+		}
+	}
+	if err := validatePasswordComplexity("weak", "", nil); err != nil {
+		t.Errorf("password is expected to be valid without complexity requirements, got: %v", err)
+	}
+}
+
+func TestFindUserPasswordPolicy(t *testing.T) {
+	newPolicy := func(name, provider string, include, exclude []string) *okta.Policy {
+		return &okta.Policy{
+			Name: name,
+			Conditions: &okta.PolicyRuleConditions{
+				People: &okta.PolicyPeopleCondition{
+					Groups: &okta.GroupCondition{Include: include, Exclude: exclude},
+				},
+				AuthProvider: &okta.PasswordPolicyAuthenticationProviderCondition{Provider: provider},
+			},
+		}
+	}
+	policies := []*okta.Policy{
+		newPolicy("AD", "ACTIVE_DIRECTORY", []string{"everyone"}, nil),
+		newPolicy("Admins", "OKTA", []string{"admins"}, []string{"contractors"}),
+		newPolicy("Default Policy", "OKTA", []string{"everyone"}, nil),
+	}
+	tests := []struct {
+		groups   []string
+		expected string
+	}{
+		{[]string{"everyone"}, "Default Policy"},
+		{[]string{"everyone", "admins"}, "Admins"},
+		{[]string{"everyone", "admins", "contractors"}, "Default Policy"},
+		{[]string{"unknown"}, ""},
+	}
+	for _, test := range tests {
+		policy := findUserPasswordPolicy(policies, test.groups)
+		var name string
+		if policy != nil {
+			name = policy.Name
+		}
+		if name != test.expected {
+			t.Errorf("groups %v are expected to fall under the '%s' policy, got '%s'", test.groups, test.expected, name)
+		}
+	}
+}
+
+// newUserStepsTestConfig fakes the Okta API for the requests of the user resource, the request matching the method
+// and the path fails. The requests made are recorded as "<method> <path>".
+func newUserStepsTestConfig(t *testing.T, failMethod, failPath string) (*Config, *[]string) {
+	var (
+		mu       sync.Mutex
+		requests []string
+	)
+	m := newTestOktaClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == failMethod && r.URL.Path == failPath:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"errorCode":"E0000001","errorSummary":"Api validation failed"}`))
+		case r.URL.Path == "/api/v1/users" || r.URL.Path == "/api/v1/users/00u1":
+			_, _ = w.Write([]byte(`{"id":"00u1","status":"ACTIVE","profile":{"firstName":"John","lastName":"Smith","login":"john@example.com","email":"john@example.com"}}`))
+		case r.Method == http.MethodGet:
+			_, _ = w.Write([]byte(`[]`))
+		default:
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}))
+	return m, &requests
+}
+
+func testUserState(t *testing.T, state map[string]interface{}) *terraform.InstanceState {
+	prior := resourceUser().Data(nil)
+	prior.SetId("00u1")
+	for k, v := range state {
+		if err := prior.Set(k, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return prior.State()
+}
+
+func testUserResourceData(t *testing.T, m *Config, s *terraform.InstanceState, config map[string]interface{}) *schema.ResourceData {
+	r := resourceUser()
+	diff, err := r.Diff(context.Background(), s, terraform.NewResourceConfigRaw(config), m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := schema.InternalMap(r.Schema).Data(s, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+// appliedUserSteps returns the steps whose attributes hold the values of testUserStepsConfig
+func appliedUserSteps(d *schema.ResourceData) []string {
+	var steps []string
+	if d.Get("first_name").(string) == "Jane" {
+		steps = append(steps, "profile")
+	}
+	if strSetEqual(convertInterfaceToStringSet(d.Get("admin_roles")), []string{"USER_ADMIN"}) {
+		steps = append(steps, "roles")
+	}
+	if strSetEqual(convertInterfaceToStringSet(d.Get("group_memberships")), []string{"00g2"}) {
+		steps = append(steps, "groups")
+	}
+	if d.Get("password").(string) == "Passw0rd!New" {
+		steps = append(steps, "password")
+	}
+	if d.Get("recovery_answer").(string) == "new answer" {
+		steps = append(steps, "recovery_question")
+	}
+	if d.Get("status").(string) == userStatusDeprovisioned {
+		steps = append(steps, "status")
+	}
+	return steps
+}
+
+var (
+	testUserStepsState = map[string]interface{}{
+		"first_name":              "John",
+		"last_name":               "Smith",
+		"login":                   "john@example.com",
+		"email":                   "john@example.com",
+		"status":                  statusActive,
+		"admin_roles":             []string{"APP_ADMIN"},
+		"group_memberships":       []string{"00g1"},
+		"password":                "Passw0rd!Old",
+		"recovery_question":       "Question",
+		"recovery_answer":         "old answer",
+		"wait_for_status_timeout": 300,
+	}
+	testUserStepsConfig = map[string]interface{}{
+		"first_name":        "Jane",
+		"last_name":         "Smith",
+		"login":             "john@example.com",
+		"email":             "john@example.com",
+		"admin_roles":       []interface{}{"USER_ADMIN"},
+		"group_memberships": []interface{}{"00g2"},
+		"password":          "Passw0rd!New",
+		"recovery_question": "Question",
+		"recovery_answer":   "new answer",
+	}
+)
+
+func TestResourceUserUpdateFailedSteps(t *testing.T) {
+	tests := []struct {
+		name       string
+		failMethod string
+		failPath   string
+		status     string
+		completed  []string
+	}{
+		{"profile", http.MethodPut, "/api/v1/users/00u1", statusActive, nil},
+		{"roles", http.MethodPost, "/api/v1/users/00u1/roles", statusActive, []string{"profile"}},
+		{"group additions", http.MethodPut, "/api/v1/groups/00g2/users/00u1", statusActive, []string{"profile", "roles"}},
+		{"group removals", http.MethodDelete, "/api/v1/groups/00g1/users/00u1", statusActive, []string{"profile", "roles"}},
+		{"password", http.MethodPost, "/api/v1/users/00u1/credentials/change_password", statusActive, []string{"profile", "roles", "groups"}},
+		{"recovery question", http.MethodPost, "/api/v1/users/00u1/credentials/change_recovery_question", statusActive, []string{"profile", "roles", "groups", "password"}},
+		{"deprovisioned", "", "", userStatusDeprovisioned, []string{"status"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m, _ := newUserStepsTestConfig(t, test.failMethod, test.failPath)
+			c := make(map[string]interface{}, len(testUserStepsConfig)+1)
+			for k, v := range testUserStepsConfig {
+				c[k] = v
+			}
+			if test.status != statusActive {
+				c["status"] = test.status
+			}
+			d := testUserResourceData(t, m, testUserState(t, testUserStepsState), c)
+			diags := resourceUser().UpdateContext(context.Background(), d, m)
+			if !diags.HasError() {
+				t.Fatal("expected the update to fail")
+			}
+			// the attributes of the steps which did not complete keep their prior values, so they are planned again
+			if actual := appliedUserSteps(d); !strSetEqual(actual, test.completed) {
+				t.Errorf("expected the attributes of the steps %v to be applied, got %v", test.completed, actual)
+			}
+		})
+	}
+}
+
+func TestResourceUserUpdateResumesSteps(t *testing.T) {
+	m, _ := newUserStepsTestConfig(t, http.MethodPost, "/api/v1/users/00u1/credentials/change_recovery_question")
+	d := testUserResourceData(t, m, testUserState(t, testUserStepsState), testUserStepsConfig)
+	if diags := resourceUser().UpdateContext(context.Background(), d, m); !diags.HasError() {
+		t.Fatal("expected the update to fail")
+	}
+
+	m, requests := newUserStepsTestConfig(t, "", "")
+	d = testUserResourceData(t, m, d.State(), testUserStepsConfig)
+	if diags := resourceUser().UpdateContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("failed to resume the update: %v", diags)
+	}
+	for _, r := range *requests {
+		if r == "PUT /api/v1/users/00u1" || strings.HasPrefix(r, "POST /api/v1/users/00u1/roles") ||
+			strings.HasPrefix(r, "PUT /api/v1/groups/") || r == "POST /api/v1/users/00u1/credentials/change_password" {
+			t.Errorf("expected the completed steps not to be repeated, got request %s", r)
+		}
+	}
+	if !contains(*requests, "POST /api/v1/users/00u1/credentials/change_recovery_question") {
+		t.Errorf("expected the failed step to be repeated, got requests %v", *requests)
+	}
+}
+
+var testUserCreateStepsConfig = map[string]interface{}{
+	"first_name":        "John",
+	"last_name":         "Smith",
+	"login":             "john@example.com",
+	"email":             "john@example.com",
+	"admin_roles":       []interface{}{"USER_ADMIN"},
+	"group_memberships": []interface{}{"00g1"},
+	"status":            userStatusSuspended,
+}
+
+func TestResourceUserCreateFailedSteps(t *testing.T) {
+	tests := []struct {
+		name       string
+		failMethod string
+		failPath   string
+		completed  []string
+	}{
+		{"roles", http.MethodPost, "/api/v1/users/00u1/roles", nil},
+		{"groups", http.MethodPut, "/api/v1/groups/00g1/users/00u1", []string{"roles"}},
+		{"status", http.MethodPost, "/api/v1/users/00u1/lifecycle/suspend", []string{"roles", "groups"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m, _ := newUserStepsTestConfig(t, test.failMethod, test.failPath)
+			d := testUserResourceData(t, m, nil, testUserCreateStepsConfig)
+			diags := resourceUser().CreateContext(context.Background(), d, m)
+			if diags.HasError() {
+				t.Fatalf("expected warnings, so the created user is not tainted, got %v", diags)
+			}
+			if len(diags) == 0 {
+				t.Fatal("expected warnings about the failed step")
+			}
+			if d.Id() != "00u1" {
+				t.Errorf("expected the created user to be kept in the state, got ID '%s'", d.Id())
+			}
+			var completed []string
+			if len(convertInterfaceToStringSet(d.Get("admin_roles"))) > 0 {
+				completed = append(completed, "roles")
+			}
+			if len(convertInterfaceToStringSet(d.Get("group_memberships"))) > 0 {
+				completed = append(completed, "groups")
+			}
+			if d.Get("status").(string) == userStatusSuspended {
+				completed = append(completed, "status")
+			}
+			if !strSetEqual(completed, test.completed) {
+				t.Errorf("expected the attributes of the steps %v to be applied, got %v", test.completed, completed)
+			}
+			if actual := d.Get("first_name").(string); actual != "John" {
+				t.Errorf("expected the attributes of the user create to be applied, got first_name '%s'", actual)
+			}
+		})
+	}
+}
+
+func TestResourceUserCreateResumesSteps(t *testing.T) {
+	m, _ := newUserStepsTestConfig(t, http.MethodPut, "/api/v1/groups/00g1/users/00u1")
+	d := testUserResourceData(t, m, nil, testUserCreateStepsConfig)
+	if diags := resourceUser().CreateContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("expected warnings, got %v", diags)
+	}
+
+	m, requests := newUserStepsTestConfig(t, "", "")
+	d = testUserResourceData(t, m, d.State(), testUserCreateStepsConfig)
+	if diags := resourceUser().UpdateContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("failed to resume the create: %v", diags)
+	}
+	for _, r := range *requests {
+		if r == "PUT /api/v1/users/00u1" || strings.HasPrefix(r, "POST /api/v1/users/00u1/roles") {
+			t.Errorf("expected the completed steps not to be repeated, got request %s", r)
+		}
+	}
+	for _, r := range []string{"PUT /api/v1/groups/00g1/users/00u1", "POST /api/v1/users/00u1/lifecycle/suspend"} {
+		if !contains(*requests, r) {
+			t.Errorf("expected the request %s of the remaining steps, got requests %v", r, *requests)
+		}
+	}
+}
+
+func TestResourceUserCreateWaitForStatusTimeout(t *testing.T) {
+	m, _ := newUserStepsTestConfig(t, "", "")
+	config := map[string]interface{}{
+		"first_name":              "John",
+		"last_name":               "Smith",
+		"login":                   "john@example.com",
+		"email":                   "john@example.com",
+		"wait_for_status":         userStatusProvisioned,
+		"wait_for_status_timeout": 1,
+	}
+	d := testUserResourceData(t, m, nil, config)
+	diags := resourceUser().CreateContext(context.Background(), d, m)
+	if diags.HasError() {
+		t.Fatalf("expected a warning, so the created user is not tainted, got %v", diags)
+	}
+	if len(diags) != 1 || !strings.Contains(diags[0].Detail, "did not reach status PROVISIONED") {
+		t.Errorf("expected a warning about the status, got %v", diags)
+	}
+	if d.Id() != "00u1" {
+		t.Errorf("expected the created user to be kept in the state, got ID '%s'", d.Id())
+	}
+}
+
+func strSetEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, e := range b {
+		if !contains(a, e) {
+			return false
+		}
+	}
+	return true
+}
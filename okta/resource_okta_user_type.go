@@ -35,6 +35,15 @@ func resourceUserType() *schema.Resource {
 				Required:    true,
 				Description: "A human-readable description of the type",
 			},
+			"clone_schema_from": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the user type (or 'default') to copy custom schema attributes from when the type is created",
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					// cloning happens only once, during type creation
+					return d.Id() != ""
+				},
+			},
 		},
 	}
 }
@@ -45,6 +54,12 @@ func resourceUserTypeCreate(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.Errorf("failed to create user type: %v", err)
 	}
 	d.SetId(newUserType.Id)
+	if source, ok := d.GetOk("clone_schema_from"); ok {
+		err = cloneUserTypeSchema(ctx, m, source.(string), newUserType)
+		if err != nil {
+			return diag.Errorf("failed to clone user type schema: %v", err)
+		}
+	}
 	return resourceUserTypeRead(ctx, d, m)
 }
 
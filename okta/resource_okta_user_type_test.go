@@ -66,6 +66,49 @@ func TestAccOktaUserType_crud(t *testing.T) {
 	})
 }
 
+func TestAccOktaUserType_cloneSchema(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := fmt.Sprintf("%s.test", userType)
+	mgr := newFixtureManager(userType)
+	config := mgr.GetFixtures("okta_user_type_clone.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(userType, doesUserTypeExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "clone_schema_from", "default"),
+					testUserTypeSchemaHasProperty(resourceName, buildResourceName(ri)),
+				),
+			},
+		},
+	})
+}
+
+func testUserTypeSchemaHasProperty(name, index string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", name)
+		}
+		schemaURL, err := getUserTypeSchemaUrl(context.Background(), getOktaClientFromMetadata(testAccProvider.Meta()), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		us, _, err := getSupplementFromMetadata(testAccProvider.Meta()).GetUserSchema(context.Background(), schemaURL)
+		if err != nil {
+			return err
+		}
+		if getCustomProperty(us, index) == nil {
+			return fmt.Errorf("custom property '%s' was not cloned to user type '%s'", index, rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
 func doesUserTypeExist(id string) (bool, error) {
 	_, response, err := getOktaClientFromMetadata(testAccProvider.Meta()).UserType.GetUserType(context.Background(), id)
 	return doesResourceExist(response, err)
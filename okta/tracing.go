@@ -0,0 +1,118 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// oktaIDPattern matches the path segments holding the IDs of Okta objects, e.g. 00g1emaKYZTWRYYRRTSK
+var oktaIDPattern = regexp.MustCompile(`^[0-9a-zA-Z]{20}$`)
+
+// TracingTransport creates a span for every request sent to the Okta API, along with the endpoint, the status
+// and the rate limit headers of the response
+type TracingTransport struct {
+	T      http.RoundTripper
+	tracer trace.Tracer
+}
+
+func (tt *TracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, span := tt.tracer.Start(req.Context(), tracingSpanName(req), trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPMethodKey.String(req.Method),
+			semconv.HTTPURLKey.String(req.URL.String()),
+			attribute.String("okta.endpoint", tracingEndpoint(req.URL.Path)),
+		))
+	defer span.End()
+	resp, err := tt.T.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return resp, err
+	}
+	span.SetAttributes(
+		semconv.HTTPStatusCodeKey.Int(resp.StatusCode),
+		attribute.String("okta.request_id", resp.Header.Get("X-Okta-Request-Id")),
+		attribute.String("okta.rate_limit.limit", resp.Header.Get("X-Rate-Limit-Limit")),
+		attribute.String("okta.rate_limit.remaining", resp.Header.Get("X-Rate-Limit-Remaining")),
+		attribute.String("okta.rate_limit.reset", resp.Header.Get("X-Rate-Limit-Reset")),
+	)
+	span.SetStatus(semconv.SpanStatusFromHTTPStatusCode(resp.StatusCode))
+	return resp, nil
+}
+
+func tracingSpanName(req *http.Request) string {
+	return fmt.Sprintf("%s %s", req.Method, tracingEndpoint(req.URL.Path))
+}
+
+// tracingEndpoint replaces the IDs in the path of the request, so that the spans of the same endpoint can be grouped,
+// e.g. /api/v1/groups/00g1emaKYZTWRYYRRTSK/users becomes /api/v1/groups/{id}/users
+func tracingEndpoint(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if oktaIDPattern.MatchString(segment) {
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// tracerProviders are the providers created for the traced configurations, they are shut down when the plugin stops
+var (
+	tracerProviders     []*sdktrace.TracerProvider
+	tracerProvidersLock sync.Mutex
+)
+
+// newTracerProvider creates a provider exporting the spans to the given OTLP/HTTP traces endpoint, e.g.
+// http://localhost:4318/v1/traces. The spans are exported in batches, the remaining ones are flushed by ShutdownTracing.
+func newTracerProvider(ctx context.Context, endpoint string) (*sdktrace.TracerProvider, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("invalid tracing endpoint '%s', expected a URL like http://localhost:4318/v1/traces", endpoint)
+	}
+	opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(u.Host)}
+	if u.Scheme == "http" {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+	if u.Path != "" {
+		opts = append(opts, otlptracehttp.WithURLPath(u.Path))
+	}
+	exporter, err := otlptracehttp.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP trace exporter: %v", err)
+	}
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String("terraform-provider-okta"))),
+	)
+	tracerProvidersLock.Lock()
+	tracerProviders = append(tracerProviders, tracerProvider)
+	tracerProvidersLock.Unlock()
+	return tracerProvider, nil
+}
+
+// ShutdownTracing exports the spans that are still batched and shuts down the tracer providers. It is called once
+// Terraform stops the plugin.
+func ShutdownTracing(ctx context.Context) {
+	tracerProvidersLock.Lock()
+	defer tracerProvidersLock.Unlock()
+	for _, tracerProvider := range tracerProviders {
+		if err := tracerProvider.Shutdown(ctx); err != nil {
+			log.Printf("[WARN] failed to export the remaining spans: %v", err)
+		}
+	}
+	tracerProviders = nil
+}
@@ -0,0 +1,61 @@
+package okta
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracingEndpoint(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{"/api/v1/groups", "/api/v1/groups"},
+		{"/api/v1/groups/00g1emaKYZTWRYYRRTSK/users", "/api/v1/groups/{id}/users"},
+		{"/api/v1/apps/0oa1gjh63g214q0Hq0g4/groups/00g1emaKYZTWRYYRRTSK", "/api/v1/apps/{id}/groups/{id}"},
+		{"/api/v1/meta/schemas/user/default", "/api/v1/meta/schemas/user/default"},
+	}
+	for _, test := range tests {
+		if actual := tracingEndpoint(test.path); actual != test.expected {
+			t.Errorf("expected '%s' for '%s', got '%s'", test.expected, test.path, actual)
+		}
+	}
+}
+
+func TestTracingTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Rate-Limit-Remaining", "42")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+	recorder := tracetest.NewSpanRecorder()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	client := &http.Client{Transport: &TracingTransport{T: http.DefaultTransport, tracer: tracerProvider.Tracer("test")}}
+	resp, err := client.Get(server.URL + "/api/v1/users/00u1emaKYZTWRYYRRTSK")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span, got %d", len(spans))
+	}
+	if spans[0].Name() != "GET /api/v1/users/{id}" {
+		t.Errorf("unexpected span name '%s'", spans[0].Name())
+	}
+	attributes := make(map[attribute.Key]attribute.Value)
+	for _, kv := range spans[0].Attributes() {
+		attributes[kv.Key] = kv.Value
+	}
+	if attributes["http.status_code"].AsInt64() != http.StatusTooManyRequests {
+		t.Errorf("unexpected status code attribute %v", attributes["http.status_code"].Emit())
+	}
+	if attributes["okta.rate_limit.remaining"].AsString() != "42" {
+		t.Errorf("unexpected rate limit attribute %v", attributes["okta.rate_limit.remaining"].Emit())
+	}
+}
@@ -7,10 +7,16 @@ import (
 	"log"
 	"net/http"
 	"reflect"
+	"sort"
+	"strings"
 	"time"
+	"unicode"
 
+	"github.com/cenkalti/backoff/v4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+	"github.com/okta/terraform-provider-okta/sdk"
 )
 
 const (
@@ -76,6 +82,11 @@ var userProfileDataSchema = map[string]*schema.Schema{
 		Type:     schema.TypeString,
 		Computed: true,
 	},
+	"full_profile_json": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "JSON document containing the entire profile of the user as returned by Okta",
+	},
 	"group_memberships": {
 		Type:     schema.TypeSet,
 		Computed: true,
@@ -246,9 +257,62 @@ func populateUserProfile(d *schema.ResourceData) *okta.UserProfile {
 		profile["postalAddress"] = nil
 	}
 
+	if address, ok := d.GetOk("address.0"); ok {
+		a := address.(map[string]interface{})
+		for k, v := range userAddressProfileKeys {
+			if a[k].(string) != "" {
+				profile[v] = a[k].(string)
+			}
+		}
+		profile["postalAddress"] = buildPostalAddress(a)
+	}
+
 	return &profile
 }
 
+// userAddressProfileKeys maps the properties of the address block to the user profile properties
+var userAddressProfileKeys = map[string]string{
+	"street":  "streetAddress",
+	"city":    "city",
+	"state":   "state",
+	"zip":     "zipCode",
+	"country": "countryCode",
+}
+
+// buildPostalAddress formats the address block as a multiline mailing address, e.g.
+// "1234 Testing St.\nNew York, NY 10001\nUS".
+func buildPostalAddress(a map[string]interface{}) interface{} {
+	cityLine := strings.Join(nonEmpty(a["state"].(string), a["zip"].(string)), " ")
+	cityLine = strings.Join(nonEmpty(a["city"].(string), cityLine), ", ")
+	lines := nonEmpty(a["street"].(string), cityLine, a["country"].(string))
+	if len(lines) == 0 {
+		return nil
+	}
+	return strings.Join(lines, "\n")
+}
+
+func nonEmpty(values ...string) []string {
+	var res []string
+	for _, v := range values {
+		if v != "" {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+// flattenUserAddress moves the flat address properties of the flattened user into the address block.
+func flattenUserAddress(attrs map[string]interface{}) []interface{} {
+	address := map[string]interface{}{}
+	for k, v := range userAddressProfileKeys {
+		key := camelCaseToUnderscore(v)
+		address[k], _ = attrs[key].(string)
+		attrs[key] = ""
+	}
+	attrs["postal_address"] = ""
+	return []interface{}{address}
+}
+
 func listUserOnlyRoles(ctx context.Context, c *okta.Client, userID string) (userOnlyRoles []*okta.Role, resp *okta.Response, err error) {
 	roles, resp, err := c.User.ListAssignedRolesForUser(ctx, userID, nil)
 	if err != nil {
@@ -364,6 +428,9 @@ func flattenUser(u *okta.User) map[string]interface{} {
 	data, _ := json.Marshal(customAttributes)
 	attrs["custom_profile_attributes"] = string(data)
 
+	data, _ = json.Marshal(u.Profile)
+	attrs["full_profile_json"] = string(data)
+
 	return attrs
 }
 
@@ -435,3 +502,266 @@ func waitForStatusTransition(ctx context.Context, u string, c *okta.Client) erro
 		}
 	}
 }
+
+// waitForUserStatus polls the user until its raw status reaches the 'wait_for_status', e.g. when a PROVISIONED user
+// becomes ACTIVE after completing the activation flow. Does nothing if 'wait_for_status' is not set.
+func waitForUserStatus(ctx context.Context, d *schema.ResourceData, c *okta.Client) error {
+	expected, ok := d.GetOk("wait_for_status")
+	if !ok {
+		return nil
+	}
+	bOff := backoff.NewExponentialBackOff()
+	bOff.MaxElapsedTime = time.Second * time.Duration(d.Get("wait_for_status_timeout").(int))
+	bOff.InitialInterval = time.Second
+	bOff.MaxInterval = time.Second * 10
+	var status string
+	err := backoff.Retry(func() error {
+		status = ""
+		user, _, err := c.User.GetUser(ctx, d.Id())
+		if err != nil {
+			return backoff.Permanent(fmt.Errorf("failed to get user: %v", err))
+		}
+		status = user.Status
+		if status != expected.(string) {
+			return fmt.Errorf("user status is %s", status)
+		}
+		return nil
+	}, backoff.WithContext(bOff, ctx))
+	if err != nil && status != "" && status != expected.(string) {
+		return fmt.Errorf("user %s did not reach status %s in %d seconds, the last status is %s",
+			d.Id(), expected.(string), d.Get("wait_for_status_timeout").(int), status)
+	}
+	return err
+}
+
+// waitForUserDeprovisioned polls the user until it reaches the DEPROVISIONED status, returns whether the user was
+// deleted in the meantime.
+func waitForUserDeprovisioned(ctx context.Context, id string, c *okta.Client) (bool, error) {
+	bOff := backoff.NewExponentialBackOff()
+	bOff.MaxElapsedTime = time.Minute
+	bOff.InitialInterval = time.Second
+	bOff.MaxInterval = time.Second * 10
+	var status string
+	var deleted bool
+	err := backoff.Retry(func() error {
+		user, resp, err := c.User.GetUser(ctx, id)
+		if is404(resp) {
+			deleted = true
+			return nil
+		}
+		if err != nil {
+			return backoff.Permanent(fmt.Errorf("failed to get user: %v", err))
+		}
+		status = user.Status
+		if status != userStatusDeprovisioned {
+			return fmt.Errorf("user status is %s", status)
+		}
+		return nil
+	}, backoff.WithContext(bOff, ctx))
+	if err != nil && status != "" && status != userStatusDeprovisioned {
+		return false, fmt.Errorf("user %s was not deprovisioned in %s, the last status is %s", id, bOff.MaxElapsedTime, status)
+	}
+	return deleted, err
+}
+
+// getPasswordPoliciesCached fetches the active password policies and the ID of the Everyone group once per provider
+// run, since they are used to validate the passwords of every user in the plan. The policies are sorted by priority.
+func getPasswordPoliciesCached(ctx context.Context, m interface{}) ([]*okta.Policy, string, error) {
+	config := m.(*Config)
+	config.passwordPoliciesLock.Lock()
+	defer config.passwordPoliciesLock.Unlock()
+	if config.passwordPoliciesLoaded {
+		return config.passwordPolicies, config.everyoneGroupID, nil
+	}
+	client := getOktaClientFromMetadata(m)
+	policies, resp, err := client.Policy.ListPolicies(ctx, &query.Params{Type: sdk.PasswordPolicyType})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list password policies: %v", err)
+	}
+	var active []*okta.Policy
+	for {
+		for _, policy := range policies {
+			if policy.Status == statusActive {
+				active = append(active, policy)
+			}
+		}
+		if !resp.HasNextPage() {
+			break
+		}
+		resp, err = resp.Next(ctx, &policies)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to list password policies: %v", err)
+		}
+	}
+	sort.SliceStable(active, func(i, j int) bool { return active[i].Priority < active[j].Priority })
+	groups, _, err := client.Group.ListGroups(ctx, &query.Params{Q: groupProfileEveryone})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to find the %s group: %v", groupProfileEveryone, err)
+	}
+	for _, group := range groups {
+		if group.Type == "BUILT_IN" && group.Profile.Name == groupProfileEveryone {
+			config.everyoneGroupID = group.Id
+		}
+	}
+	config.passwordPolicies = active
+	config.passwordComplexities = make(map[string]*sdk.PasswordPolicyPasswordSettingsComplexity)
+	config.passwordPoliciesLoaded = true
+	return config.passwordPolicies, config.everyoneGroupID, nil
+}
+
+// getPasswordComplexityCached fetches the complexity requirements of the password policy once per provider run
+func getPasswordComplexityCached(ctx context.Context, m interface{}, policyID string) (*sdk.PasswordPolicyPasswordSettingsComplexity, error) {
+	config := m.(*Config)
+	config.passwordPoliciesLock.Lock()
+	defer config.passwordPoliciesLock.Unlock()
+	if complexity, ok := config.passwordComplexities[policyID]; ok {
+		return complexity, nil
+	}
+	policy, _, err := getSupplementFromMetadata(m).GetPolicy(ctx, policyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get password policy: %v", err)
+	}
+	var complexity *sdk.PasswordPolicyPasswordSettingsComplexity
+	if policy.Settings != nil && policy.Settings.Password != nil {
+		complexity = policy.Settings.Password.Complexity
+	}
+	config.passwordComplexities[policyID] = complexity
+	return complexity, nil
+}
+
+// findUserPasswordPolicy returns the first policy in priority order which includes one of the groups of the user
+// and excludes none of them. Policies of other authentication providers than Okta don't apply to the users managed
+// by the provider.
+func findUserPasswordPolicy(policies []*okta.Policy, groups []string) *okta.Policy {
+	for _, policy := range policies {
+		conditions := policy.Conditions
+		if conditions == nil || conditions.People == nil || conditions.People.Groups == nil {
+			continue
+		}
+		if conditions.AuthProvider != nil && conditions.AuthProvider.Provider != "" && conditions.AuthProvider.Provider != "OKTA" {
+			continue
+		}
+		if containsOne(groups, conditions.People.Groups.Include...) && !containsOne(groups, conditions.People.Groups.Exclude...) {
+			return policy
+		}
+	}
+	return nil
+}
+
+// userPasswordPolicyGroups returns the groups the user is in when the password is set, or false when they can't be
+// determined during the plan. A new user is only in the Everyone group when it's created, since the groups are
+// assigned afterwards. The groups of an existing user are updated before its password.
+func userPasswordPolicyGroups(ctx context.Context, d *schema.ResourceDiff, m interface{}, everyoneGroupID string) ([]string, bool, error) {
+	if d.Id() == "" {
+		return []string{everyoneGroupID}, true, nil
+	}
+	if d.HasChange("group_memberships") && !d.NewValueKnown("group_memberships") {
+		return nil, false, nil
+	}
+	userGroups, _, err := getOktaClientFromMetadata(m).User.ListUserGroups(ctx, d.Id())
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to list user groups: %v", err)
+	}
+	groups := make([]interface{}, len(userGroups))
+	for i := range userGroups {
+		groups[i] = userGroups[i].Id
+	}
+	groupSet := schema.NewSet(schema.HashString, groups)
+	if d.HasChange("group_memberships") {
+		oldGM, newGM := d.GetChange("group_memberships")
+		oldSet := oldGM.(*schema.Set)
+		newSet := newGM.(*schema.Set)
+		groupSet = groupSet.Difference(oldSet.Difference(newSet)).Union(newSet.Difference(oldSet))
+	}
+	return convertInterfaceArrToStringArr(groupSet.List()), true, nil
+}
+
+// validateUserPasswordDiff checks the password of the user against the password policy which applies to the groups
+// of the user, so that the creation of lots of users does not fail halfway through on weak passwords. It's only done
+// when enabled in the provider config, validation is skipped if the policy can not be determined.
+func validateUserPasswordDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !m.(*Config).validateUserPassword || !d.HasChange("password") || !d.NewValueKnown("password") {
+		return nil
+	}
+	password := d.Get("password").(string)
+	if password == "" {
+		return nil
+	}
+	policies, everyoneGroupID, err := getPasswordPoliciesCached(ctx, m)
+	if err != nil {
+		logger(m).Warn("failed to get password policies, skipping password validation", "error", err)
+		return nil
+	}
+	groups, ok, err := userPasswordPolicyGroups(ctx, d, m, everyoneGroupID)
+	if err != nil {
+		logger(m).Warn("failed to get the groups of the user, skipping password validation", "error", err)
+		return nil
+	}
+	if !ok {
+		return nil
+	}
+	policy := findUserPasswordPolicy(policies, groups)
+	if policy == nil {
+		return nil
+	}
+	complexity, err := getPasswordComplexityCached(ctx, m, policy.Id)
+	if err != nil {
+		logger(m).Warn("failed to get password policy, skipping password validation", "error", err)
+		return nil
+	}
+	var login string
+	if d.NewValueKnown("login") {
+		login = d.Get("login").(string)
+	}
+	if err := validatePasswordComplexity(password, login, complexity); err != nil {
+		return fmt.Errorf("%v of the '%s' password policy", err, policy.Name)
+	}
+	return nil
+}
+
+// validatePasswordComplexity checks the length and the character classes of the password, the dictionary
+// and the excluded profile attributes other than the username can only be checked by Okta
+func validatePasswordComplexity(password, login string, complexity *sdk.PasswordPolicyPasswordSettingsComplexity) error {
+	if complexity == nil {
+		return nil
+	}
+	var lower, upper, number, symbol int64
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower++
+		case unicode.IsUpper(r):
+			upper++
+		case unicode.IsDigit(r):
+			number++
+		default:
+			symbol++
+		}
+	}
+	var errs []string
+	if length := int64(len([]rune(password))); length < complexity.MinLength {
+		errs = append(errs, fmt.Sprintf("at least %d characters", complexity.MinLength))
+	}
+	if lower < complexity.MinLowerCase {
+		errs = append(errs, fmt.Sprintf("at least %d lowercase letters", complexity.MinLowerCase))
+	}
+	if upper < complexity.MinUpperCase {
+		errs = append(errs, fmt.Sprintf("at least %d uppercase letters", complexity.MinUpperCase))
+	}
+	if number < complexity.MinNumber {
+		errs = append(errs, fmt.Sprintf("at least %d numbers", complexity.MinNumber))
+	}
+	if symbol < complexity.MinSymbol {
+		errs = append(errs, fmt.Sprintf("at least %d symbols", complexity.MinSymbol))
+	}
+	if complexity.ExcludeUsername != nil && *complexity.ExcludeUsername && login != "" {
+		username := strings.SplitN(login, "@", 2)[0]
+		if strings.Contains(strings.ToLower(password), strings.ToLower(username)) {
+			errs = append(errs, "no parts of the username")
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("password must contain %s to meet the complexity requirements", strings.Join(errs, ", "))
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"net/url"
 
 	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/terraform-provider-okta/sdk"
 )
 
 func getUserTypeSchemaUrl(ctx context.Context, client *okta.Client, id string) (string, error) {
@@ -19,6 +20,35 @@ func getUserTypeSchemaUrl(ctx context.Context, client *okta.Client, id string) (
 	return userTypeURL(ut), nil
 }
 
+// cloneUserTypeSchema copies all the custom attributes of the source user type schema to the target user type
+func cloneUserTypeSchema(ctx context.Context, m interface{}, sourceID string, target *okta.UserType) error {
+	sourceURL, err := getUserTypeSchemaUrl(ctx, getOktaClientFromMetadata(m), sourceID)
+	if err != nil {
+		return err
+	}
+	sourceSchema, _, err := getSupplementFromMetadata(m).GetUserSchema(ctx, sourceURL)
+	if err != nil {
+		return fmt.Errorf("failed to get source user type schema: %v", err)
+	}
+	if sourceSchema.Definitions == nil || sourceSchema.Definitions.Custom == nil ||
+		len(sourceSchema.Definitions.Custom.Properties) == 0 {
+		return nil
+	}
+	_, _, err = getSupplementFromMetadata(m).UpdateUserSchemaProperty(ctx, userTypeURL(target), &sdk.UserSchema{
+		Definitions: &sdk.UserSchemaDefinitions{
+			Custom: &sdk.UserSubSchemaProperties{
+				ID:         "#custom",
+				Type:       "object",
+				Properties: sourceSchema.Definitions.Custom.Properties,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update user type schema: %v", err)
+	}
+	return nil
+}
+
 func userTypeURL(ut *okta.UserType) string {
 	fm, ok := ut.Links.(map[string]interface{})
 	if ok {
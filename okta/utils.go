@@ -10,6 +10,7 @@ import (
 	"unicode"
 
 	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/okta-sdk-golang/v2/okta"
 	"github.com/okta/terraform-provider-okta/sdk"
@@ -268,6 +269,119 @@ func getNullableInt(d *schema.ResourceData, key string) *int {
 	return nil
 }
 
+// Sets the prior state values of the given keys. Used when an update that consists of several requests fails
+// part way through, so the changes are planned again.
+func revertChanges(d *schema.ResourceData, keys ...string) {
+	for _, k := range keys {
+		o, _ := d.GetChange(k)
+		_ = d.Set(k, o)
+	}
+}
+
+// Resources making several dependent requests apply their attributes in steps: the main request applies most of
+// them, while the rest are applied by the requests made after it. When one of the requests fails, the state records
+// the attributes of the steps that completed, and keeps the prior values of the others. The next apply then plans
+// only the changes that were not applied and resumes with the steps that did not complete, without making the
+// completed requests again. The plugin SDK gives no access to the private state of resources, so the progress is
+// recorded by the state of the attributes themselves.
+type resourceSteps struct {
+	stepKeys  map[string]bool
+	completed map[string]bool
+	main      bool
+}
+
+// stepsFrom returns the steps of the resource being applied, or nil if the resource has no steps, see
+// withResumableSteps. A nil resourceSteps runs the steps without recording them.
+func stepsFrom(ctx context.Context) *resourceSteps {
+	s, _ := ctx.Value(resumableSteps).(*resourceSteps)
+	return s
+}
+
+// runMain makes the main request, which applies all the attributes not applied by the other steps along with the
+// given ones.
+func (s *resourceSteps) runMain(step func() error, keys ...string) error {
+	if err := step(); err != nil {
+		return err
+	}
+	if s != nil {
+		s.main = true
+		s.complete(keys)
+	}
+	return nil
+}
+
+// run makes the requests of the step applying the given attributes.
+func (s *resourceSteps) run(keys []string, step func() error) error {
+	if err := step(); err != nil {
+		return err
+	}
+	if s != nil {
+		s.complete(keys)
+	}
+	return nil
+}
+
+func (s *resourceSteps) complete(keys []string) {
+	for _, k := range keys {
+		s.completed[k] = true
+	}
+}
+
+func (s *resourceSteps) applied(key string) bool {
+	if s.stepKeys[key] {
+		return s.completed[key]
+	}
+	return s.main || s.completed[key]
+}
+
+// revert sets the prior values of the attributes which were not applied
+func (s *resourceSteps) revert(r *schema.Resource, d *schema.ResourceData) {
+	for k := range r.Schema {
+		if !s.applied(k) {
+			revertChanges(d, k)
+		}
+	}
+}
+
+// withResumableSteps makes the create and update of the resource resumable, see resourceSteps. The given attributes
+// are applied by the steps following the main request. A create which fails after the resource was created returns
+// warnings instead of errors, so the resource is not tainted and the next apply only makes the remaining requests.
+func withResumableSteps(r *schema.Resource, stepKeys ...string) *schema.Resource {
+	newSteps := func() *resourceSteps {
+		s := &resourceSteps{stepKeys: make(map[string]bool), completed: make(map[string]bool)}
+		for _, k := range stepKeys {
+			s.stepKeys[k] = true
+		}
+		return s
+	}
+	create, update := r.CreateContext, r.UpdateContext
+	r.CreateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		s := newSteps()
+		diags := create(context.WithValue(ctx, resumableSteps, s), d, m)
+		if !diags.HasError() || d.Id() == "" {
+			return diags
+		}
+		s.revert(r, d)
+		for i := range diags {
+			diags[i].Severity = diag.Warning
+		}
+		return append(diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "Resource was created, but some of its attributes were not applied",
+			Detail:   "The attributes which were not applied are planned again, the next apply resumes with them.",
+		}}, diags...)
+	}
+	r.UpdateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		s := newSteps()
+		diags := update(context.WithValue(ctx, resumableSteps, s), d, m)
+		if diags.HasError() {
+			s.revert(r, d)
+		}
+		return diags
+	}
+	return r
+}
+
 // Useful shortcut for suppressing errors from Okta's SDK when a resource does not exist. Usually used during deletion
 // of nested resources.
 func suppressErrorOn404(resp *okta.Response, err error) error {
@@ -112,3 +112,18 @@ func TestBuildSchema(t *testing.T) {
 		}
 	}
 }
+
+func TestRevertChanges(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"a": {Type: schema.TypeString, Optional: true},
+		"b": {Type: schema.TypeString, Optional: true},
+	}
+	d := schema.TestResourceDataRaw(t, s, map[string]interface{}{"a": "new a", "b": "new b"})
+	revertChanges(d, "a")
+	if actual := d.Get("a").(string); actual != "" {
+		t.Errorf("revertChanges test failed, expected prior value of 'a' to be set, actual %s", actual)
+	}
+	if actual := d.Get("b").(string); actual != "new b" {
+		t.Errorf("revertChanges test failed, expected 'b' to keep its new value, actual %s", actual)
+	}
+}
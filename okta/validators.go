@@ -199,3 +199,34 @@ func stringIsVersion(i interface{}, k cty.Path) diag.Diagnostics {
 	}
 	return nil
 }
+
+// appTargetRegex matches the app name of the OIN catalog, e.g. 'salesforce', optionally followed by the app instance ID,
+// e.g. 'salesforce.0oapsqQ6dv19pqyEo0g3'
+var appTargetRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]+(\.[a-zA-Z0-9]+)?$`)
+
+func stringIsAppTarget(i interface{}, k cty.Path) diag.Diagnostics {
+	v, ok := i.(string)
+	if !ok {
+		return diag.Errorf("expected type of %s to be string", k)
+	}
+	if !appTargetRegex.MatchString(v) {
+		return diag.Errorf("expected app target to be either an app name, e.g. 'salesforce', or an app name and app instance ID, "+
+			"e.g. 'salesforce.0oapsqQ6dv19pqyEo0g3', got '%s'", v)
+	}
+	return nil
+}
+
+// permissionRegex matches the permissions of the custom admin roles, e.g. 'okta.users.lifecycle.manage'. Okta keeps adding
+// permissions, so only their format is validated.
+var permissionRegex = regexp.MustCompile(`^okta(\.[a-zA-Z]+)+$`)
+
+func stringIsPermission(i interface{}, k cty.Path) diag.Diagnostics {
+	v, ok := i.(string)
+	if !ok {
+		return diag.Errorf("expected type of %s to be string", k)
+	}
+	if !permissionRegex.MatchString(v) {
+		return diag.Errorf("expected permission to be in the 'okta.<resource>.<action>' format, e.g. 'okta.users.read', got '%s'", v)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+// AccessPolicyRule is a rule of the app sign-on (access) policy. Its conditions and actions differ from
+// the ones of the other policy rules, hence the separate model.
+type AccessPolicyRule struct {
+	Id          string                      `json:"id,omitempty"`
+	Type        string                      `json:"type,omitempty"`
+	Name        string                      `json:"name,omitempty"`
+	Status      string                      `json:"status,omitempty"`
+	Priority    int64                       `json:"priority,omitempty"`
+	System      *bool                       `json:"system,omitempty"`
+	Created     *time.Time                  `json:"created,omitempty"`
+	LastUpdated *time.Time                  `json:"lastUpdated,omitempty"`
+	Conditions  *AccessPolicyRuleConditions `json:"conditions,omitempty"`
+	Actions     AccessPolicyRuleActions     `json:"actions,omitempty"`
+}
+
+type AccessPolicyRuleConditions struct {
+	Network     *okta.PolicyNetworkCondition       `json:"network,omitempty"`
+	People      *okta.PolicyPeopleCondition        `json:"people,omitempty"`
+	RiskScore   *okta.RiskScorePolicyRuleCondition `json:"riskScore,omitempty"`
+	Device      *AccessPolicyDeviceCondition       `json:"device,omitempty"`
+	ElCondition *AccessPolicyExpressionCondition   `json:"elCondition,omitempty"`
+}
+
+type AccessPolicyDeviceCondition struct {
+	Registered *bool `json:"registered,omitempty"`
+	Managed    *bool `json:"managed,omitempty"`
+}
+
+type AccessPolicyExpressionCondition struct {
+	Condition string `json:"condition,omitempty"`
+}
+
+type AccessPolicyRuleActions struct {
+	AppSignOn *AccessPolicyRuleAppSignOn `json:"appSignOn,omitempty"`
+}
+
+type AccessPolicyRuleAppSignOn struct {
+	Access             string                          `json:"access,omitempty"`
+	VerificationMethod *AccessPolicyVerificationMethod `json:"verificationMethod,omitempty"`
+}
+
+type AccessPolicyVerificationMethod struct {
+	FactorMode       string                   `json:"factorMode,omitempty"`
+	ReauthenticateIn string                   `json:"reauthenticateIn,omitempty"`
+	Type             string                   `json:"type,omitempty"`
+	Constraints      []map[string]interface{} `json:"constraints,omitempty"`
+}
+
+// CreateAccessPolicyRule creates a rule of the app sign-on (access) policy.
+func (m *ApiSupplement) CreateAccessPolicyRule(ctx context.Context, policyID string, body AccessPolicyRule) (*AccessPolicyRule, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/policies/%v/rules", policyID)
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPost, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var rule AccessPolicyRule
+	resp, err := m.RequestExecutor.Do(ctx, req, &rule)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &rule, resp, nil
+}
+
+// GetAccessPolicyRule gets a rule of the app sign-on (access) policy.
+func (m *ApiSupplement) GetAccessPolicyRule(ctx context.Context, policyID, ruleID string) (*AccessPolicyRule, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/policies/%v/rules/%v", policyID, ruleID)
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var rule AccessPolicyRule
+	resp, err := m.RequestExecutor.Do(ctx, req, &rule)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &rule, resp, nil
+}
+
+// UpdateAccessPolicyRule updates a rule of the app sign-on (access) policy.
+func (m *ApiSupplement) UpdateAccessPolicyRule(ctx context.Context, policyID, ruleID string, body AccessPolicyRule) (*AccessPolicyRule, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/policies/%v/rules/%v", policyID, ruleID)
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPut, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var rule AccessPolicyRule
+	resp, err := m.RequestExecutor.Do(ctx, req, &rule)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &rule, resp, nil
+}
@@ -0,0 +1,81 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+const UserProvisioningFeature = "USER_PROVISIONING"
+
+type ApplicationFeature struct {
+	Name         string                   `json:"name,omitempty"`
+	Status       string                   `json:"status,omitempty"`
+	Description  string                   `json:"description,omitempty"`
+	Capabilities *ApplicationCapabilities `json:"capabilities,omitempty"`
+}
+
+type ApplicationCapabilities struct {
+	Create *CapabilitiesCreate `json:"create,omitempty"`
+	Update *CapabilitiesUpdate `json:"update,omitempty"`
+}
+
+type CapabilitiesCreate struct {
+	LifecycleCreate *LifecycleCreateSetting `json:"lifecycleCreate,omitempty"`
+}
+
+type CapabilitiesUpdate struct {
+	LifecycleDeactivate *LifecycleDeactivateSetting `json:"lifecycleDeactivate,omitempty"`
+	Password            *PasswordSetting            `json:"password,omitempty"`
+	Profile             *ProfileSetting             `json:"profile,omitempty"`
+}
+
+type LifecycleCreateSetting struct {
+	Status string `json:"status,omitempty"`
+}
+
+type LifecycleDeactivateSetting struct {
+	Status string `json:"status,omitempty"`
+}
+
+type PasswordSetting struct {
+	Change string `json:"change,omitempty"`
+	Seed   string `json:"seed,omitempty"`
+	Status string `json:"status,omitempty"`
+}
+
+type ProfileSetting struct {
+	Status string `json:"status,omitempty"`
+}
+
+// GetApplicationFeature gets the feature (e.g. USER_PROVISIONING) of the application
+func (m *ApiSupplement) GetApplicationFeature(ctx context.Context, appID, name string) (*ApplicationFeature, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/features/%s", appID, name)
+	req, err := m.RequestExecutor.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var feature ApplicationFeature
+	resp, err := m.RequestExecutor.Do(ctx, req, &feature)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &feature, resp, nil
+}
+
+// UpdateApplicationFeature updates the capabilities of the application feature
+func (m *ApiSupplement) UpdateApplicationFeature(ctx context.Context, appID, name string, capabilities ApplicationCapabilities) (*ApplicationFeature, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/features/%s", appID, name)
+	req, err := m.RequestExecutor.NewRequest(http.MethodPut, url, capabilities)
+	if err != nil {
+		return nil, nil, err
+	}
+	var feature ApplicationFeature
+	resp, err := m.RequestExecutor.Do(ctx, req, &feature)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &feature, resp, nil
+}
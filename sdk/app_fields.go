@@ -0,0 +1,135 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+// RawApp is the document of an app as sent to and returned by the API. The okta-sdk-golang app models lack some of
+// the app fields, so they are read from the same document the model is decoded from, and set on the document the
+// model is encoded to. This way the app is fetched once per read and put once per update.
+type RawApp map[string]interface{}
+
+// AppFields sets the app fields missing from the app model on the document of the app
+type AppFields func(app RawApp)
+
+// GetApp gets the app document and decodes it into the app model, the model can be nil
+func (m *ApiSupplement) GetApp(ctx context.Context, appID string, app okta.App) (RawApp, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s", appID)
+	return m.sendApp(ctx, http.MethodGet, url, nil, app)
+}
+
+// CreateApp creates the app from the model and the fields missing from it. The model is updated with the created app.
+func (m *ApiSupplement) CreateApp(ctx context.Context, app okta.App, activate bool, fields ...AppFields) (RawApp, *okta.Response, error) {
+	body, err := newRawApp(app, fields)
+	if err != nil {
+		return nil, nil, err
+	}
+	url := fmt.Sprintf("/api/v1/apps?activate=%t", activate)
+	return m.sendApp(ctx, http.MethodPost, url, body, app)
+}
+
+// UpdateApp updates the app from the model and the fields missing from it. The model is updated with the updated app.
+func (m *ApiSupplement) UpdateApp(ctx context.Context, appID string, app okta.App, fields ...AppFields) (RawApp, *okta.Response, error) {
+	body, err := newRawApp(app, fields)
+	if err != nil {
+		return nil, nil, err
+	}
+	url := fmt.Sprintf("/api/v1/apps/%s", appID)
+	return m.sendApp(ctx, http.MethodPut, url, body, app)
+}
+
+// updateRawApp changes the fields of the app document as it is, for the updates which don't have the app model
+func (m *ApiSupplement) updateRawApp(ctx context.Context, appID string, fields AppFields) (*okta.Response, error) {
+	app, resp, err := m.GetApp(ctx, appID, nil)
+	if err != nil {
+		return resp, err
+	}
+	fields(app)
+	url := fmt.Sprintf("/api/v1/apps/%s", appID)
+	_, resp, err = m.sendApp(ctx, http.MethodPut, url, app, nil)
+	return resp, err
+}
+
+func (m *ApiSupplement) sendApp(ctx context.Context, method, url string, body RawApp, app okta.App) (RawApp, *okta.Response, error) {
+	var req *http.Request
+	var err error
+	if body == nil {
+		req, err = m.RequestExecutor.NewRequest(method, url, nil)
+	} else {
+		req, err = m.RequestExecutor.NewRequest(method, url, body)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	var doc json.RawMessage
+	resp, err := m.RequestExecutor.Do(ctx, req, &doc)
+	if err != nil {
+		return nil, resp, err
+	}
+	var raw RawApp
+	if err := json.Unmarshal(doc, &raw); err != nil {
+		return nil, resp, err
+	}
+	if app != nil {
+		if err := json.Unmarshal(doc, app); err != nil {
+			return nil, resp, err
+		}
+	}
+	return raw, resp, nil
+}
+
+func newRawApp(app okta.App, fields []AppFields) (RawApp, error) {
+	b, err := json.Marshal(app)
+	if err != nil {
+		return nil, err
+	}
+	var raw RawApp
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, err
+	}
+	for _, f := range fields {
+		if f != nil {
+			f(raw)
+		}
+	}
+	return raw, nil
+}
+
+// decode decodes the part of the document under the keys into v, v is left as it is if the part is missing
+func (a RawApp) decode(v interface{}, keys ...string) {
+	var part interface{} = map[string]interface{}(a)
+	for _, key := range keys {
+		obj, ok := part.(map[string]interface{})
+		if !ok {
+			return
+		}
+		part = obj[key]
+	}
+	if part == nil {
+		return
+	}
+	b, err := json.Marshal(part)
+	if err != nil {
+		return
+	}
+	_ = json.Unmarshal(b, v)
+}
+
+// object returns the object under the keys of the document, adding the missing ones
+func (a RawApp) object(keys ...string) map[string]interface{} {
+	obj := map[string]interface{}(a)
+	for _, key := range keys {
+		child, ok := obj[key].(map[string]interface{})
+		if !ok {
+			child = map[string]interface{}{}
+			obj[key] = child
+		}
+		obj = child
+	}
+	return obj
+}
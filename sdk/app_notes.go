@@ -0,0 +1,20 @@
+package sdk
+
+type AppNotes struct {
+	Admin   string `json:"admin,omitempty"`
+	Enduser string `json:"enduser,omitempty"`
+}
+
+// Notes returns app's admin and end user notes
+func (a RawApp) Notes() *AppNotes {
+	notes := &AppNotes{}
+	a.decode(notes, "settings", "notes")
+	return notes
+}
+
+// WithAppNotes sets app's admin and end user notes
+func WithAppNotes(notes *AppNotes) AppFields {
+	return func(app RawApp) {
+		app.object("settings")["notes"] = notes
+	}
+}
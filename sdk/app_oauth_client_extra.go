@@ -0,0 +1,33 @@
+package sdk
+
+// AppOAuthClientExtra holds the OAuth app's sender-constrained token settings missing from
+// okta.OpenIdConnectApplicationSettingsClient
+type AppOAuthClientExtra struct {
+	// DPoPBoundAccessTokens requires DPoP (Demonstrating Proof-of-Possession) bound access tokens
+	DPoPBoundAccessTokens bool `json:"dpop_bound_access_tokens"`
+	// TLSClientAuthSubjectDN is the subject DN of the client certificate when the client authenticates with mutual TLS
+	TLSClientAuthSubjectDN string `json:"tls_client_auth_subject_dn,omitempty"`
+	// TLSClientCertificateBoundAccessTokens requires the access tokens to be bound to the client certificate
+	TLSClientCertificateBoundAccessTokens bool `json:"tls_client_certificate_bound_access_tokens"`
+}
+
+// OAuthClientExtra returns OAuth app's DPoP and mutual TLS settings
+func (a RawApp) OAuthClientExtra() *AppOAuthClientExtra {
+	extra := &AppOAuthClientExtra{}
+	a.decode(extra, "settings", "oauthClient")
+	return extra
+}
+
+// WithAppOAuthClientExtra sets OAuth app's DPoP and mutual TLS settings
+func WithAppOAuthClientExtra(extra *AppOAuthClientExtra) AppFields {
+	return func(app RawApp) {
+		oauthClient := app.object("settings", "oauthClient")
+		oauthClient["dpop_bound_access_tokens"] = extra.DPoPBoundAccessTokens
+		oauthClient["tls_client_certificate_bound_access_tokens"] = extra.TLSClientCertificateBoundAccessTokens
+		if extra.TLSClientAuthSubjectDN == "" {
+			delete(oauthClient, "tls_client_auth_subject_dn")
+		} else {
+			oauthClient["tls_client_auth_subject_dn"] = extra.TLSClientAuthSubjectDN
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+// SetAppAuthenticationPolicy assigns app sign-on (access) policy to the app
+func (m *ApiSupplement) SetAppAuthenticationPolicy(ctx context.Context, appID, policyID string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/policies/%s", appID, policyID)
+	req, err := m.RequestExecutor.NewRequest(http.MethodPut, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
@@ -0,0 +1,14 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+// UpdateAppSigningKey sets the key credential used by the app for signing
+func (m *ApiSupplement) UpdateAppSigningKey(ctx context.Context, appID, kid string) (*okta.Response, error) {
+	return m.updateRawApp(ctx, appID, func(app RawApp) {
+		app.object("credentials", "signing")["kid"] = kid
+	})
+}
@@ -0,0 +1,14 @@
+package sdk
+
+import "github.com/okta/okta-sdk-golang/v2/okta"
+
+// SwaApplication is okta.SwaApplication with the scheme-based credentials, since okta-sdk-golang SWA app model
+// does not have credentials scheme, reveal password flag and shared username and password.
+type SwaApplication struct {
+	okta.SwaApplication
+	Credentials *okta.SchemeApplicationCredentials `json:"credentials,omitempty"`
+}
+
+func NewSwaApplication() *SwaApplication {
+	return &SwaApplication{SwaApplication: *okta.NewSwaApplication()}
+}
@@ -0,0 +1,30 @@
+package sdk
+
+// SwaAppSettingsExtra holds SWA app settings missing from okta.SwaApplicationSettingsApplication
+type SwaAppSettingsExtra struct {
+	Checkbox    string `json:"checkbox,omitempty"`
+	RedirectURL string `json:"redirectUrl,omitempty"`
+}
+
+// SwaSettingsExtra returns SWA app's checkbox and redirect URL settings
+func (a RawApp) SwaSettingsExtra() *SwaAppSettingsExtra {
+	extra := &SwaAppSettingsExtra{}
+	a.decode(extra, "settings", "app")
+	return extra
+}
+
+// WithSwaAppSettingsExtra sets SWA app's checkbox and redirect URL settings
+func WithSwaAppSettingsExtra(extra *SwaAppSettingsExtra) AppFields {
+	return func(app RawApp) {
+		appSettings := app.object("settings", "app")
+		setOrDelete := func(key, value string) {
+			if value == "" {
+				delete(appSettings, key)
+			} else {
+				appSettings[key] = value
+			}
+		}
+		setOrDelete("checkbox", extra.Checkbox)
+		setOrDelete("redirectUrl", extra.RedirectURL)
+	}
+}
@@ -0,0 +1,81 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+type Authenticator struct {
+	ID       string                 `json:"id,omitempty"`
+	Key      string                 `json:"key"`
+	Name     string                 `json:"name"`
+	Status   string                 `json:"status,omitempty"`
+	Type     string                 `json:"type,omitempty"`
+	Settings map[string]interface{} `json:"settings,omitempty"`
+}
+
+// ListAuthenticators lists all the authenticators of the org
+func (m *ApiSupplement) ListAuthenticators(ctx context.Context) ([]*Authenticator, *okta.Response, error) {
+	req, err := m.RequestExecutor.NewRequest(http.MethodGet, "/api/v1/authenticators", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var authenticators []*Authenticator
+	resp, err := m.RequestExecutor.Do(ctx, req, &authenticators)
+	if err != nil {
+		return nil, resp, err
+	}
+	return authenticators, resp, nil
+}
+
+// GetAuthenticator gets authenticator by ID
+func (m *ApiSupplement) GetAuthenticator(ctx context.Context, id string) (*Authenticator, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/authenticators/%s", id)
+	req, err := m.RequestExecutor.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var authenticator Authenticator
+	resp, err := m.RequestExecutor.Do(ctx, req, &authenticator)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &authenticator, resp, nil
+}
+
+// UpdateAuthenticator updates the name and the settings of the authenticator
+func (m *ApiSupplement) UpdateAuthenticator(ctx context.Context, id string, body Authenticator) (*Authenticator, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/authenticators/%s", id)
+	req, err := m.RequestExecutor.NewRequest(http.MethodPut, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var authenticator Authenticator
+	resp, err := m.RequestExecutor.Do(ctx, req, &authenticator)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &authenticator, resp, nil
+}
+
+// ActivateAuthenticator activates the authenticator
+func (m *ApiSupplement) ActivateAuthenticator(ctx context.Context, id string) (*okta.Response, error) {
+	return m.changeAuthenticatorLifecycle(ctx, id, "activate")
+}
+
+// DeactivateAuthenticator deactivates the authenticator
+func (m *ApiSupplement) DeactivateAuthenticator(ctx context.Context, id string) (*okta.Response, error) {
+	return m.changeAuthenticatorLifecycle(ctx, id, "deactivate")
+}
+
+func (m *ApiSupplement) changeAuthenticatorLifecycle(ctx context.Context, id, action string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/authenticators/%s/lifecycle/%s", id, action)
+	req, err := m.RequestExecutor.NewRequest(http.MethodPost, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
@@ -0,0 +1,25 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+// GetOrgAuthorizationServerScopes lists the scopes supported by the org authorization server (e.g. okta.users.read)
+// from its discovery document
+func (m *ApiSupplement) GetOrgAuthorizationServerScopes(ctx context.Context) ([]string, *okta.Response, error) {
+	req, err := m.RequestExecutor.NewRequest(http.MethodGet, "/.well-known/oauth-authorization-server", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var metadata struct {
+		ScopesSupported []string `json:"scopes_supported"`
+	}
+	resp, err := m.RequestExecutor.Do(ctx, req, &metadata)
+	if err != nil {
+		return nil, resp, err
+	}
+	return metadata.ScopesSupported, resp, nil
+}
@@ -46,6 +46,21 @@ type (
 	}
 )
 
+func (m *ApiSupplement) ListAuthorizationServerPolicyRules(ctx context.Context, authServerID, policyID string) ([]*AuthorizationServerPolicyRule, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/authorizationServers/%s/policies/%s/rules", authServerID, policyID)
+	req, err := m.RequestExecutor.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rules []*AuthorizationServerPolicyRule
+	resp, err := m.RequestExecutor.Do(ctx, req, &rules)
+	if err != nil {
+		return nil, resp, err
+	}
+	return rules, resp, nil
+}
+
 func (m *ApiSupplement) DeleteAuthorizationServerPolicyRule(ctx context.Context, authServerID, policyID, id string) (*okta.Response, error) {
 	url := fmt.Sprintf("/api/v1/authorizationServers/%s/policies/%s/rules/%s", authServerID, policyID, id)
 	req, err := m.RequestExecutor.NewRequest(http.MethodDelete, url, nil)
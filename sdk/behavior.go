@@ -10,9 +10,9 @@ import (
 )
 
 type Behavior struct {
-	ID       string                 `json:"id"`
+	ID       string                 `json:"id,omitempty"`
 	Name     string                 `json:"name"`
-	Status   string                 `json:"status"`
+	Status   string                 `json:"status,omitempty"`
 	Settings map[string]interface{} `json:"settings"`
 	Type     string                 `json:"type"`
 }
@@ -49,3 +49,63 @@ func (m *ApiSupplement) GetBehavior(ctx context.Context, id string) (*Behavior,
 	}
 	return &behavior, resp, nil
 }
+
+// CreateBehavior creates a behavior detection rule
+func (m *ApiSupplement) CreateBehavior(ctx context.Context, body Behavior) (*Behavior, *okta.Response, error) {
+	url := "/api/v1/behaviors"
+	req, err := m.RequestExecutor.NewRequest(http.MethodPost, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var behavior Behavior
+	resp, err := m.RequestExecutor.Do(ctx, req, &behavior)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &behavior, resp, nil
+}
+
+// UpdateBehavior updates a behavior detection rule
+func (m *ApiSupplement) UpdateBehavior(ctx context.Context, id string, body Behavior) (*Behavior, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/behaviors/%s", id)
+	req, err := m.RequestExecutor.NewRequest(http.MethodPut, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var behavior Behavior
+	resp, err := m.RequestExecutor.Do(ctx, req, &behavior)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &behavior, resp, nil
+}
+
+// DeleteBehavior deletes a behavior detection rule
+func (m *ApiSupplement) DeleteBehavior(ctx context.Context, id string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/behaviors/%s", id)
+	req, err := m.RequestExecutor.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
+
+// ActivateBehavior activates a behavior detection rule
+func (m *ApiSupplement) ActivateBehavior(ctx context.Context, id string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/behaviors/%s/lifecycle/activate", id)
+	req, err := m.RequestExecutor.NewRequest(http.MethodPost, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
+
+// DeactivateBehavior deactivates a behavior detection rule
+func (m *ApiSupplement) DeactivateBehavior(ctx context.Context, id string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/behaviors/%s/lifecycle/deactivate", id)
+	req, err := m.RequestExecutor.NewRequest(http.MethodPost, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
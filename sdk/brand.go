@@ -0,0 +1,64 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+type Brand struct {
+	ID                         string      `json:"id,omitempty"`
+	AgreeToCustomPrivacyPolicy bool        `json:"agreeToCustomPrivacyPolicy,omitempty"`
+	CustomPrivacyPolicyURL     string      `json:"customPrivacyPolicyUrl,omitempty"`
+	RemovePoweredByOkta        bool        `json:"removePoweredByOkta"`
+	IsDefault                  bool        `json:"isDefault,omitempty"`
+	Links                      interface{} `json:"_links,omitempty"`
+}
+
+// ListBrands lists all the brands of the org
+func (m *ApiSupplement) ListBrands(ctx context.Context) ([]*Brand, *okta.Response, error) {
+	req, err := m.RequestExecutor.NewRequest(http.MethodGet, "/api/v1/brands", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var brands []*Brand
+	resp, err := m.RequestExecutor.Do(ctx, req, &brands)
+	if err != nil {
+		return nil, resp, err
+	}
+	return brands, resp, nil
+}
+
+// GetDefaultBrand gets the default brand of the org, which is the only brand of the single-brand orgs
+func (m *ApiSupplement) GetDefaultBrand(ctx context.Context) (*Brand, *okta.Response, error) {
+	brands, resp, err := m.ListBrands(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+	if len(brands) == 0 {
+		return nil, resp, fmt.Errorf("org has no brands")
+	}
+	for _, brand := range brands {
+		if brand.IsDefault {
+			return brand, resp, nil
+		}
+	}
+	return brands[0], resp, nil
+}
+
+// UpdateBrand updates the brand
+func (m *ApiSupplement) UpdateBrand(ctx context.Context, brandID string, body Brand) (*Brand, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/brands/%s", brandID)
+	req, err := m.RequestExecutor.NewRequest(http.MethodPut, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var brand Brand
+	resp, err := m.RequestExecutor.Do(ctx, req, &brand)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &brand, resp, nil
+}
@@ -0,0 +1,37 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+// CatalogApp is an integration of the Okta Integration Network (OIN) catalog
+type CatalogApp struct {
+	Name        string   `json:"name"`
+	DisplayName string   `json:"displayName"`
+	Category    string   `json:"category"`
+	Description string   `json:"description"`
+	SignOnModes []string `json:"signOnModes"`
+	Features    []string `json:"features"`
+}
+
+// ListCatalogApps searches the OIN catalog, the 'q' query parameter matches the beginning of the names of the apps
+func (m *ApiSupplement) ListCatalogApps(ctx context.Context, qp *query.Params) ([]*CatalogApp, *okta.Response, error) {
+	url := "/api/v1/catalog/apps"
+	if qp != nil {
+		url += qp.String()
+	}
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var apps []*CatalogApp
+	resp, err := m.RequestExecutor.Do(ctx, req, &apps)
+	if err != nil {
+		return nil, resp, err
+	}
+	return apps, resp, nil
+}
@@ -0,0 +1,61 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+// ClientRoleAssignment is an admin role assigned to an OAuth service app. Role and ResourceSet are only
+// used by the custom roles.
+type ClientRoleAssignment struct {
+	ID          string      `json:"id,omitempty"`
+	Type        string      `json:"type,omitempty"`
+	Role        string      `json:"role,omitempty"`
+	ResourceSet string      `json:"resource-set,omitempty"`
+	Label       string      `json:"label,omitempty"`
+	Status      string      `json:"status,omitempty"`
+	Links       interface{} `json:"_links,omitempty"`
+}
+
+// AssignClientRole assigns an admin role to the OAuth service app
+func (m *ApiSupplement) AssignClientRole(ctx context.Context, clientID string, body ClientRoleAssignment) (*ClientRoleAssignment, *okta.Response, error) {
+	url := fmt.Sprintf("/oauth2/v1/clients/%s/roles", clientID)
+	req, err := m.RequestExecutor.NewRequest(http.MethodPost, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var role ClientRoleAssignment
+	resp, err := m.RequestExecutor.Do(ctx, req, &role)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &role, resp, nil
+}
+
+// GetClientRole gets the admin role assigned to the OAuth service app
+func (m *ApiSupplement) GetClientRole(ctx context.Context, clientID, roleAssignmentID string) (*ClientRoleAssignment, *okta.Response, error) {
+	url := fmt.Sprintf("/oauth2/v1/clients/%s/roles/%s", clientID, roleAssignmentID)
+	req, err := m.RequestExecutor.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var role ClientRoleAssignment
+	resp, err := m.RequestExecutor.Do(ctx, req, &role)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &role, resp, nil
+}
+
+// UnassignClientRole removes the admin role from the OAuth service app
+func (m *ApiSupplement) UnassignClientRole(ctx context.Context, clientID, roleAssignmentID string) (*okta.Response, error) {
+	url := fmt.Sprintf("/oauth2/v1/clients/%s/roles/%s", clientID, roleAssignmentID)
+	req, err := m.RequestExecutor.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
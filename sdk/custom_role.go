@@ -0,0 +1,73 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+// CustomRole is an IAM-based admin role, which grants its set of permissions on the resources it is assigned for
+type CustomRole struct {
+	ID          string                 `json:"id,omitempty"`
+	Label       string                 `json:"label,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	Permissions []string               `json:"permissions,omitempty"`
+	Links       map[string]interface{} `json:"_links,omitempty"`
+}
+
+// CreateCustomRole creates a custom role with the initial set of permissions
+func (m *ApiSupplement) CreateCustomRole(ctx context.Context, body CustomRole) (*CustomRole, *okta.Response, error) {
+	req, err := m.RequestExecutor.NewRequest(http.MethodPost, "/api/v1/iam/roles", body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var role CustomRole
+	resp, err := m.RequestExecutor.Do(ctx, req, &role)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &role, resp, nil
+}
+
+// GetCustomRole gets a custom role, its permissions are listed separately
+func (m *ApiSupplement) GetCustomRole(ctx context.Context, roleIDOrLabel string) (*CustomRole, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/iam/roles/%s", roleIDOrLabel)
+	req, err := m.RequestExecutor.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var role CustomRole
+	resp, err := m.RequestExecutor.Do(ctx, req, &role)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &role, resp, nil
+}
+
+// UpdateCustomRole updates the label and the description of a custom role
+func (m *ApiSupplement) UpdateCustomRole(ctx context.Context, roleIDOrLabel string, body CustomRole) (*CustomRole, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/iam/roles/%s", roleIDOrLabel)
+	body.Permissions = nil
+	req, err := m.RequestExecutor.NewRequest(http.MethodPut, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var role CustomRole
+	resp, err := m.RequestExecutor.Do(ctx, req, &role)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &role, resp, nil
+}
+
+// DeleteCustomRole deletes a custom role
+func (m *ApiSupplement) DeleteCustomRole(ctx context.Context, roleIDOrLabel string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/iam/roles/%s", roleIDOrLabel)
+	req, err := m.RequestExecutor.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
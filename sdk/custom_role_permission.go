@@ -0,0 +1,98 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+// PermissionConditions limits the scope of USER-scoped permissions of the custom role,
+// e.g. {"include": {"okta:ResourceAttribute/User/Profile": ["city", "zipCode"]}}
+type PermissionConditions struct {
+	Include map[string][]string `json:"include,omitempty"`
+	Exclude map[string][]string `json:"exclude,omitempty"`
+}
+
+type CustomRolePermission struct {
+	Label      string                 `json:"label,omitempty"`
+	Conditions *PermissionConditions  `json:"conditions,omitempty"`
+	Links      map[string]interface{} `json:"_links,omitempty"`
+}
+
+type customRolePermissions struct {
+	Permissions []*CustomRolePermission `json:"permissions"`
+	Links       struct {
+		Next *struct {
+			Href string `json:"href"`
+		} `json:"next,omitempty"`
+	} `json:"_links"`
+}
+
+// ListCustomRolePermissions lists the permissions of the custom role along with their conditions, following the
+// pagination links of the response
+func (m *ApiSupplement) ListCustomRolePermissions(ctx context.Context, roleIDOrLabel string) ([]*CustomRolePermission, *okta.Response, error) {
+	next := fmt.Sprintf("/api/v1/iam/roles/%s/permissions", roleIDOrLabel)
+	var (
+		perms []*CustomRolePermission
+		resp  *okta.Response
+	)
+	for next != "" {
+		req, err := m.RequestExecutor.NewRequest(http.MethodGet, next, nil)
+		if err != nil {
+			return nil, resp, err
+		}
+		var page customRolePermissions
+		resp, err = m.RequestExecutor.Do(ctx, req, &page)
+		if err != nil {
+			return nil, resp, err
+		}
+		perms = append(perms, page.Permissions...)
+		next = ""
+		if page.Links.Next != nil && page.Links.Next.Href != "" {
+			// the request executor prepends the org URL, so only the path of the link is used
+			u, err := url.Parse(page.Links.Next.Href)
+			if err != nil {
+				return nil, resp, err
+			}
+			next = u.RequestURI()
+		}
+	}
+	return perms, resp, nil
+}
+
+// AddCustomRolePermission adds the permission to the custom role
+func (m *ApiSupplement) AddCustomRolePermission(ctx context.Context, roleIDOrLabel, permission string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/iam/roles/%s/permissions/%s", roleIDOrLabel, permission)
+	req, err := m.RequestExecutor.NewRequest(http.MethodPost, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
+
+// DeleteCustomRolePermission removes the permission from the custom role
+func (m *ApiSupplement) DeleteCustomRolePermission(ctx context.Context, roleIDOrLabel, permission string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/iam/roles/%s/permissions/%s", roleIDOrLabel, permission)
+	req, err := m.RequestExecutor.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
+
+// UpdateCustomRolePermissionConditions replaces the conditions of the custom role's permission.
+// Passing nil conditions removes them from the permission.
+func (m *ApiSupplement) UpdateCustomRolePermissionConditions(ctx context.Context, roleIDOrLabel, permission string, conditions *PermissionConditions) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/iam/roles/%s/permissions/%s", roleIDOrLabel, permission)
+	body := struct {
+		Conditions *PermissionConditions `json:"conditions"`
+	}{Conditions: conditions}
+	req, err := m.RequestExecutor.NewRequest(http.MethodPut, url, body)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
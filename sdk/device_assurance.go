@@ -0,0 +1,108 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+type (
+	// DeviceAssurance is the device assurance policy, the set of requirements to the posture of the devices
+	DeviceAssurance struct {
+		ID                    string                 `json:"id,omitempty"`
+		Name                  string                 `json:"name,omitempty"`
+		Platform              string                 `json:"platform,omitempty"`
+		OsVersion             *DeviceAssuranceOs     `json:"osVersion,omitempty"`
+		DiskEncryptionType    *DeviceAssuranceTypes  `json:"diskEncryptionType,omitempty"`
+		Jailbreak             *bool                  `json:"jailbreak,omitempty"`
+		ScreenLockType        *DeviceAssuranceTypes  `json:"screenLockType,omitempty"`
+		SecureHardwarePresent *bool                  `json:"secureHardwarePresent,omitempty"`
+		CreatedBy             string                 `json:"createdBy,omitempty"`
+		CreatedDate           *time.Time             `json:"createdDate,omitempty"`
+		LastUpdatedBy         string                 `json:"lastUpdatedBy,omitempty"`
+		LastUpdate            *time.Time             `json:"lastUpdate,omitempty"`
+		Links                 map[string]interface{} `json:"_links,omitempty"`
+	}
+
+	DeviceAssuranceOs struct {
+		Minimum string `json:"minimum,omitempty"`
+	}
+
+	DeviceAssuranceTypes struct {
+		Include []string `json:"include,omitempty"`
+	}
+)
+
+// ListDeviceAssurances lists all the device assurance policies of the org
+func (m *ApiSupplement) ListDeviceAssurances(ctx context.Context) ([]*DeviceAssurance, *okta.Response, error) {
+	url := "/api/v1/device-assurances"
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var deviceAssurances []*DeviceAssurance
+	resp, err := m.RequestExecutor.Do(ctx, req, &deviceAssurances)
+	if err != nil {
+		return nil, resp, err
+	}
+	return deviceAssurances, resp, nil
+}
+
+// CreateDeviceAssurance creates a device assurance policy
+func (m *ApiSupplement) CreateDeviceAssurance(ctx context.Context, body DeviceAssurance) (*DeviceAssurance, *okta.Response, error) {
+	url := "/api/v1/device-assurances"
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPost, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var deviceAssurance DeviceAssurance
+	resp, err := m.RequestExecutor.Do(ctx, req, &deviceAssurance)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &deviceAssurance, resp, nil
+}
+
+// GetDeviceAssurance gets a device assurance policy by ID
+func (m *ApiSupplement) GetDeviceAssurance(ctx context.Context, id string) (*DeviceAssurance, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/device-assurances/%s", id)
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var deviceAssurance DeviceAssurance
+	resp, err := m.RequestExecutor.Do(ctx, req, &deviceAssurance)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &deviceAssurance, resp, nil
+}
+
+// UpdateDeviceAssurance updates a device assurance policy
+func (m *ApiSupplement) UpdateDeviceAssurance(ctx context.Context, id string, body DeviceAssurance) (*DeviceAssurance, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/device-assurances/%s", id)
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPut, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var deviceAssurance DeviceAssurance
+	resp, err := m.RequestExecutor.Do(ctx, req, &deviceAssurance)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &deviceAssurance, resp, nil
+}
+
+// DeleteDeviceAssurance deletes a device assurance policy, the policy can't be deleted while it's used by the rules
+// of authentication policies
+func (m *ApiSupplement) DeleteDeviceAssurance(ctx context.Context, id string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/device-assurances/%s", id)
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
@@ -0,0 +1,87 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+type (
+	// EmailSender is a custom email sender, which makes Okta send the emails of the org from the company's own domain.
+	// Okta has no public API for the senders, they are managed through the undocumented endpoints of the Admin UI.
+	EmailSender struct {
+		ID          string                  `json:"id,omitempty"`
+		FromName    string                  `json:"fromName,omitempty"`
+		FromAddress string                  `json:"fromAddress,omitempty"`
+		Subdomain   string                  `json:"subdomain,omitempty"`
+		Status      string                  `json:"status,omitempty"`
+		DNSRecords  []*EmailSenderDNSRecord `json:"dnsRecords,omitempty"`
+	}
+
+	// EmailSenderDNSRecord is a DNS record which has to be added to the domain of the sender before it can be verified
+	EmailSenderDNSRecord struct {
+		RecordType string `json:"recordType,omitempty"`
+		Fqdn       string `json:"fqdn,omitempty"`
+		Value      string `json:"value,omitempty"`
+	}
+
+	emailSenderDisableRequest struct {
+		SenderIDs []string `json:"senderIds"`
+	}
+)
+
+// CreateEmailSender creates a custom email sender
+func (m *ApiSupplement) CreateEmailSender(ctx context.Context, body EmailSender) (*EmailSender, *okta.Response, error) {
+	req, err := m.RequestExecutor.NewRequest(http.MethodPost, "/api/internal/email/sender", body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var sender EmailSender
+	resp, err := m.RequestExecutor.Do(ctx, req, &sender)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &sender, resp, nil
+}
+
+// GetEmailSender gets a custom email sender
+func (m *ApiSupplement) GetEmailSender(ctx context.Context, id string) (*EmailSender, *okta.Response, error) {
+	url := fmt.Sprintf("/api/internal/email/sender/%s", id)
+	req, err := m.RequestExecutor.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var sender EmailSender
+	resp, err := m.RequestExecutor.Do(ctx, req, &sender)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &sender, resp, nil
+}
+
+// VerifyEmailSender checks the DNS records of a custom email sender, the sender is used only after it was verified
+func (m *ApiSupplement) VerifyEmailSender(ctx context.Context, id string) (*EmailSender, *okta.Response, error) {
+	url := fmt.Sprintf("/api/internal/email/sender/%s/verify", id)
+	req, err := m.RequestExecutor.NewRequest(http.MethodPost, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var sender EmailSender
+	resp, err := m.RequestExecutor.Do(ctx, req, &sender)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &sender, resp, nil
+}
+
+// DisableEmailSender disables a custom email sender, so the emails are sent from the Okta domain again
+func (m *ApiSupplement) DisableEmailSender(ctx context.Context, id string) (*okta.Response, error) {
+	body := emailSenderDisableRequest{SenderIDs: []string{id}}
+	req, err := m.RequestExecutor.NewRequest(http.MethodPost, "/api/internal/email/sender/disable", body)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
@@ -0,0 +1,76 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+// EmailSMTPServer is a custom SMTP server the emails of the org are relayed through
+type EmailSMTPServer struct {
+	ID       string `json:"id,omitempty"`
+	Alias    string `json:"alias,omitempty"`
+	Host     string `json:"host,omitempty"`
+	Port     int    `json:"port,omitempty"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	Enabled  *bool  `json:"enabled,omitempty"`
+	// Encryption is how the connection to the server is secured: STARTTLS or TLS
+	Encryption string `json:"encryption,omitempty"`
+}
+
+// CreateEmailSMTPServer creates a custom SMTP server
+func (m *ApiSupplement) CreateEmailSMTPServer(ctx context.Context, body EmailSMTPServer) (*EmailSMTPServer, *okta.Response, error) {
+	req, err := m.RequestExecutor.NewRequest(http.MethodPost, "/api/v1/email-servers", body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var server EmailSMTPServer
+	resp, err := m.RequestExecutor.Do(ctx, req, &server)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &server, resp, nil
+}
+
+// GetEmailSMTPServer gets a custom SMTP server, the password is never returned
+func (m *ApiSupplement) GetEmailSMTPServer(ctx context.Context, id string) (*EmailSMTPServer, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/email-servers/%s", id)
+	req, err := m.RequestExecutor.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var server EmailSMTPServer
+	resp, err := m.RequestExecutor.Do(ctx, req, &server)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &server, resp, nil
+}
+
+// UpdateEmailSMTPServer updates a custom SMTP server
+func (m *ApiSupplement) UpdateEmailSMTPServer(ctx context.Context, id string, body EmailSMTPServer) (*EmailSMTPServer, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/email-servers/%s", id)
+	req, err := m.RequestExecutor.NewRequest(http.MethodPatch, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var server EmailSMTPServer
+	resp, err := m.RequestExecutor.Do(ctx, req, &server)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &server, resp, nil
+}
+
+// DeleteEmailSMTPServer deletes a custom SMTP server
+func (m *ApiSupplement) DeleteEmailSMTPServer(ctx context.Context, id string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/email-servers/%s", id)
+	req, err := m.RequestExecutor.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
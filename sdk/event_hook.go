@@ -0,0 +1,72 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+// EventHook is okta.EventHook with the events filter, since okta-sdk-golang event hook model does not have it
+// and drops it on update.
+type EventHook struct {
+	okta.EventHook
+	Events *EventSubscriptions `json:"events,omitempty"`
+}
+
+type EventSubscriptions struct {
+	okta.EventSubscriptions
+	Filter *EventHookFilter `json:"filter,omitempty"`
+}
+
+type EventHookFilter struct {
+	Type           string                `json:"type,omitempty"`
+	EventFilterMap []*EventHookFilterMap `json:"eventFilterMap"`
+}
+
+type EventHookFilterMap struct {
+	Event     string                    `json:"event"`
+	Condition *EventHookFilterCondition `json:"condition,omitempty"`
+}
+
+type EventHookFilterCondition struct {
+	Expression string `json:"expression"`
+	Version    string `json:"version,omitempty"`
+}
+
+// CreateEventHook creates event hook along with its filter
+func (m *ApiSupplement) CreateEventHook(ctx context.Context, body EventHook) (*EventHook, *okta.Response, error) {
+	return m.sendEventHook(ctx, http.MethodPost, "/api/v1/eventHooks", &body)
+}
+
+// GetEventHook gets event hook along with its filter
+func (m *ApiSupplement) GetEventHook(ctx context.Context, hookID string) (*EventHook, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/eventHooks/%s", hookID)
+	return m.sendEventHook(ctx, http.MethodGet, url, nil)
+}
+
+// UpdateEventHook updates event hook along with its filter
+func (m *ApiSupplement) UpdateEventHook(ctx context.Context, hookID string, body EventHook) (*EventHook, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/eventHooks/%s", hookID)
+	return m.sendEventHook(ctx, http.MethodPut, url, &body)
+}
+
+func (m *ApiSupplement) sendEventHook(ctx context.Context, method, url string, body *EventHook) (*EventHook, *okta.Response, error) {
+	var req *http.Request
+	var err error
+	if body == nil {
+		req, err = m.RequestExecutor.NewRequest(method, url, nil)
+	} else {
+		req, err = m.RequestExecutor.NewRequest(method, url, body)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	var hook *EventHook
+	resp, err := m.RequestExecutor.Do(ctx, req, &hook)
+	if err != nil {
+		return nil, resp, err
+	}
+	return hook, resp, nil
+}
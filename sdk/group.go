@@ -0,0 +1,61 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+// Group is okta.Group with the profile as a map, since okta-sdk-golang group profile model
+// has only name and description, while groups may have custom profile attributes.
+type Group struct {
+	ID      string                 `json:"id,omitempty"`
+	Type    string                 `json:"type,omitempty"`
+	Profile map[string]interface{} `json:"profile,omitempty"`
+}
+
+// CreateGroup creates a group with the given profile
+func (m *ApiSupplement) CreateGroup(ctx context.Context, group Group) (*Group, *okta.Response, error) {
+	req, err := m.RequestExecutor.NewRequest(http.MethodPost, "/api/v1/groups", group)
+	if err != nil {
+		return nil, nil, err
+	}
+	var respGroup Group
+	resp, err := m.RequestExecutor.Do(ctx, req, &respGroup)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &respGroup, resp, nil
+}
+
+// GetGroup gets a group including its custom profile attributes
+func (m *ApiSupplement) GetGroup(ctx context.Context, groupID string) (*Group, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/groups/%s", groupID)
+	req, err := m.RequestExecutor.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var group Group
+	resp, err := m.RequestExecutor.Do(ctx, req, &group)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &group, resp, nil
+}
+
+// UpdateGroup replaces the profile of a group
+func (m *ApiSupplement) UpdateGroup(ctx context.Context, groupID string, group Group) (*Group, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/groups/%s", groupID)
+	req, err := m.RequestExecutor.NewRequest(http.MethodPut, url, group)
+	if err != nil {
+		return nil, nil, err
+	}
+	var respGroup Group
+	resp, err := m.RequestExecutor.Do(ctx, req, &respGroup)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &respGroup, resp, nil
+}
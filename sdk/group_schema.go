@@ -0,0 +1,22 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+// Group profile schema has the same format as the user profile schema, so the user schema models are used
+const groupSchemaURL = "/api/v1/meta/schemas/group/default"
+
+func (m *ApiSupplement) GetGroupSchema(ctx context.Context) (*UserSchema, *okta.Response, error) {
+	return m.GetUserSchema(ctx, groupSchemaURL)
+}
+
+func (m *ApiSupplement) UpdateCustomGroupSchemaProperty(ctx context.Context, id string, schema *UserSubSchema) (*UserSchema, *okta.Response, error) {
+	return m.UpdateCustomUserSchemaProperty(ctx, groupSchemaURL, id, schema)
+}
+
+func (m *ApiSupplement) DeleteGroupSchemaProperty(ctx context.Context, id string) (*okta.Response, error) {
+	return m.DeleteUserSchemaProperty(ctx, groupSchemaURL, id)
+}
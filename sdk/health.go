@@ -0,0 +1,17 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+// Ping makes the cheapest authenticated call available to check that the Okta API is reachable and responsive
+func (m *ApiSupplement) Ping(ctx context.Context) (*okta.Response, error) {
+	req, err := m.RequestExecutor.NewRequest(http.MethodGet, "/api/v1/users?limit=1", nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
@@ -0,0 +1,78 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+// IdentityProvider is okta.IdentityProvider with the protocol credentials that okta-sdk-golang does not model,
+// e.g. the private key and team ID of the Apple identity provider.
+type IdentityProvider struct {
+	okta.IdentityProvider
+	Protocol *Protocol `json:"protocol,omitempty"`
+}
+
+type Protocol struct {
+	okta.Protocol
+	Credentials *IdentityProviderCredentials `json:"credentials,omitempty"`
+}
+
+type IdentityProviderCredentials struct {
+	Client  *okta.IdentityProviderCredentialsClient `json:"client,omitempty"`
+	Signing *IdentityProviderCredentialsSigning     `json:"signing,omitempty"`
+	Trust   *okta.IdentityProviderCredentialsTrust  `json:"trust,omitempty"`
+}
+
+type IdentityProviderCredentialsSigning struct {
+	Kid        string `json:"kid,omitempty"`
+	PrivateKey string `json:"privateKey,omitempty"`
+	TeamId     string `json:"teamId,omitempty"`
+}
+
+// GetIdentityProvider gets identity provider by ID
+func (m *ApiSupplement) GetIdentityProvider(ctx context.Context, id string) (*IdentityProvider, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/idps/%s", id)
+	req, err := m.RequestExecutor.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var idp IdentityProvider
+	resp, err := m.RequestExecutor.Do(ctx, req, &idp)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &idp, resp, nil
+}
+
+// CreateIdentityProvider creates identity provider
+func (m *ApiSupplement) CreateIdentityProvider(ctx context.Context, body IdentityProvider) (*IdentityProvider, *okta.Response, error) {
+	url := "/api/v1/idps"
+	req, err := m.RequestExecutor.NewRequest(http.MethodPost, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var idp IdentityProvider
+	resp, err := m.RequestExecutor.Do(ctx, req, &idp)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &idp, resp, nil
+}
+
+// UpdateIdentityProvider updates identity provider
+func (m *ApiSupplement) UpdateIdentityProvider(ctx context.Context, id string, body IdentityProvider) (*IdentityProvider, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/idps/%s", id)
+	req, err := m.RequestExecutor.NewRequest(http.MethodPut, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var idp IdentityProvider
+	resp, err := m.RequestExecutor.Do(ctx, req, &idp)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &idp, resp, nil
+}
@@ -21,12 +21,14 @@ type (
 	}
 
 	NetworkZone struct {
+		Asns      []string      `json:"asns,omitempty"`
 		Gateways  []*AddressObj `json:"gateways,omitempty"`
 		ID        string        `json:"id,omitempty"`
 		Locations []*Location   `json:"locations,omitempty"`
 		Name      string        `json:"name,omitempty"`
 		Proxies   []*AddressObj `json:"proxies,omitempty"`
 		ProxyType string        `json:"proxyType,omitempty"`
+		Status    string        `json:"status,omitempty"`
 		System    bool          `json:"system,omitempty"`
 		Type      string        `json:"type,omitempty"`
 		Usage     string        `json:"usage,omitempty"`
@@ -74,6 +76,14 @@ func (m *ApiSupplement) ListNetworkZones(ctx context.Context) ([]*NetworkZone, *
 	if err != nil {
 		return nil, resp, err
 	}
+	for resp.HasNextPage() {
+		var nextZones []*NetworkZone
+		resp, err = resp.Next(ctx, &nextZones)
+		if err != nil {
+			return nil, resp, err
+		}
+		zones = append(zones, nextZones...)
+	}
 	return zones, resp, nil
 }
 
@@ -104,3 +114,23 @@ func (m *ApiSupplement) UpdateNetworkZone(ctx context.Context, id string, body N
 	}
 	return &zone, resp, nil
 }
+
+func (m *ApiSupplement) ActivateNetworkZone(ctx context.Context, id string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/zones/%s/lifecycle/activate", id)
+	req, err := m.RequestExecutor.NewRequest(http.MethodPost, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
+
+func (m *ApiSupplement) DeactivateNetworkZone(ctx context.Context, id string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/zones/%s/lifecycle/deactivate", id)
+	req, err := m.RequestExecutor.NewRequest(http.MethodPost, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
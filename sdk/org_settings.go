@@ -0,0 +1,118 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+type (
+	OrgPreferences struct {
+		ShowEndUserFooter bool `json:"showEndUserFooter"`
+	}
+
+	OktaCommunicationSettings struct {
+		OptOutEmailUsers bool `json:"optOutEmailUsers"`
+	}
+
+	OktaSupportSettings struct {
+		Support    string     `json:"support,omitempty"`
+		Expiration *time.Time `json:"expiration,omitempty"`
+	}
+)
+
+// GetOrgID gets ID of the org
+func (m *ApiSupplement) GetOrgID(ctx context.Context) (string, *okta.Response, error) {
+	req, err := m.RequestExecutor.NewRequest(http.MethodGet, "/api/v1/org", nil)
+	if err != nil {
+		return "", nil, err
+	}
+	var org struct {
+		ID string `json:"id"`
+	}
+	resp, err := m.RequestExecutor.Do(ctx, req, &org)
+	if err != nil {
+		return "", resp, err
+	}
+	return org.ID, resp, nil
+}
+
+// GetOrgPreferences gets preferences of the org
+func (m *ApiSupplement) GetOrgPreferences(ctx context.Context) (*OrgPreferences, *okta.Response, error) {
+	req, err := m.RequestExecutor.NewRequest(http.MethodGet, "/api/v1/org/preferences", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var preferences OrgPreferences
+	resp, err := m.RequestExecutor.Do(ctx, req, &preferences)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &preferences, resp, nil
+}
+
+// SetOrgEndUserFooter shows or hides the footer on the end-user dashboard
+func (m *ApiSupplement) SetOrgEndUserFooter(ctx context.Context, show bool) (*okta.Response, error) {
+	action := "hideEndUserFooter"
+	if show {
+		action = "showEndUserFooter"
+	}
+	return m.postOrgAction(ctx, fmt.Sprintf("/api/v1/org/preferences/%s", action))
+}
+
+// GetOktaCommunicationSettings gets the settings of Okta communication emails sent to the users of the org
+func (m *ApiSupplement) GetOktaCommunicationSettings(ctx context.Context) (*OktaCommunicationSettings, *okta.Response, error) {
+	req, err := m.RequestExecutor.NewRequest(http.MethodGet, "/api/v1/org/privacy/oktaCommunication", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var settings OktaCommunicationSettings
+	resp, err := m.RequestExecutor.Do(ctx, req, &settings)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &settings, resp, nil
+}
+
+// SetOktaCommunicationOptOut opts the users of the org in or out of Okta communication emails
+func (m *ApiSupplement) SetOktaCommunicationOptOut(ctx context.Context, optOut bool) (*okta.Response, error) {
+	action := "optIn"
+	if optOut {
+		action = "optOut"
+	}
+	return m.postOrgAction(ctx, fmt.Sprintf("/api/v1/org/privacy/oktaCommunication/%s", action))
+}
+
+// GetOktaSupportSettings gets the settings of Okta Support access to the org
+func (m *ApiSupplement) GetOktaSupportSettings(ctx context.Context) (*OktaSupportSettings, *okta.Response, error) {
+	req, err := m.RequestExecutor.NewRequest(http.MethodGet, "/api/v1/org/privacy/oktaSupport", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var settings OktaSupportSettings
+	resp, err := m.RequestExecutor.Do(ctx, req, &settings)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &settings, resp, nil
+}
+
+// SetOktaSupportAccess grants or revokes Okta Support access to the org
+func (m *ApiSupplement) SetOktaSupportAccess(ctx context.Context, grant bool) (*okta.Response, error) {
+	action := "revoke"
+	if grant {
+		action = "grant"
+	}
+	return m.postOrgAction(ctx, fmt.Sprintf("/api/v1/org/privacy/oktaSupport/%s", action))
+}
+
+func (m *ApiSupplement) postOrgAction(ctx context.Context, url string) (*okta.Response, error) {
+	req, err := m.RequestExecutor.NewRequest(http.MethodPost, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
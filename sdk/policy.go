@@ -16,6 +16,8 @@ const (
 	MfaPolicyType                = "MFA_ENROLL"
 	IdpDiscoveryType             = "IDP_DISCOVERY"
 	OauthAuthorizationPolicyType = "OAUTH_AUTHORIZATION_POLICY"
+	AccessPolicyType             = "ACCESS_POLICY"
+	ProfileEnrollmentPolicyType  = "PROFILE_ENROLLMENT"
 )
 
 // Return the PasswordPolicy object. Used to create & update the password policy
@@ -34,6 +36,16 @@ func MfaPolicy() Policy {
 	return Policy{Type: MfaPolicyType}
 }
 
+// Return the AccessPolicy object. Used to create & update the app sign-on (access) policy
+func AccessPolicy() Policy {
+	return Policy{Type: AccessPolicyType}
+}
+
+// Return the ProfileEnrollmentPolicy object. Used to create & update the profile enrollment policy
+func ProfileEnrollmentPolicy() Policy {
+	return Policy{Type: ProfileEnrollmentPolicyType}
+}
+
 type Policy struct {
 	Embedded    interface{}                `json:"_embedded,omitempty"`
 	Links       interface{}                `json:"_links,omitempty"`
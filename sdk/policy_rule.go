@@ -143,3 +143,29 @@ func (m *ApiSupplement) UpdatePolicyRule(ctx context.Context, policyID, ruleId s
 
 	return &policyRule, resp, nil
 }
+
+// SetPolicyRulePriority changes the priority of a policy rule. The rule is updated as it was received from the API,
+// so that the attributes missing from PolicyRule of the specific rule types are not lost.
+func (m *ApiSupplement) SetPolicyRulePriority(ctx context.Context, policyID, ruleId string, priority int64) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/policies/%v/rules/%v", policyID, ruleId)
+
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var policyRule map[string]interface{}
+
+	resp, err := m.RequestExecutor.Do(ctx, req, &policyRule)
+	if err != nil {
+		return resp, err
+	}
+
+	policyRule["priority"] = priority
+	req, err = m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPut, url, policyRule)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
@@ -0,0 +1,81 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+// ProfileEnrollmentPolicyRule is the rule of the profile enrollment policy. Each of these policies has a single rule,
+// which is created along with the policy, and its actions differ from the ones of the other policy rules.
+type ProfileEnrollmentPolicyRule struct {
+	Id          string                             `json:"id,omitempty"`
+	Type        string                             `json:"type,omitempty"`
+	Name        string                             `json:"name,omitempty"`
+	Status      string                             `json:"status,omitempty"`
+	Priority    int64                              `json:"priority,omitempty"`
+	System      *bool                              `json:"system,omitempty"`
+	Created     *time.Time                         `json:"created,omitempty"`
+	LastUpdated *time.Time                         `json:"lastUpdated,omitempty"`
+	Actions     ProfileEnrollmentPolicyRuleActions `json:"actions,omitempty"`
+}
+
+type ProfileEnrollmentPolicyRuleActions struct {
+	ProfileEnrollment *ProfileEnrollmentPolicyRuleAction `json:"profileEnrollment,omitempty"`
+}
+
+type ProfileEnrollmentPolicyRuleAction struct {
+	Access                     string                                         `json:"access,omitempty"`
+	ActivationRequirements     *ProfileEnrollmentPolicyRuleActivation         `json:"activationRequirements,omitempty"`
+	PreRegistrationInlineHooks []*PreRegistrationInlineHook                   `json:"preRegistrationInlineHooks,omitempty"`
+	ProfileAttributes          []*ProfileEnrollmentPolicyRuleProfileAttribute `json:"profileAttributes,omitempty"`
+	TargetGroupIds             []string                                       `json:"targetGroupIds,omitempty"`
+	UnknownUserAction          string                                         `json:"unknownUserAction,omitempty"`
+}
+
+type ProfileEnrollmentPolicyRuleActivation struct {
+	EmailVerification *bool `json:"emailVerification,omitempty"`
+}
+
+type PreRegistrationInlineHook struct {
+	InlineHookId string `json:"inlineHookId,omitempty"`
+}
+
+type ProfileEnrollmentPolicyRuleProfileAttribute struct {
+	Label    string `json:"label"`
+	Name     string `json:"name"`
+	Required bool   `json:"required"`
+}
+
+// GetProfileEnrollmentPolicyRule gets the rule of the profile enrollment policy.
+func (m *ApiSupplement) GetProfileEnrollmentPolicyRule(ctx context.Context, policyID, ruleID string) (*ProfileEnrollmentPolicyRule, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/policies/%v/rules/%v", policyID, ruleID)
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var rule ProfileEnrollmentPolicyRule
+	resp, err := m.RequestExecutor.Do(ctx, req, &rule)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &rule, resp, nil
+}
+
+// UpdateProfileEnrollmentPolicyRule updates the rule of the profile enrollment policy.
+func (m *ApiSupplement) UpdateProfileEnrollmentPolicyRule(ctx context.Context, policyID, ruleID string, body ProfileEnrollmentPolicyRule) (*ProfileEnrollmentPolicyRule, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/policies/%v/rules/%v", policyID, ruleID)
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPut, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var rule ProfileEnrollmentPolicyRule
+	resp, err := m.RequestExecutor.Do(ctx, req, &rule)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &rule, resp, nil
+}
@@ -0,0 +1,95 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+type (
+	// PerClientRateLimitSettings are the modes of the rate limits applied per client (IP address)
+	PerClientRateLimitSettings struct {
+		DefaultMode          string            `json:"defaultMode"`
+		UseCaseModeOverrides map[string]string `json:"useCaseModeOverrides,omitempty"`
+	}
+
+	// RateLimitAdminNotifications are the settings of the notifications sent to the admins on hitting the rate limits
+	RateLimitAdminNotifications struct {
+		NotificationsEnabled bool `json:"notificationsEnabled"`
+	}
+
+	// RateLimitWarningThreshold is the percentage of the rate limit the warning notifications are sent at
+	RateLimitWarningThreshold struct {
+		WarningThreshold int `json:"warningThreshold"`
+	}
+)
+
+// GetPerClientRateLimitSettings gets the per-client rate limit settings of the org
+func (m *ApiSupplement) GetPerClientRateLimitSettings(ctx context.Context) (*PerClientRateLimitSettings, *okta.Response, error) {
+	var settings PerClientRateLimitSettings
+	resp, err := m.doRateLimitSettingsRequest(ctx, http.MethodGet, "per-client", nil, &settings)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &settings, resp, nil
+}
+
+// UpdatePerClientRateLimitSettings updates the per-client rate limit settings of the org
+func (m *ApiSupplement) UpdatePerClientRateLimitSettings(ctx context.Context, body PerClientRateLimitSettings) (*PerClientRateLimitSettings, *okta.Response, error) {
+	var settings PerClientRateLimitSettings
+	resp, err := m.doRateLimitSettingsRequest(ctx, http.MethodPut, "per-client", body, &settings)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &settings, resp, nil
+}
+
+// GetRateLimitAdminNotifications gets the rate limit notification settings of the org
+func (m *ApiSupplement) GetRateLimitAdminNotifications(ctx context.Context) (*RateLimitAdminNotifications, *okta.Response, error) {
+	var settings RateLimitAdminNotifications
+	resp, err := m.doRateLimitSettingsRequest(ctx, http.MethodGet, "admin-notifications", nil, &settings)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &settings, resp, nil
+}
+
+// UpdateRateLimitAdminNotifications enables or disables the rate limit notifications sent to the admins
+func (m *ApiSupplement) UpdateRateLimitAdminNotifications(ctx context.Context, body RateLimitAdminNotifications) (*RateLimitAdminNotifications, *okta.Response, error) {
+	var settings RateLimitAdminNotifications
+	resp, err := m.doRateLimitSettingsRequest(ctx, http.MethodPut, "admin-notifications", body, &settings)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &settings, resp, nil
+}
+
+// GetRateLimitWarningThreshold gets the rate limit warning threshold of the org
+func (m *ApiSupplement) GetRateLimitWarningThreshold(ctx context.Context) (*RateLimitWarningThreshold, *okta.Response, error) {
+	var settings RateLimitWarningThreshold
+	resp, err := m.doRateLimitSettingsRequest(ctx, http.MethodGet, "warning-threshold", nil, &settings)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &settings, resp, nil
+}
+
+// UpdateRateLimitWarningThreshold updates the rate limit warning threshold of the org
+func (m *ApiSupplement) UpdateRateLimitWarningThreshold(ctx context.Context, body RateLimitWarningThreshold) (*RateLimitWarningThreshold, *okta.Response, error) {
+	var settings RateLimitWarningThreshold
+	resp, err := m.doRateLimitSettingsRequest(ctx, http.MethodPut, "warning-threshold", body, &settings)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &settings, resp, nil
+}
+
+func (m *ApiSupplement) doRateLimitSettingsRequest(ctx context.Context, method, setting string, body, v interface{}) (*okta.Response, error) {
+	url := "/api/v1/rate-limit-settings/" + setting
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, v)
+}
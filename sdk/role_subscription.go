@@ -0,0 +1,51 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+// RoleSubscription is the subscription of the admins with the role type to the notification type
+type RoleSubscription struct {
+	NotificationType string                 `json:"notificationType,omitempty"`
+	Channels         []string               `json:"channels,omitempty"`
+	Status           string                 `json:"status,omitempty"`
+	Links            map[string]interface{} `json:"_links,omitempty"`
+}
+
+// GetRoleSubscription gets the subscription of the role type to the notification type
+func (m *ApiSupplement) GetRoleSubscription(ctx context.Context, roleType, notificationType string) (*RoleSubscription, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/roles/%s/subscriptions/%s", roleType, notificationType)
+	req, err := m.RequestExecutor.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var subscription RoleSubscription
+	resp, err := m.RequestExecutor.Do(ctx, req, &subscription)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &subscription, resp, nil
+}
+
+// SubscribeRoleSubscription subscribes the role type to the notification type
+func (m *ApiSupplement) SubscribeRoleSubscription(ctx context.Context, roleType, notificationType string) (*okta.Response, error) {
+	return m.doRoleSubscriptionRequest(ctx, roleType, notificationType, "subscribe")
+}
+
+// UnsubscribeRoleSubscription unsubscribes the role type from the notification type
+func (m *ApiSupplement) UnsubscribeRoleSubscription(ctx context.Context, roleType, notificationType string) (*okta.Response, error) {
+	return m.doRoleSubscriptionRequest(ctx, roleType, notificationType, "unsubscribe")
+}
+
+func (m *ApiSupplement) doRoleSubscriptionRequest(ctx context.Context, roleType, notificationType, action string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/roles/%s/subscriptions/%s/%s", roleType, notificationType, action)
+	req, err := m.RequestExecutor.NewRequest(http.MethodPost, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}